@@ -0,0 +1,120 @@
+package beaconevents
+
+import (
+	"sync"
+)
+
+type Topic string
+
+const (
+	TopicHead                Topic = "head"
+	TopicBlock               Topic = "block"
+	TopicAttestation         Topic = "attestation"
+	TopicVoluntaryExit       Topic = "voluntary_exit"
+	TopicFinalizedCheckpoint Topic = "finalized_checkpoint"
+	TopicChainReorg          Topic = "chain_reorg"
+	TopicPayloadAttributes   Topic = "payload_attributes"
+)
+
+// Topics is the set of topics which can be subscribed to.
+var Topics = map[Topic]struct{}{
+	TopicHead:                {},
+	TopicBlock:               {},
+	TopicAttestation:         {},
+	TopicVoluntaryExit:       {},
+	TopicFinalizedCheckpoint: {},
+	TopicChainReorg:          {},
+	TopicPayloadAttributes:   {},
+}
+
+type Event struct {
+	Topic Topic
+	Data  any
+}
+
+// Emitters fans out beacon chain events to the subscribers interested in them.
+// Publishing never blocks: a subscriber which does not keep up with the stream is dropped.
+type Emitters struct {
+	subscriptions map[*Subscription]struct{}
+	mu            sync.RWMutex
+}
+
+func NewEmitters() *Emitters {
+	return &Emitters{
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+}
+
+type Subscription struct {
+	topics map[Topic]struct{}
+	ch     chan *Event
+	closed bool
+
+	emitters *Emitters
+}
+
+// Subscribe registers a new subscription for the given topics, buffering at most bufferSize events.
+func (e *Emitters) Subscribe(topics []Topic, bufferSize int) *Subscription {
+	s := &Subscription{
+		topics:   make(map[Topic]struct{}, len(topics)),
+		ch:       make(chan *Event, bufferSize),
+		emitters: e,
+	}
+	for _, topic := range topics {
+		s.topics[topic] = struct{}{}
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.subscriptions[s] = struct{}{}
+	return s
+}
+
+// HasSubscribers returns whether anyone is listening to the given topic, so that expensive events can be skipped.
+func (e *Emitters) HasSubscribers(topic Topic) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for s := range e.subscriptions {
+		if _, ok := s.topics[topic]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish sends the event to all the subscribers of the topic. Subscribers with a full buffer are closed.
+func (e *Emitters) Publish(topic Topic, data any) {
+	e.mu.RLock()
+	var slow []*Subscription
+	event := &Event{Topic: topic, Data: data}
+	for s := range e.subscriptions {
+		if _, ok := s.topics[topic]; !ok {
+			continue
+		}
+		select {
+		case s.ch <- event:
+		default:
+			slow = append(slow, s)
+		}
+	}
+	e.mu.RUnlock()
+	for _, s := range slow {
+		s.Unsubscribe()
+	}
+}
+
+// Events returns the channel the events are delivered to. It is closed once the subscription ends.
+func (s *Subscription) Events() <-chan *Event {
+	return s.ch
+}
+
+// Unsubscribe ends the subscription, it is safe to call it multiple times.
+func (s *Subscription) Unsubscribe() {
+	s.emitters.mu.Lock()
+	defer s.emitters.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	delete(s.emitters.subscriptions, s)
+	close(s.ch)
+}
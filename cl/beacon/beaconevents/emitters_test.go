@@ -0,0 +1,45 @@
+package beaconevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmittersTopics(t *testing.T) {
+	e := NewEmitters()
+	head := e.Subscribe([]Topic{TopicHead}, 4)
+	block := e.Subscribe([]Topic{TopicBlock, TopicHead}, 4)
+	defer head.Unsubscribe()
+	defer block.Unsubscribe()
+
+	require.True(t, e.HasSubscribers(TopicHead))
+	require.False(t, e.HasSubscribers(TopicChainReorg))
+
+	e.Publish(TopicBlock, 1)
+	e.Publish(TopicHead, 2)
+
+	require.Equal(t, &Event{Topic: TopicHead, Data: 2}, <-head.Events())
+	require.Equal(t, &Event{Topic: TopicBlock, Data: 1}, <-block.Events())
+	require.Equal(t, &Event{Topic: TopicHead, Data: 2}, <-block.Events())
+	require.Len(t, head.Events(), 0)
+}
+
+func TestEmittersDropSlowSubscriber(t *testing.T) {
+	e := NewEmitters()
+	slow := e.Subscribe([]Topic{TopicHead}, 1)
+
+	e.Publish(TopicHead, 1)
+	// the buffer is full, the subscription is closed instead of blocking.
+	e.Publish(TopicHead, 2)
+	require.False(t, e.HasSubscribers(TopicHead))
+
+	event, ok := <-slow.Events()
+	require.True(t, ok)
+	require.Equal(t, 1, event.Data)
+	_, ok = <-slow.Events()
+	require.False(t, ok)
+
+	// unsubscribing again is a no-op
+	slow.Unsubscribe()
+}
@@ -0,0 +1,63 @@
+package beaconevents
+
+import (
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+)
+
+type HeadData struct {
+	Slot                      uint64         `json:"slot,string"`
+	Block                     libcommon.Hash `json:"block"`
+	State                     libcommon.Hash `json:"state"`
+	EpochTransition           bool           `json:"epoch_transition"`
+	PreviousDutyDependentRoot libcommon.Hash `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  libcommon.Hash `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool           `json:"execution_optimistic"`
+}
+
+type BlockData struct {
+	Slot                uint64         `json:"slot,string"`
+	Block               libcommon.Hash `json:"block"`
+	ExecutionOptimistic bool           `json:"execution_optimistic"`
+}
+
+type FinalizedCheckpointData struct {
+	Block               libcommon.Hash `json:"block"`
+	State               libcommon.Hash `json:"state"`
+	Epoch               uint64         `json:"epoch,string"`
+	ExecutionOptimistic bool           `json:"execution_optimistic"`
+}
+
+type ChainReorgData struct {
+	Slot                uint64         `json:"slot,string"`
+	Depth               uint64         `json:"depth,string"`
+	OldHeadBlock        libcommon.Hash `json:"old_head_block"`
+	NewHeadBlock        libcommon.Hash `json:"new_head_block"`
+	OldHeadState        libcommon.Hash `json:"old_head_state"`
+	NewHeadState        libcommon.Hash `json:"new_head_state"`
+	Epoch               uint64         `json:"epoch,string"`
+	ExecutionOptimistic bool           `json:"execution_optimistic"`
+}
+
+type PayloadAttributes struct {
+	Timestamp             uint64                `json:"timestamp,string"`
+	PrevRandao            libcommon.Hash        `json:"prev_randao"`
+	SuggestedFeeRecipient libcommon.Address     `json:"suggested_fee_recipient"`
+	Withdrawals           []*cltypes.Withdrawal `json:"withdrawals,omitempty"`
+	ParentBeaconBlockRoot *libcommon.Hash       `json:"parent_beacon_block_root,omitempty"`
+}
+
+type PayloadAttributesData struct {
+	ProposerIndex     uint64            `json:"proposer_index,string"`
+	ProposalSlot      uint64            `json:"proposal_slot,string"`
+	ParentBlockNumber uint64            `json:"parent_block_number,string"`
+	ParentBlockRoot   libcommon.Hash    `json:"parent_block_root"`
+	ParentBlockHash   libcommon.Hash    `json:"parent_block_hash"`
+	PayloadAttributes PayloadAttributes `json:"payload_attributes"`
+}
+
+// PayloadAttributesEvent is versioned by the fork of the proposal slot.
+type PayloadAttributesEvent struct {
+	Version string                `json:"version"`
+	Data    PayloadAttributesData `json:"data"`
+}
@@ -9,12 +9,17 @@ import (
 type State struct {
 	feeRecipients map[int]common.Address
 
+	beaconCommitteeSubscriptions map[int]BeaconCommitteeSubscription
+	syncCommitteeSubscriptions   map[int]SyncCommitteeSubscription
+
 	mu sync.RWMutex
 }
 
 func NewState() *State {
 	return &State{
-		feeRecipients: map[int]common.Address{},
+		feeRecipients:                map[int]common.Address{},
+		beaconCommitteeSubscriptions: map[int]BeaconCommitteeSubscription{},
+		syncCommitteeSubscriptions:   map[int]SyncCommitteeSubscription{},
 	}
 }
 
@@ -23,3 +28,57 @@ func (s *State) SetFeeRecipient(idx int, address common.Address) {
 	defer s.mu.Unlock()
 	s.feeRecipients[idx] = address
 }
+
+// FeeRecipient returns the fee recipient prepared for the given validator, if any.
+func (s *State) FeeRecipient(idx int) (common.Address, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	address, ok := s.feeRecipients[idx]
+	return address, ok
+}
+
+// AddBeaconCommitteeSubscription records the latest attestation duty of a validator.
+func (s *State) AddBeaconCommitteeSubscription(sub BeaconCommitteeSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.beaconCommitteeSubscriptions[sub.ValidatorIndex] = sub
+}
+
+// BeaconCommitteeSubscription returns the latest attestation duty recorded for the given validator.
+func (s *State) BeaconCommitteeSubscription(idx int) (BeaconCommitteeSubscription, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sub, ok := s.beaconCommitteeSubscriptions[idx]
+	return sub, ok
+}
+
+// AddSyncCommitteeSubscription records the sync committee membership of a validator.
+func (s *State) AddSyncCommitteeSubscription(sub SyncCommitteeSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.syncCommitteeSubscriptions[sub.ValidatorIndex] = sub
+}
+
+// SyncCommitteeSubscription returns the sync committee membership recorded for the given validator.
+func (s *State) SyncCommitteeSubscription(idx int) (SyncCommitteeSubscription, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sub, ok := s.syncCommitteeSubscriptions[idx]
+	return sub, ok
+}
+
+// PruneSubscriptions drops the subscriptions that expired before the given slot.
+func (s *State) PruneSubscriptions(slot uint64, slotsPerEpoch uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for idx, sub := range s.beaconCommitteeSubscriptions {
+		if uint64(sub.Slot) < slot {
+			delete(s.beaconCommitteeSubscriptions, idx)
+		}
+	}
+	for idx, sub := range s.syncCommitteeSubscriptions {
+		if uint64(sub.UntilEpoch)*slotsPerEpoch <= slot {
+			delete(s.syncCommitteeSubscriptions, idx)
+		}
+	}
+}
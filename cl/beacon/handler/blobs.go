@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/persistence/beacon_indicies"
+)
+
+func (a *ApiHandler) GetEthV1BeaconBlobSidecars(w http.ResponseWriter, r *http.Request) (*beaconResponse, error) {
+	ctx := r.Context()
+	tx, err := a.indiciesDB.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	blockId, err := blockIdFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	blockRoot, err := a.rootFromBlockId(ctx, tx, blockId)
+	if err != nil {
+		return nil, err
+	}
+	strIndicies, err := stringListFromQueryParams(r, "indices")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	indicies := make(map[uint64]struct{}, len(strIndicies))
+	for _, strIndex := range strIndicies {
+		index, err := strconv.ParseUint(strIndex, 10, 64)
+		if err != nil {
+			return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, fmt.Sprintf("invalid blob index %s", strIndex))
+		}
+		indicies[index] = struct{}{}
+	}
+
+	slot, err := beacon_indicies.ReadBlockSlotByBlockRoot(tx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if slot == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusNotFound, fmt.Sprintf("block not found %x", blockRoot))
+	}
+	blobSidecars, _, err := a.blobStorage.ReadBlobSidecars(ctx, *slot, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := []*cltypes.BlobSidecar{}
+	for _, blobSidecar := range blobSidecars {
+		if _, ok := indicies[blobSidecar.Index]; len(indicies) > 0 && !ok {
+			continue
+		}
+		resp = append(resp, blobSidecar)
+	}
+	return newBeaconResponse(resp), nil
+}
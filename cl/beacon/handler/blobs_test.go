@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlobSidecars(t *testing.T) {
+	_, blocks, _, _, _, handler, _, _, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	block := blocks[len(blocks)-1]
+	blockRoot, err := block.Block.HashSSZ()
+	require.NoError(t, err)
+	header := &cltypes.SignedBeaconBlockHeader{Header: &cltypes.BeaconBlockHeader{Slot: block.Block.Slot}}
+	var sidecars []*cltypes.BlobSidecar
+	for i := uint64(0); i < 2; i++ {
+		sidecars = append(sidecars, cltypes.NewBlobSidecar(i, &cltypes.Blob{byte(i)}, cltypes.KZGCommitment{}, cltypes.KZGProof{}, header,
+			solid.NewHashVector(cltypes.KzgCommitmentInclusionProofDepth)))
+	}
+	require.NoError(t, handler.blobStorage.WriteBlobSidecars(context.Background(), blockRoot, sidecars))
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	cases := []struct {
+		url      string
+		code     int
+		indicies []string
+	}{
+		{url: fmt.Sprintf("/eth/v1/beacon/blob_sidecars/0x%x", blockRoot), code: http.StatusOK, indicies: []string{"0", "1"}},
+		{url: fmt.Sprintf("/eth/v1/beacon/blob_sidecars/0x%x?indices=1", blockRoot), code: http.StatusOK, indicies: []string{"1"}},
+		{url: fmt.Sprintf("/eth/v1/beacon/blob_sidecars/0x%x?indices=a", blockRoot), code: http.StatusBadRequest},
+		{url: fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%d", block.Block.Slot), code: http.StatusOK, indicies: []string{"0", "1"}},
+		{url: "/eth/v1/beacon/blob_sidecars/0x0100000000000000000000000000000000000000000000000000000000000000", code: http.StatusNotFound},
+	}
+	for _, c := range cases {
+		t.Run(c.url, func(t *testing.T) {
+			resp, err := server.Client().Get(server.URL + c.url)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			out, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, c.code, resp.StatusCode, string(out))
+			if c.code != http.StatusOK {
+				return
+			}
+			var response struct {
+				Data []struct {
+					Index string `json:"index"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(out, &response))
+			require.Len(t, response.Data, len(c.indicies))
+			for i, index := range c.indicies {
+				require.Equal(t, index, response.Data[i].Index)
+			}
+		})
+	}
+}
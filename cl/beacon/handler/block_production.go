@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/Giulio2002/bls"
+	"github.com/go-chi/chi/v5"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
+	"github.com/ledgerwatch/erigon/cl/phase1/execution_client"
+	"github.com/ledgerwatch/erigon/cl/transition"
+	"github.com/ledgerwatch/erigon/cl/transition/machine"
+	"github.com/ledgerwatch/erigon/cl/utils"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_types"
+)
+
+type producedBlockResponse struct {
+	Version string `json:"version"`
+	Data    any    `json:"data"`
+}
+
+// blockContents is the deneb block production response, which carries the blobs backing the block commitments.
+type blockContents struct {
+	Block     *cltypes.BeaconBlock `json:"block"`
+	KzgProofs []hexutility.Bytes   `json:"kzg_proofs"`
+	Blobs     []hexutility.Bytes   `json:"blobs"`
+}
+
+func (a *ApiHandler) GetEthV2ValidatorBlocksSlot(w http.ResponseWriter, r *http.Request) (*producedBlockResponse, error) {
+	slot, err := strconv.ParseUint(chi.URLParam(r, "slot"), 10, 64)
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, fmt.Sprintf("invalid slot: %v", err))
+	}
+	var randaoReveal libcommon.Bytes96
+	if err := randaoReveal.UnmarshalText([]byte(r.URL.Query().Get("randao_reveal"))); err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, fmt.Sprintf("invalid randao_reveal: %v", err))
+	}
+	var graffiti libcommon.Hash
+	if graffitiString := r.URL.Query().Get("graffiti"); graffitiString != "" {
+		graffitiBytes, err := hexutil.Decode(graffitiString)
+		if err != nil || len(graffitiBytes) > len(graffiti) {
+			return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, "invalid graffiti")
+		}
+		copy(graffiti[:], graffitiBytes)
+	}
+	skipRandaoVerification := r.URL.Query().Has("skip_randao_verification")
+	if skipRandaoVerification && randaoReveal != bls.InfiniteSignature {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, "randao_reveal must be the point at infinity if skip_randao_verification is set")
+	}
+
+	block, blobsBundle, err := a.produceBeaconBlock(slot, randaoReveal, graffiti, !skipRandaoVerification)
+	if err != nil {
+		return nil, err
+	}
+	version := clparams.ClVersionToString(block.Version())
+	w.Header().Set("Eth-Consensus-Version", version)
+	if block.Version() < clparams.DenebVersion {
+		return &producedBlockResponse{Version: version, Data: block}, nil
+	}
+	contents := &blockContents{Block: block, KzgProofs: []hexutility.Bytes{}, Blobs: []hexutility.Bytes{}}
+	if blobsBundle != nil {
+		contents.KzgProofs = blobsBundle.Proofs
+		contents.Blobs = blobsBundle.Blobs
+	}
+	return &producedBlockResponse{Version: version, Data: contents}, nil
+}
+
+// produceBeaconBlock builds an unsigned block on top of the current head, filling it with the pooled operations
+// and, post-merge, with a payload assembled by the execution layer.
+func (a *ApiHandler) produceBeaconBlock(slot uint64, randaoReveal libcommon.Bytes96, graffiti libcommon.Hash, verifyRandao bool) (*cltypes.BeaconBlock, *engine_types.BlobsBundleV1, error) {
+	_, headSlot, err := a.forkchoiceStore.GetHead()
+	if err != nil {
+		return nil, nil, err
+	}
+	if slot <= headSlot {
+		return nil, nil, beaconhttp.NewEndpointError(http.StatusBadRequest, fmt.Sprintf("slot %d is not after the head slot %d", slot, headSlot))
+	}
+	s, headRoot, err := a.headStateAtSlot(slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	proposerIndex, err := s.GetBeaconProposerIndex()
+	if err != nil {
+		return nil, nil, err
+	}
+	epoch := state.Epoch(s)
+	if verifyRandao {
+		if err := verifyRandaoReveal(s, proposerIndex, epoch, randaoReveal); err != nil {
+			return nil, nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+		}
+	}
+	deposits, err := a.pendingDeposits(s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	block := cltypes.NewBeaconBlock(a.beaconChainCfg)
+	block.Slot = slot
+	block.ProposerIndex = proposerIndex
+	block.ParentRoot = headRoot
+	block.Body.Version = s.Version()
+	// initializes all the body lists for the given version.
+	block.Body.EncodingSizeSSZ()
+	block.Body.RandaoReveal = randaoReveal
+	block.Body.Graffiti = graffiti
+	block.Body.Eth1Data = s.Eth1Data().Copy()
+	for _, deposit := range deposits {
+		block.Body.Deposits.Append(deposit)
+	}
+
+	var blobsBundle *engine_types.BlobsBundleV1
+	if s.Version() >= clparams.BellatrixVersion && state.IsMergeTransitionComplete(s) {
+		if blobsBundle, err = a.produceExecutionPayload(s, block, headRoot, proposerIndex); err != nil {
+			return nil, nil, err
+		}
+	}
+	if s.Version() >= clparams.AltairVersion {
+		if block.Body.SyncAggregate, err = a.produceSyncAggregate(s, slot-1, headRoot); err != nil {
+			return nil, nil, err
+		}
+	}
+	postState, err := s.Copy()
+	if err != nil {
+		return nil, nil, err
+	}
+	// the state at the new slot is only used to filter the operations from now on.
+	a.packOperations(s, block.Body)
+
+	if err := machine.ProcessBlock(transition.DefaultMachine, postState, &cltypes.SignedBeaconBlock{Block: block}); err != nil {
+		return nil, nil, err
+	}
+	if block.StateRoot, err = postState.HashSSZ(); err != nil {
+		return nil, nil, err
+	}
+	return block, blobsBundle, nil
+}
+
+func verifyRandaoReveal(s *state.CachingBeaconState, proposerIndex, epoch uint64, randaoReveal libcommon.Bytes96) error {
+	proposer, err := s.ValidatorForValidatorIndex(int(proposerIndex))
+	if err != nil {
+		return err
+	}
+	domain, err := s.GetDomain(s.BeaconConfig().DomainRandao, epoch)
+	if err != nil {
+		return err
+	}
+	epochRoot := make([]byte, 32)
+	binary.LittleEndian.PutUint64(epochRoot, epoch)
+	signingRoot := utils.Sha256(epochRoot, domain)
+	pk := proposer.PublicKey()
+	valid, err := bls.Verify(randaoReveal[:], signingRoot[:], pk[:])
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("invalid randao reveal for proposer %d", proposerIndex)
+	}
+	return nil
+}
+
+// produceExecutionPayload asks the execution layer to build a payload on top of the state's latest payload and
+// sets it, alongside its blob commitments, in the block.
+func (a *ApiHandler) produceExecutionPayload(s *state.CachingBeaconState, block *cltypes.BeaconBlock, headRoot libcommon.Hash, proposerIndex uint64) (*engine_types.BlobsBundleV1, error) {
+	engine := a.forkchoiceStore.Engine()
+	if engine == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusServiceUnavailable, "no execution engine available")
+	}
+	feeRecipient, _ := a.builderState.FeeRecipient(int(proposerIndex))
+	attributes := &engine_types.PayloadAttributes{
+		Timestamp:             hexutil.Uint64(state.ComputeTimestampAtSlot(s, s.Slot())),
+		PrevRandao:            s.GetRandaoMixes(state.Epoch(s)),
+		SuggestedFeeRecipient: feeRecipient,
+	}
+	if s.Version() >= clparams.CapellaVersion {
+		attributes.Withdrawals = []*types.Withdrawal{}
+		for _, w := range state.ExpectedWithdrawals(s) {
+			attributes.Withdrawals = append(attributes.Withdrawals, &types.Withdrawal{
+				Index:     w.Index,
+				Validator: w.Validator,
+				Address:   w.Address,
+				Amount:    w.Amount,
+			})
+		}
+	}
+	if s.Version() >= clparams.DenebVersion {
+		attributes.ParentBeaconBlockRoot = &headRoot
+	}
+	finalizedHash := a.forkchoiceStore.GetEth1Hash(a.forkchoiceStore.FinalizedCheckpoint().BlockRoot())
+	id, err := engine.AssembleBlock(finalizedHash, s.LatestExecutionPayloadHeader().BlockHash, attributes)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := engine.GetAssembledBlock(id, s.Version())
+	if err != nil {
+		return nil, err
+	}
+	if block.Body.ExecutionPayload, err = execution_client.ConvertPayloadToEth1Block(resp.ExecutionPayload, s.Version(), a.beaconChainCfg); err != nil {
+		return nil, err
+	}
+	if s.Version() < clparams.DenebVersion || resp.BlobsBundle == nil {
+		return nil, nil
+	}
+	if len(resp.BlobsBundle.Commitments) != len(resp.BlobsBundle.Blobs) || len(resp.BlobsBundle.Proofs) != len(resp.BlobsBundle.Blobs) {
+		return nil, fmt.Errorf("inconsistent blobs bundle from the execution layer")
+	}
+	for _, commitment := range resp.BlobsBundle.Commitments {
+		var c cltypes.KZGCommitment
+		if len(commitment) != len(c) {
+			return nil, fmt.Errorf("invalid kzg commitment length: %d", len(commitment))
+		}
+		copy(c[:], commitment)
+		block.Body.BlobKzgCommitments.Append(&c)
+	}
+	return resp.BlobsBundle, nil
+}
+
+// produceSyncAggregate aggregates the pooled sync committee contributions and messages for the given slot and block root.
+func (a *ApiHandler) produceSyncAggregate(s *state.CachingBeaconState, slot uint64, root libcommon.Hash) (*cltypes.SyncAggregate, error) {
+	aggregate := &cltypes.SyncAggregate{SyncCommiteeSignature: bls.InfiniteSignature}
+	subcommitteeBytes := len(aggregate.SyncCommiteeBits) / int(a.beaconChainCfg.SyncCommitteeSubnetCount)
+	var signatures [][]byte
+	for subcommitteeIndex, contributions := range a.pooledContributions(s, slot, root) {
+		if subcommitteeIndex >= a.beaconChainCfg.SyncCommitteeSubnetCount {
+			continue
+		}
+		contribution, err := aggregateContributions(contributions)
+		if err != nil {
+			return nil, err
+		}
+		bits := contribution.AggregationBits()
+		copy(aggregate.SyncCommiteeBits[int(subcommitteeIndex)*subcommitteeBytes:], bits[:subcommitteeBytes])
+		signature := contribution.Signature()
+		signatures = append(signatures, signature[:])
+	}
+	if len(signatures) == 0 {
+		return aggregate, nil
+	}
+	signature, err := utils.AggregateSignatures(signatures)
+	if err != nil {
+		return nil, err
+	}
+	aggregate.SyncCommiteeSignature = signature
+	return aggregate, nil
+}
+
+// packOperations fills the block body with the pooled operations which are still valid, applying each of them to
+// the given state so that conflicting operations are left out.
+func (a *ApiHandler) packOperations(s *state.CachingBeaconState, body *cltypes.BeaconBody) {
+	cfg := a.beaconChainCfg
+	for _, slashing := range a.operationsPool.ProposerSlashingsPool.Raw() {
+		if body.ProposerSlashings.Len() >= int(cfg.MaxProposerSlashings) {
+			break
+		}
+		if transition.DefaultMachine.ProcessProposerSlashing(s, slashing) == nil {
+			body.ProposerSlashings.Append(slashing)
+		}
+	}
+	for _, slashing := range a.operationsPool.AttesterSlashingsPool.Raw() {
+		if body.AttesterSlashings.Len() >= int(cfg.MaxAttesterSlashings) {
+			break
+		}
+		if transition.DefaultMachine.ProcessAttesterSlashing(s, slashing) == nil {
+			body.AttesterSlashings.Append(slashing)
+		}
+	}
+
+	// aggregate the pooled attestations by data and pack the largest aggregates first.
+	byData := map[libcommon.Hash][]*solid.Attestation{}
+	for _, att := range a.operationsPool.AttestationsPool.Raw() {
+		if att.AttestantionData().Slot()+cfg.MinAttestationInclusionDelay > s.Slot() {
+			continue
+		}
+		dataRoot, err := att.AttestantionData().HashSSZ()
+		if err != nil {
+			continue
+		}
+		byData[dataRoot] = append(byData[dataRoot], att)
+	}
+	aggregates := make([]*solid.Attestation, 0, len(byData))
+	for _, atts := range byData {
+		aggregate, err := aggregateAttestations(atts)
+		if err != nil {
+			continue
+		}
+		aggregates = append(aggregates, aggregate)
+	}
+	sort.Slice(aggregates, func(i, j int) bool {
+		return bitCount(aggregates[i].AggregationBits()) > bitCount(aggregates[j].AggregationBits())
+	})
+	for _, att := range aggregates {
+		if body.Attestations.Len() >= int(cfg.MaxAttestations) {
+			break
+		}
+		single := solid.NewDynamicListSSZ[*solid.Attestation](1)
+		single.Append(att)
+		if transition.DefaultMachine.ProcessAttestations(s, single) == nil {
+			body.Attestations.Append(att)
+		}
+	}
+
+	for _, exit := range a.operationsPool.VoluntaryExistsPool.Raw() {
+		if body.VoluntaryExits.Len() >= int(cfg.MaxVoluntaryExits) {
+			break
+		}
+		if transition.DefaultMachine.ProcessVoluntaryExit(s, exit) == nil {
+			body.VoluntaryExits.Append(exit)
+		}
+	}
+	if s.Version() < clparams.CapellaVersion {
+		return
+	}
+	for _, change := range a.operationsPool.BLSToExecutionChangesPool.Raw() {
+		if body.ExecutionChanges.Len() >= int(cfg.MaxBlsToExecutionChanges) {
+			break
+		}
+		if transition.DefaultMachine.ProcessBlsToExecutionChange(s, change) == nil {
+			body.ExecutionChanges.Append(change)
+		}
+	}
+}
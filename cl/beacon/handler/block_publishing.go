@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon-lib/gointerfaces/sentinel"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/gossip"
+	"github.com/ledgerwatch/erigon/cl/transition"
+	"github.com/ledgerwatch/log/v3"
+)
+
+// broadcast validation levels of the publishing endpoints, see the beacon api spec.
+const (
+	broadcastValidationGossip                   = "gossip"
+	broadcastValidationConsensus                = "consensus"
+	broadcastValidationConsensusAndEquivocation = "consensus_and_equivocation"
+)
+
+// signedBlockContents is the deneb publishing format, which carries the blobs backing the block commitments.
+type signedBlockContents struct {
+	SignedBlock *cltypes.SignedBeaconBlock `json:"signed_block"`
+	KzgProofs   []hexutility.Bytes         `json:"kzg_proofs"`
+	Blobs       []hexutility.Bytes         `json:"blobs"`
+}
+
+func (a *ApiHandler) PostEthV1BeaconBlocks(w http.ResponseWriter, r *http.Request) {
+	a.postBeaconBlocks(w, r, broadcastValidationGossip)
+}
+
+func (a *ApiHandler) PostEthV2BeaconBlocks(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Eth-Consensus-Version") == "" {
+		http.Error(w, "no eth consensus version set", http.StatusBadRequest)
+		return
+	}
+	broadcastValidation := r.URL.Query().Get("broadcast_validation")
+	if broadcastValidation == "" {
+		broadcastValidation = broadcastValidationGossip
+	}
+	a.postBeaconBlocks(w, r, broadcastValidation)
+}
+
+func (a *ApiHandler) postBeaconBlocks(w http.ResponseWriter, r *http.Request, broadcastValidation string) {
+	if broadcastValidation != broadcastValidationGossip && broadcastValidation != broadcastValidationConsensus && broadcastValidation != broadcastValidationConsensusAndEquivocation {
+		http.Error(w, fmt.Sprintf("invalid broadcast_validation: %s", broadcastValidation), http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	version, err := a.blockVersion(r.Header.Get("Eth-Consensus-Version"), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	block, err := a.decodeSignedBlock(body, version)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := a.validateBlockForBroadcast(block, broadcastValidation); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Broadcast to gossip. TODO: the deneb blobs are not published as sidecars yet.
+	if a.sentinel != nil {
+		encodedSSZ, err := block.EncodeSSZ(nil)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if _, err := a.sentinel.PublishGossip(r.Context(), &sentinel.GossipData{
+			Data: encodedSSZ,
+			Name: gossip.TopicNameBeaconBlock,
+		}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	// Our own blocks do not come back to us through gossip, so they are handed to the gossip manager which queues
+	// them for import into forkchoice and the database.
+	if a.gossipManager != nil {
+		a.gossipManager.ImportSignedBeaconBlock(block)
+	}
+	log.Debug("[Beacon API] published block", "slot", block.Block.Slot, "proposer", block.Block.ProposerIndex)
+	// Only write 200
+	w.WriteHeader(http.StatusOK)
+}
+
+// blockVersion returns the fork of the submitted block, either from the Eth-Consensus-Version header or, when it is
+// missing, from the slot of the block.
+func (a *ApiHandler) blockVersion(header string, body []byte) (clparams.StateVersion, error) {
+	if header != "" {
+		for version := clparams.Phase0Version; version <= clparams.DenebVersion; version++ {
+			if clparams.ClVersionToString(version) == header {
+				return version, nil
+			}
+		}
+		return 0, fmt.Errorf("invalid eth consensus version: %s", header)
+	}
+	type slotOnly struct {
+		Slot *uint64 `json:"slot,string"`
+	}
+	// the block is either sent as is or, from deneb onwards, wrapped in the block contents.
+	var peek struct {
+		Message     slotOnly `json:"message"`
+		SignedBlock struct {
+			Message slotOnly `json:"message"`
+		} `json:"signed_block"`
+	}
+	if err := json.Unmarshal(body, &peek); err != nil {
+		return 0, err
+	}
+	slot := peek.Message.Slot
+	if slot == nil {
+		slot = peek.SignedBlock.Message.Slot
+	}
+	if slot == nil {
+		return 0, fmt.Errorf("missing block slot")
+	}
+	return a.beaconChainCfg.GetCurrentStateVersion(*slot / a.beaconChainCfg.SlotsPerEpoch), nil
+}
+
+// decodeSignedBlock decodes the json body of a publishing request, unwrapping the block contents from deneb onwards.
+func (a *ApiHandler) decodeSignedBlock(body []byte, version clparams.StateVersion) (*cltypes.SignedBeaconBlock, error) {
+	block := cltypes.NewSignedBeaconBlock(a.beaconChainCfg)
+	block.Block.Body.Version = version
+	// initializes all the body lists for the given version.
+	block.Block.Body.EncodingSizeSSZ()
+	if version < clparams.DenebVersion {
+		if err := json.Unmarshal(body, block); err != nil {
+			return nil, err
+		}
+		return block, nil
+	}
+	contents := &signedBlockContents{SignedBlock: block}
+	if err := json.Unmarshal(body, contents); err != nil {
+		return nil, err
+	}
+	commitments := block.Block.Body.BlobKzgCommitments.Len()
+	if len(contents.Blobs) != commitments || len(contents.KzgProofs) != commitments {
+		return nil, fmt.Errorf("expected %d blobs and proofs, got %d blobs and %d proofs", commitments, len(contents.Blobs), len(contents.KzgProofs))
+	}
+	return block, nil
+}
+
+// validateBlockForBroadcast checks the block against the state of its parent. gossip validation checks the proposer
+// and its signature, while consensus validation runs the whole state transition. Equivocations are left to forkchoice.
+func (a *ApiHandler) validateBlockForBroadcast(block *cltypes.SignedBeaconBlock, broadcastValidation string) error {
+	if block.Block.Slot <= a.forkchoiceStore.FinalizedSlot() {
+		return fmt.Errorf("block slot %d is not after the finalized slot %d", block.Block.Slot, a.forkchoiceStore.FinalizedSlot())
+	}
+	s, err := a.forkchoiceStore.GetStateAtBlockRoot(block.Block.ParentRoot, true)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return fmt.Errorf("parent block %x is unknown", block.Block.ParentRoot)
+	}
+	if block.Block.Slot <= s.Slot() {
+		return fmt.Errorf("block slot %d is not after its parent slot %d", block.Block.Slot, s.Slot())
+	}
+	if block.Version() != s.BeaconConfig().GetCurrentStateVersion(block.Block.Slot/s.BeaconConfig().SlotsPerEpoch) {
+		return fmt.Errorf("block version %s does not match the fork at slot %d", clparams.ClVersionToString(block.Version()), block.Block.Slot)
+	}
+	if broadcastValidation != broadcastValidationGossip {
+		return transition.TransitionState(s, block, nil, true)
+	}
+	if err := transition.DefaultMachine.ProcessSlots(s, block.Block.Slot); err != nil {
+		return err
+	}
+	proposerIndex, err := s.GetBeaconProposerIndex()
+	if err != nil {
+		return err
+	}
+	if block.Block.ProposerIndex != proposerIndex {
+		return fmt.Errorf("block proposer %d is not the expected proposer %d", block.Block.ProposerIndex, proposerIndex)
+	}
+	return transition.ValidatingMachine.VerifyBlockSignature(s, block)
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostBeaconBlocks(t *testing.T) {
+	_, blocks, _, preState, _, handler, _, _, fcu := setupTestingHandler(t, clparams.Phase0Version)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	imported := handler.gossipManager.SubscribeSignedBeaconBlocks(ctx)
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	block := blocks[0]
+	encoded, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		url         string
+		version     string
+		parentState bool
+		body        []byte
+		code        int
+	}{
+		{name: "gossip validation", url: "/eth/v1/beacon/blocks", parentState: true, body: encoded, code: http.StatusOK},
+		{name: "consensus validation", url: "/eth/v2/beacon/blocks?broadcast_validation=consensus", version: "phase0", parentState: true, body: encoded, code: http.StatusOK},
+		{name: "missing version", url: "/eth/v2/beacon/blocks", parentState: true, body: encoded, code: http.StatusBadRequest},
+		{name: "invalid broadcast validation", url: "/eth/v2/beacon/blocks?broadcast_validation=none", version: "phase0", parentState: true, body: encoded, code: http.StatusBadRequest},
+		{name: "unknown parent", url: "/eth/v1/beacon/blocks", body: encoded, code: http.StatusBadRequest},
+		{name: "bad signature", url: "/eth/v1/beacon/blocks", parentState: true, body: bytes.Replace(encoded, []byte(block.Signature.String()[2:10]), []byte("00000000"), 1), code: http.StatusBadRequest},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			delete(fcu.StateAtBlockRootVal, block.Block.ParentRoot)
+			if c.parentState {
+				parentState, err := preState.Copy()
+				require.NoError(t, err)
+				fcu.StateAtBlockRootVal[block.Block.ParentRoot] = parentState
+			}
+			req, err := http.NewRequest(http.MethodPost, server.URL+c.url, bytes.NewBuffer(c.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			if c.version != "" {
+				req.Header.Set("Eth-Consensus-Version", c.version)
+			}
+			resp, err := server.Client().Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			b, _ := io.ReadAll(resp.Body)
+			require.Equal(t, c.code, resp.StatusCode, string(b))
+			if c.code != http.StatusOK {
+				require.Len(t, imported, 0)
+				return
+			}
+			// the block is queued for import into forkchoice.
+			queued := <-imported
+			expectedRoot, err := block.Block.HashSSZ()
+			require.NoError(t, err)
+			queuedRoot, err := queued.Data.Block.HashSSZ()
+			require.NoError(t, err)
+			require.Equal(t, libcommon.Hash(expectedRoot), libcommon.Hash(queuedRoot))
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"sync"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/cmp"
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/merkle_tree"
+	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
+	"github.com/ledgerwatch/erigon/cl/phase1/execution_client"
+	"github.com/ledgerwatch/erigon/cl/utils"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/log/v3"
+)
+
+const (
+	depositTreeDepth = cltypes.DepositProofLength - 1
+	// depositLogsRange is the number of execution blocks whose logs are read at once.
+	depositLogsRange = 10_000
+	// depositsReorgMargin is the number of included deposits still kept, in case a reorg lowers the deposit index.
+	depositsReorgMargin = 1024
+)
+
+// depositEventTopic is the topic of DepositEvent(bytes,bytes,bytes,bytes,bytes).
+var depositEventTopic = libcommon.HexToHash("0x649bbc62d0e31342afea4e5cd82d4049e7e1ee912fc0889aa790803be39038c5")
+
+// depositTree follows the deposit contract to prove the deposits which the produced blocks have to include. The
+// deposits the chain already included are only kept as the branch of the merkle tree, like the contract does.
+type depositTree struct {
+	mu sync.Mutex
+
+	branch    [depositTreeDepth]libcommon.Hash // roots of the last full subtree of each height among the first base deposits
+	base      uint64
+	leaves    []libcommon.Hash // deposits from base on
+	deposits  []*cltypes.DepositData
+	nextBlock uint64 // first execution block whose logs were not read
+}
+
+func (t *depositTree) count() uint64 {
+	return t.base + uint64(len(t.leaves))
+}
+
+// sync reads the deposit logs until the tree holds all the deposits of eth1Data, which are in blocks deep enough
+// not to be reorged.
+func (t *depositTree) sync(engine execution_client.ExecutionEngine, contract libcommon.Address, eth1Data *cltypes.Eth1Data) error {
+	if t.count() >= eth1Data.DepositCount {
+		return nil
+	}
+	lastBlock, err := engine.HeaderNumber(eth1Data.BlockHash)
+	if err != nil {
+		return err
+	}
+	if lastBlock == nil {
+		return fmt.Errorf("execution layer does not know the eth1 data block %x", eth1Data.BlockHash)
+	}
+	for t.nextBlock <= *lastBlock && t.count() < eth1Data.DepositCount {
+		to := cmp.Min(t.nextBlock+depositLogsRange-1, *lastBlock)
+		logs, err := engine.GetLogs(contract, t.nextBlock, to)
+		if err != nil {
+			return err
+		}
+		for _, l := range logs {
+			if err := t.add(l); err != nil {
+				return err
+			}
+		}
+		t.nextBlock = to + 1
+	}
+	if t.count() < eth1Data.DepositCount {
+		return fmt.Errorf("found %d deposits up to the eth1 data block, expected %d", t.count(), eth1Data.DepositCount)
+	}
+	return nil
+}
+
+func (t *depositTree) add(l *types.Log) error {
+	if l.Removed || len(l.Topics) == 0 || l.Topics[0] != depositEventTopic {
+		return nil
+	}
+	deposit, index, err := decodeDepositLog(l.Data)
+	if err != nil {
+		return err
+	}
+	if index != t.count() {
+		return fmt.Errorf("found deposit %d, expected %d", index, t.count())
+	}
+	leaf, err := deposit.HashSSZ()
+	if err != nil {
+		return err
+	}
+	t.leaves = append(t.leaves, leaf)
+	t.deposits = append(t.deposits, deposit)
+	return nil
+}
+
+// prune folds the deposits before index into the branch.
+func (t *depositTree) prune(index uint64) {
+	for t.base < index && len(t.leaves) > 0 {
+		node, size := t.leaves[0], t.base+1
+		for h := 0; h < depositTreeDepth; h++ {
+			if size&1 == 1 {
+				t.branch[h] = node
+				break
+			}
+			node = utils.Sha256(t.branch[h][:], node[:])
+			size >>= 1
+		}
+		t.leaves, t.deposits = t.leaves[1:], t.deposits[1:]
+		t.base++
+	}
+}
+
+// node returns the root of the subtree of height h holding the deposits from n<<h, in the tree of count deposits.
+func (t *depositTree) node(h int, n, count uint64) libcommon.Hash {
+	first := n << h
+	switch {
+	case first >= count:
+		return merkle_tree.ZeroHashes[h]
+	case first+1<<h <= t.base:
+		// only the full subtrees left of the deposits kept are asked for, which are the ones of the branch.
+		return t.branch[h]
+	case h == 0:
+		return t.leaves[n-t.base]
+	}
+	left, right := t.node(h-1, 2*n, count), t.node(h-1, 2*n+1, count)
+	return utils.Sha256(left[:], right[:])
+}
+
+// proofs returns the deposits from index on a block has to include, proven against eth1Data.
+func (t *depositTree) proofs(eth1Data *cltypes.Eth1Data, index uint64) ([]*cltypes.Deposit, error) {
+	if index < t.base {
+		return nil, fmt.Errorf("deposit %d was pruned", index)
+	}
+	count := eth1Data.DepositCount
+	var length libcommon.Hash
+	binary.LittleEndian.PutUint64(length[:], count)
+	root := t.node(depositTreeDepth, 0, count)
+	if utils.Sha256(root[:], length[:]) != eth1Data.Root {
+		return nil, fmt.Errorf("deposit root mismatch for %d deposits", count)
+	}
+	deposits := []*cltypes.Deposit{}
+	for i := index; i < count && len(deposits) < cltypes.MaxDeposits; i++ {
+		proof := solid.NewHashVector(cltypes.DepositProofLength)
+		for h := 0; h < depositTreeDepth; h++ {
+			proof.Set(h, t.node(h, (i>>h)^1, count))
+		}
+		proof.Set(depositTreeDepth, length)
+		deposits = append(deposits, &cltypes.Deposit{Proof: proof, Data: t.deposits[i-t.base]})
+	}
+	return deposits, nil
+}
+
+// decodeDepositLog decodes the abi encoded pubkey, withdrawal credentials, amount, signature and index of a
+// DepositEvent.
+func decodeDepositLog(data []byte) (*cltypes.DepositData, uint64, error) {
+	fields := make([][]byte, 5)
+	for i := range fields {
+		if len(data) < 32*(i+1) {
+			return nil, 0, fmt.Errorf("deposit log too short")
+		}
+		offset := binary.BigEndian.Uint64(data[32*i+24 : 32*(i+1)])
+		if offset+32 < offset || uint64(len(data)) < offset+32 {
+			return nil, 0, fmt.Errorf("invalid deposit log offset")
+		}
+		size := binary.BigEndian.Uint64(data[offset+24 : offset+32])
+		if offset+32+size < offset+32 || uint64(len(data)) < offset+32+size {
+			return nil, 0, fmt.Errorf("invalid deposit log length")
+		}
+		fields[i] = data[offset+32 : offset+32+size]
+	}
+	deposit := &cltypes.DepositData{}
+	if len(fields[0]) != len(deposit.PubKey) || len(fields[1]) != len(deposit.WithdrawalCredentials) || len(fields[2]) != 8 ||
+		len(fields[3]) != len(deposit.Signature) || len(fields[4]) != 8 {
+		return nil, 0, fmt.Errorf("invalid deposit log fields")
+	}
+	copy(deposit.PubKey[:], fields[0])
+	copy(deposit.WithdrawalCredentials[:], fields[1])
+	deposit.Amount = binary.LittleEndian.Uint64(fields[2])
+	copy(deposit.Signature[:], fields[3])
+	return deposit, binary.LittleEndian.Uint64(fields[4]), nil
+}
+
+// pendingDeposits returns the deposits which a block built on top of s has to include.
+func (a *ApiHandler) pendingDeposits(s *state.CachingBeaconState) ([]*cltypes.Deposit, error) {
+	eth1Data, index := s.Eth1Data(), s.Eth1DepositIndex()
+	if eth1Data.DepositCount <= index {
+		return nil, nil
+	}
+	engine := a.forkchoiceStore.Engine()
+	if engine == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusServiceUnavailable, "no execution engine available to read the pending deposits")
+	}
+	a.deposits.mu.Lock()
+	defer a.deposits.mu.Unlock()
+	if err := a.deposits.sync(engine, libcommon.HexToAddress(a.beaconChainCfg.DepositContractAddress), eth1Data); err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusServiceUnavailable, fmt.Sprintf("could not read the pending deposits: %v", err))
+	}
+	deposits, err := a.deposits.proofs(eth1Data, index)
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusServiceUnavailable, fmt.Sprintf("could not prove the pending deposits: %v", err))
+	}
+	if index > depositsReorgMargin {
+		a.deposits.prune(index - depositsReorgMargin)
+	}
+	return deposits, nil
+}
+
+// syncDeposits follows the deposit contract up to the head state, so that producing a block does not wait for the
+// logs of many blocks.
+func (a *ApiHandler) syncDeposits() {
+	engine := a.forkchoiceStore.Engine()
+	if engine == nil || !a.deposits.mu.TryLock() {
+		return
+	}
+	defer a.deposits.mu.Unlock()
+	s, cn := a.syncedData.HeadState()
+	if s == nil {
+		cn()
+		return
+	}
+	eth1Data := s.Eth1Data().Copy()
+	cn()
+	if err := a.deposits.sync(engine, libcommon.HexToAddress(a.beaconChainCfg.DepositContractAddress), eth1Data); err != nil {
+		log.Debug("could not read the deposits", "err", err)
+	}
+}
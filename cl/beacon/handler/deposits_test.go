@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"encoding/binary"
+	"testing"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/cmp"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/merkle_tree"
+	"github.com/ledgerwatch/erigon/cl/utils"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testDepositLog abi encodes a DepositEvent like the deposit contract does.
+func testDepositLog(deposit *cltypes.DepositData, index uint64) *types.Log {
+	amount, depositIndex := make([]byte, 8), make([]byte, 8)
+	binary.LittleEndian.PutUint64(amount, deposit.Amount)
+	binary.LittleEndian.PutUint64(depositIndex, index)
+	fields := [][]byte{deposit.PubKey[:], deposit.WithdrawalCredentials[:], amount, deposit.Signature[:], depositIndex}
+	head, tail := []byte{}, []byte{}
+	for _, field := range fields {
+		word := make([]byte, 32)
+		binary.BigEndian.PutUint64(word[24:], uint64(32*len(fields)+len(tail)))
+		head = append(head, word...)
+		word = make([]byte, 32)
+		binary.BigEndian.PutUint64(word[24:], uint64(len(field)))
+		tail = append(tail, word...)
+		tail = append(tail, field...)
+		tail = append(tail, make([]byte, (32-len(field)%32)%32)...)
+	}
+	return &types.Log{Topics: []libcommon.Hash{depositEventTopic}, Data: append(head, tail...)}
+}
+
+func TestDepositTreeProofs(t *testing.T) {
+	const count = 37
+	tree := &depositTree{}
+	leaves := [][32]byte{}
+	for i := uint64(0); i < count; i++ {
+		deposit := &cltypes.DepositData{PubKey: libcommon.Bytes48{byte(i)}, WithdrawalCredentials: libcommon.Hash{byte(i), 1}, Amount: 32e9 + i, Signature: libcommon.Bytes96{byte(i), 2}}
+		require.NoError(t, tree.add(testDepositLog(deposit, i)))
+		require.Equal(t, deposit, tree.deposits[i])
+		leaf, err := deposit.HashSSZ()
+		require.NoError(t, err)
+		leaves = append(leaves, leaf)
+	}
+	require.Error(t, tree.add(testDepositLog(&cltypes.DepositData{}, count+1)))
+
+	// merkleizing hashes in place
+	root, err := merkle_tree.MerkleizeVector(append([][32]byte{}, leaves...), 1<<depositTreeDepth)
+	require.NoError(t, err)
+	var length [32]byte
+	binary.LittleEndian.PutUint64(length[:], count)
+	eth1Data := &cltypes.Eth1Data{Root: utils.Sha256(root[:], length[:]), DepositCount: count}
+
+	for _, index := range []uint64{0, 5, 16, 21, 30} {
+		tree.prune(index)
+		require.Equal(t, index, tree.base)
+		deposits, err := tree.proofs(eth1Data, index)
+		require.NoError(t, err)
+		require.Len(t, deposits, int(cmp.Min(count-index, cltypes.MaxDeposits)))
+		for i, deposit := range deposits {
+			proof := []libcommon.Hash{}
+			for h := 0; h < cltypes.DepositProofLength; h++ {
+				proof = append(proof, deposit.Proof.Get(h))
+			}
+			require.True(t, utils.IsValidMerkleBranch(leaves[index+uint64(i)], proof, cltypes.DepositProofLength, index+uint64(i), eth1Data.Root))
+		}
+	}
+	_, err = tree.proofs(eth1Data, 29)
+	require.Error(t, err)
+	_, err = tree.proofs(&cltypes.Eth1Data{Root: libcommon.Hash{1}, DepositCount: count}, 30)
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gfx-labs/sse"
+	"github.com/ledgerwatch/log/v3"
+
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
+)
+
+// eventsBufferSize is how many events a client can lag behind before being disconnected.
+const eventsBufferSize = 256
+
+func (a *ApiHandler) EventSourceGetV1Events(w http.ResponseWriter, r *http.Request) {
+	var topics []beaconevents.Topic
+	for _, param := range r.URL.Query()["topics"] {
+		for _, topic := range strings.Split(param, ",") {
+			topic = strings.TrimSpace(topic)
+			if _, ok := beaconevents.Topics[beaconevents.Topic(topic)]; !ok {
+				http.Error(w, fmt.Sprintf("invalid topic: %q", topic), http.StatusBadRequest)
+				return
+			}
+			topics = append(topics, beaconevents.Topic(topic))
+		}
+	}
+	if len(topics) == 0 {
+		http.Error(w, "no topics specified", http.StatusBadRequest)
+		return
+	}
+	// The stream lives for as long as the client wants, so lift the server write timeout.
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		log.Debug("[Beacon API] could not clear write deadline for event stream", "err", err)
+	}
+	sink, err := sse.DefaultUpgrader.Upgrade(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	subscription := a.emitters.Subscribe(topics, eventsBufferSize)
+	defer subscription.Unsubscribe()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-subscription.Events():
+			if !ok {
+				// the client was too slow and got dropped, it will have to reconnect.
+				return
+			}
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				log.Warn("[Beacon API] could not encode event", "topic", event.Topic, "err", err)
+				continue
+			}
+			if err := sink.Encode(&sse.Event{
+				Event: []byte(event.Topic),
+				Data:  bytes.NewReader(data),
+			}); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsInvalidTopic(t *testing.T) {
+	_, _, _, _, _, handler, _, _, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	for _, query := range []string{"", "?topics=head,nope", "?topics=nope"} {
+		resp, err := http.Get(server.URL + "/eth/v1/events" + query)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+func TestEventsStream(t *testing.T) {
+	_, _, _, _, _, handler, _, _, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/eth/v1/events?topics=head,block&topics=finalized_checkpoint")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// wait for the subscription to be registered before publishing
+	require.Eventually(t, func() bool {
+		return handler.emitters.HasSubscribers(beaconevents.TopicBlock)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	handler.emitters.Publish(beaconevents.TopicAttestation, "ignored")
+	handler.emitters.Publish(beaconevents.TopicBlock, &beaconevents.BlockData{Slot: 5, Block: libcommon.Hash{1}})
+
+	reader := bufio.NewReader(resp.Body)
+	var lines []string
+	for len(lines) < 2 {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	require.Equal(t, "event: block", lines[0])
+	require.Equal(t, `data: {"slot":"5","block":"0x0100000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false}`, lines[1])
+}
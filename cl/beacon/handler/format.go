@@ -200,6 +200,19 @@ func hashFromQueryParams(r *http.Request, name string) (*libcommon.Hash, error)
 	if hashStr == "" {
 		return nil, nil
 	}
+	return parseHash(hashStr)
+}
+
+// hashFromPathParams retrieves a mandatory hash from the url path.
+func hashFromPathParams(r *http.Request, name string) (*libcommon.Hash, error) {
+	hashStr := chi.URLParam(r, name)
+	if hashStr == "" {
+		return nil, fmt.Errorf("missing path variable: {%s}", name)
+	}
+	return parseHash(hashStr)
+}
+
+func parseHash(hashStr string) (*libcommon.Hash, error) {
 	// check if hashstr is an hex string
 	if len(hashStr) != 2+2*32 {
 		return nil, fmt.Errorf("invalid hash length")
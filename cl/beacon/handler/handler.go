@@ -7,13 +7,17 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/ledgerwatch/erigon-lib/gointerfaces/sentinel"
 	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
 	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
+	"github.com/ledgerwatch/erigon/cl/beacon/building"
 	"github.com/ledgerwatch/erigon/cl/beacon/synced_data"
 	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
 	"github.com/ledgerwatch/erigon/cl/persistence"
+	"github.com/ledgerwatch/erigon/cl/persistence/blob_storage"
 	"github.com/ledgerwatch/erigon/cl/persistence/state/historical_states_reader"
 	"github.com/ledgerwatch/erigon/cl/phase1/forkchoice"
+	"github.com/ledgerwatch/erigon/cl/phase1/network"
 	"github.com/ledgerwatch/erigon/cl/pool"
 	"github.com/ledgerwatch/erigon/turbo/snapshotsync/freezeblocks"
 )
@@ -31,6 +35,11 @@ type ApiHandler struct {
 	syncedData      *synced_data.SyncedDataManager
 	stateReader     *historical_states_reader.HistoricalStatesReader
 	sentinel        sentinel.SentinelClient
+	gossipManager   *network.GossipManager
+	emitters        *beaconevents.Emitters
+	builderState    *building.State
+	blobStorage     blob_storage.BlobStorage
+	deposits        depositTree
 
 	version string // Node's version
 
@@ -38,10 +47,10 @@ type ApiHandler struct {
 	randaoMixesPool sync.Pool
 }
 
-func NewApiHandler(genesisConfig *clparams.GenesisConfig, beaconChainConfig *clparams.BeaconChainConfig, source persistence.RawBeaconBlockChain, indiciesDB kv.RoDB, forkchoiceStore forkchoice.ForkChoiceStorage, operationsPool pool.OperationsPool, rcsn freezeblocks.BeaconSnapshotReader, syncedData *synced_data.SyncedDataManager, stateReader *historical_states_reader.HistoricalStatesReader, sentinel sentinel.SentinelClient, version string) *ApiHandler {
+func NewApiHandler(genesisConfig *clparams.GenesisConfig, beaconChainConfig *clparams.BeaconChainConfig, source persistence.RawBeaconBlockChain, indiciesDB kv.RoDB, forkchoiceStore forkchoice.ForkChoiceStorage, operationsPool pool.OperationsPool, rcsn freezeblocks.BeaconSnapshotReader, syncedData *synced_data.SyncedDataManager, stateReader *historical_states_reader.HistoricalStatesReader, sentinel sentinel.SentinelClient, gossipManager *network.GossipManager, emitters *beaconevents.Emitters, blobStorage blob_storage.BlobStorage, version string) *ApiHandler {
 	return &ApiHandler{o: sync.Once{}, genesisCfg: genesisConfig, beaconChainCfg: beaconChainConfig, indiciesDB: indiciesDB, forkchoiceStore: forkchoiceStore, operationsPool: operationsPool, blockReader: rcsn, syncedData: syncedData, stateReader: stateReader, randaoMixesPool: sync.Pool{New: func() interface{} {
 		return solid.NewHashVector(int(beaconChainConfig.EpochsPerHistoricalVector))
-	}}, sentinel: sentinel, version: version}
+	}}, sentinel: sentinel, gossipManager: gossipManager, emitters: emitters, builderState: building.NewState(), blobStorage: blobStorage, version: version}
 }
 
 func (a *ApiHandler) init() {
@@ -52,7 +61,7 @@ func (a *ApiHandler) init() {
 	r.Route("/eth", func(r chi.Router) {
 		r.Route("/v1", func(r chi.Router) {
 			r.Get("/builder/states/{state_id}/expected_withdrawals", beaconhttp.HandleEndpointFunc(a.GetEth1V1BuilderStatesExpectedWit))
-			r.Get("/events", http.NotFound)
+			r.Get("/events", a.EventSourceGetV1Events)
 			r.Route("/node", func(r chi.Router) {
 				r.Get("/health", a.GetEthV1NodeHealth)
 				r.Get("/version", a.GetEthV1NodeVersion)
@@ -74,13 +83,20 @@ func (a *ApiHandler) init() {
 					r.Get("/{block_id}", beaconhttp.HandleEndpointFunc(a.getHeader))
 				})
 				r.Route("/blocks", func(r chi.Router) {
-					r.Post("/", http.NotFound)
+					r.Post("/", a.PostEthV1BeaconBlocks)
 					r.Get("/{block_id}", beaconhttp.HandleEndpointFunc(a.getBlock))
 					r.Get("/{block_id}/attestations", beaconhttp.HandleEndpointFunc(a.getBlockAttestations))
 					r.Get("/{block_id}/root", beaconhttp.HandleEndpointFunc(a.getBlockRoot))
 				})
 				r.Get("/genesis", beaconhttp.HandleEndpointFunc(a.getGenesis))
+				r.Route("/light_client", func(r chi.Router) {
+					r.Get("/bootstrap/{block_root}", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconLightClientBootstrap))
+					r.Get("/updates", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconLightClientUpdates))
+					r.Get("/finality_update", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconLightClientFinalityUpdate))
+					r.Get("/optimistic_update", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconLightClientOptimisticUpdate))
+				})
 				r.Get("/blinded_blocks/{block_id}", beaconhttp.HandleEndpointFunc(a.getBlindedBlock))
+				r.Get("/blob_sidecars/{block_id}", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconBlobSidecars))
 				r.Route("/pool", func(r chi.Router) {
 					r.Get("/voluntary_exits", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconPoolVoluntaryExits))
 					r.Post("/voluntary_exits", a.PostEthV1BeaconPoolVoluntaryExits)
@@ -91,8 +107,8 @@ func (a *ApiHandler) init() {
 					r.Get("/bls_to_execution_changes", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconPoolBLSExecutionChanges))
 					r.Post("/bls_to_execution_changes", a.PostEthV1BeaconPoolBlsToExecutionChanges)
 					r.Get("/attestations", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconPoolAttestations))
-					r.Post("/attestations", http.NotFound)    // TODO
-					r.Post("/sync_committees", http.NotFound) // TODO
+					r.Post("/attestations", a.PostEthV1BeaconPoolAttestations)
+					r.Post("/sync_committees", a.PostEthV1BeaconPoolSyncCommittees)
 				})
 				r.Get("/node/syncing", http.NotFound)
 				r.Route("/states", func(r chi.Router) {
@@ -117,14 +133,14 @@ func (a *ApiHandler) init() {
 					r.Post("/sync/{epoch}", beaconhttp.HandleEndpointFunc(a.getSyncDuties))
 				})
 				r.Get("/blinded_blocks/{slot}", http.NotFound)
-				r.Get("/attestation_data", http.NotFound)
-				r.Get("/aggregate_attestation", http.NotFound)
-				r.Post("/aggregate_and_proofs", http.NotFound)
-				r.Post("/beacon_committee_subscriptions", http.NotFound)
-				r.Post("/sync_committee_subscriptions", http.NotFound)
-				r.Get("/sync_committee_contribution", http.NotFound)
-				r.Post("/contribution_and_proofs", http.NotFound)
-				r.Post("/prepare_beacon_proposer", http.NotFound)
+				r.Get("/attestation_data", beaconhttp.HandleEndpointFunc(a.GetEthV1ValidatorAttestationData))
+				r.Get("/aggregate_attestation", beaconhttp.HandleEndpointFunc(a.GetEthV1ValidatorAggregateAttestation))
+				r.Post("/aggregate_and_proofs", a.PostEthV1ValidatorAggregateAndProofs)
+				r.Post("/beacon_committee_subscriptions", a.PostEthV1ValidatorBeaconCommitteeSubscriptions)
+				r.Post("/sync_committee_subscriptions", a.PostEthV1ValidatorSyncCommitteeSubscriptions)
+				r.Get("/sync_committee_contribution", beaconhttp.HandleEndpointFunc(a.GetEthV1ValidatorSyncCommitteeContribution))
+				r.Post("/contribution_and_proofs", a.PostEthV1ValidatorContributionAndProofs)
+				r.Post("/prepare_beacon_proposer", a.PostEthV1ValidatorPrepareBeaconProposer)
 				r.Post("/liveness/{epoch}", beaconhttp.HandleEndpointFunc(a.liveness))
 			})
 		})
@@ -136,10 +152,11 @@ func (a *ApiHandler) init() {
 				})
 			})
 			r.Route("/beacon", func(r chi.Router) {
+				r.Post("/blocks", a.PostEthV2BeaconBlocks)
 				r.Get("/blocks/{block_id}", beaconhttp.HandleEndpointFunc(a.getBlock))
 			})
 			r.Route("/validator", func(r chi.Router) {
-				r.Post("/blocks/{slot}", http.NotFound)
+				r.Get("/blocks/{slot}", beaconhttp.HandleEndpointFunc(a.GetEthV2ValidatorBlocksSlot))
 			})
 		})
 	})
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
+	"github.com/ledgerwatch/erigon/cl/persistence/beacon_indicies"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication"
+)
+
+func (a *ApiHandler) GetEthV1BeaconLightClientBootstrap(w http.ResponseWriter, r *http.Request) (*beaconResponse, error) {
+	blockRoot, err := hashFromPathParams(r, "block_root")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	// recent blocks are served from forkchoice, finalized ones from the database.
+	if bootstrap, ok := a.forkchoiceStore.GetLightClientBootstrap(*blockRoot); ok {
+		return newBeaconResponse(bootstrap).withVersion(bootstrap.Version()), nil
+	}
+	tx, err := a.indiciesDB.BeginRo(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	bootstrap, err := beacon_indicies.ReadLightClientBootstrap(tx, *blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if bootstrap == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusNotFound, "bootstrap not found")
+	}
+	return newBeaconResponse(bootstrap).withVersion(bootstrap.Version()), nil
+}
+
+func (a *ApiHandler) GetEthV1BeaconLightClientUpdates(w http.ResponseWriter, r *http.Request) ([]*beaconResponse, error) {
+	startPeriod, err := uint64FromQueryParams(r, "start_period")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	if startPeriod == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, "start_period is required")
+	}
+	count, err := uint64FromQueryParams(r, "count")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	if count == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, "count is required")
+	}
+	if *count > communication.MaximumRequestClientUpdates {
+		*count = communication.MaximumRequestClientUpdates
+	}
+
+	tx, err := a.indiciesDB.BeginRo(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	resp := []*beaconResponse{}
+	// the updates must be consecutive, so we stop at the first missing period.
+	for period := *startPeriod; period < *startPeriod+*count; period++ {
+		update, ok := a.forkchoiceStore.GetLightClientUpdate(period)
+		if !ok {
+			if update, err = beacon_indicies.ReadLightClientUpdate(tx, period); err != nil {
+				return nil, err
+			}
+		}
+		if update == nil {
+			break
+		}
+		resp = append(resp, newBeaconResponse(update).withVersion(update.Version()))
+	}
+	return resp, nil
+}
+
+func (a *ApiHandler) GetEthV1BeaconLightClientFinalityUpdate(w http.ResponseWriter, r *http.Request) (*beaconResponse, error) {
+	update := a.forkchoiceStore.GetLightClientFinalityUpdate()
+	if update == nil {
+		tx, err := a.indiciesDB.BeginRo(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback()
+		if update, err = beacon_indicies.ReadLightClientFinalityUpdate(tx); err != nil {
+			return nil, err
+		}
+	}
+	if update == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusNotFound, "no finality update available")
+	}
+	return newBeaconResponse(update).withVersion(update.Version()), nil
+}
+
+func (a *ApiHandler) GetEthV1BeaconLightClientOptimisticUpdate(w http.ResponseWriter, r *http.Request) (*beaconResponse, error) {
+	update := a.forkchoiceStore.GetLightClientOptimisticUpdate()
+	if update == nil {
+		tx, err := a.indiciesDB.BeginRo(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback()
+		if update, err = beacon_indicies.ReadLightClientOptimisticUpdate(tx); err != nil {
+			return nil, err
+		}
+	}
+	if update == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusNotFound, "no optimistic update available")
+	}
+	return newBeaconResponse(update).withVersion(update.Version()), nil
+}
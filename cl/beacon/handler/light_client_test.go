@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/persistence/beacon_indicies"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLightClient(t *testing.T) {
+	db, _, _, _, _, handler, _, _, fcu := setupTestingHandler(t, clparams.Phase0Version)
+
+	bootstrap := cltypes.NewLightClientBootstrap(clparams.AltairVersion)
+	bootstrap.Header.Beacon.Slot = 42
+	fcu.LightClientBootstrapsVal[libcommon.Hash{1}] = bootstrap
+
+	optimisticUpdate := cltypes.NewLightClientOptimisticUpdate(clparams.CapellaVersion)
+	optimisticUpdate.SignatureSlot = 43
+	fcu.LightClientOptimisticUpdateVal = optimisticUpdate
+
+	// the first period is only in the database, the second only in forkchoice.
+	tx, err := db.BeginRw(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback()
+	require.NoError(t, beacon_indicies.WriteLightClientUpdate(tx, 1, cltypes.NewLightClientUpdate(clparams.AltairVersion)))
+	require.NoError(t, tx.Commit())
+	fcu.LightClientUpdatesVal[2] = cltypes.NewLightClientUpdate(clparams.CapellaVersion)
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	cases := []struct {
+		url      string
+		code     int
+		versions []string
+	}{
+		{url: "/eth/v1/beacon/light_client/bootstrap/0x0100000000000000000000000000000000000000000000000000000000000000", code: http.StatusOK, versions: []string{"altair"}},
+		{url: "/eth/v1/beacon/light_client/bootstrap/0x0200000000000000000000000000000000000000000000000000000000000000", code: http.StatusNotFound},
+		{url: "/eth/v1/beacon/light_client/bootstrap/0x02", code: http.StatusBadRequest},
+		{url: "/eth/v1/beacon/light_client/updates?start_period=1&count=5", code: http.StatusOK, versions: []string{"altair", "capella"}},
+		{url: "/eth/v1/beacon/light_client/updates?start_period=1", code: http.StatusBadRequest},
+		{url: "/eth/v1/beacon/light_client/finality_update", code: http.StatusNotFound},
+		{url: "/eth/v1/beacon/light_client/optimistic_update", code: http.StatusOK, versions: []string{"capella"}},
+	}
+	for _, c := range cases {
+		t.Run(c.url, func(t *testing.T) {
+			resp, err := server.Client().Get(server.URL + c.url)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			out, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, c.code, resp.StatusCode, string(out))
+			if c.code != http.StatusOK {
+				return
+			}
+			type versioned struct {
+				Version clparams.StateVersion `json:"version"`
+				Data    json.RawMessage       `json:"data"`
+			}
+			var responses []versioned
+			if out[0] == '[' {
+				require.NoError(t, json.Unmarshal(out, &responses))
+			} else {
+				responses = make([]versioned, 1)
+				require.NoError(t, json.Unmarshal(out, &responses[0]))
+			}
+			require.Len(t, responses, len(c.versions))
+			for i, version := range c.versions {
+				require.Equal(t, version, clparams.ClVersionToString(responses[i].Version))
+				require.NotEmpty(t, responses[i].Data)
+			}
+		})
+	}
+}
@@ -7,8 +7,11 @@ import (
 
 	"github.com/ledgerwatch/erigon-lib/gointerfaces/sentinel"
 	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
+	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
 	"github.com/ledgerwatch/erigon/cl/gossip"
+	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
 )
 
 func (a *ApiHandler) GetEthV1BeaconPoolVoluntaryExits(w http.ResponseWriter, r *http.Request) (*beaconResponse, error) {
@@ -191,3 +194,131 @@ func (a *ApiHandler) PostEthV1BeaconPoolBlsToExecutionChanges(w http.ResponseWri
 	// Only write 200
 	w.WriteHeader(http.StatusOK)
 }
+
+// attestationSubnetCount returns the number of attestation subnets of the network we are running on.
+func (a *ApiHandler) attestationSubnetCount() uint64 {
+	if networkCfg, ok := clparams.NetworkConfigs[clparams.NetworkType(a.beaconChainCfg.DepositNetworkID)]; ok {
+		return networkCfg.AttestationSubnetCount
+	}
+	return clparams.NetworkConfigs[clparams.MainnetNetwork].AttestationSubnetCount
+}
+
+func (a *ApiHandler) PostEthV1BeaconPoolAttestations(w http.ResponseWriter, r *http.Request) {
+	req := []*solid.Attestation{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	headState, cn := a.syncedData.HeadState()
+	if headState == nil {
+		cn()
+		http.Error(w, "beacon node is still syncing", http.StatusServiceUnavailable)
+		return
+	}
+	// compute the subnets upfront so that we do not hold on to the head state while validating and broadcasting.
+	subnets := make([]uint64, len(req))
+	for i, att := range req {
+		data := att.AttestantionData()
+		committeesPerSlot := headState.CommitteeCount(data.Slot() / a.beaconChainCfg.SlotsPerEpoch)
+		subnets[i] = state.ComputeSubnetForAttestation(a.beaconChainCfg, a.attestationSubnetCount(), committeesPerSlot, data.Slot(), data.ValidatorIndex())
+	}
+	cn()
+
+	failures := []poolingFailure{}
+	for i, att := range req {
+		// the length bit is always set, so an unaggregated attestation has exactly two bits set.
+		if bitCount(att.AggregationBits()) != 2 {
+			failures = append(failures, poolingFailure{Index: i, Message: "attestation must have exactly one participant"})
+			continue
+		}
+		if err := a.forkchoiceStore.OnAttestation(att, false, true); err != nil {
+			failures = append(failures, poolingFailure{Index: i, Message: err.Error()})
+			continue
+		}
+		// Broadcast to gossip
+		if a.sentinel != nil {
+			encodedSSZ, err := att.EncodeSSZ(nil)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if _, err := a.sentinel.PublishGossip(r.Context(), &sentinel.GossipData{
+				Data: encodedSSZ,
+				Name: gossip.TopicNameBeaconAttestation(subnets[i]),
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(poolingError{Code: http.StatusBadRequest, Message: "some failures", Failures: failures})
+		return
+	}
+	// Only write 200
+	w.WriteHeader(http.StatusOK)
+}
+
+func (a *ApiHandler) PostEthV1BeaconPoolSyncCommittees(w http.ResponseWriter, r *http.Request) {
+	req := []*cltypes.SyncCommitteeMessage{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	headState, cn := a.syncedData.HeadState()
+	if headState == nil {
+		cn()
+		http.Error(w, "beacon node is still syncing", http.StatusServiceUnavailable)
+		return
+	}
+	// compute the subnets upfront so that we do not hold on to the head state while validating and broadcasting.
+	subnets := make([][]uint64, len(req))
+	subnetErrs := make([]error, len(req))
+	for i, msg := range req {
+		subnets[i], subnetErrs[i] = state.ComputeSubnetsForSyncCommittee(headState, msg.Slot, msg.ValidatorIndex)
+	}
+	cn()
+
+	failures := []poolingFailure{}
+	for i, msg := range req {
+		if subnetErrs[i] != nil {
+			failures = append(failures, poolingFailure{Index: i, Message: subnetErrs[i].Error()})
+			continue
+		}
+		if len(subnets[i]) == 0 {
+			failures = append(failures, poolingFailure{Index: i, Message: fmt.Sprintf("validator %d is not part of the sync committee", msg.ValidatorIndex)})
+			continue
+		}
+		if err := a.forkchoiceStore.OnSyncCommitteeMessage(msg, false); err != nil {
+			failures = append(failures, poolingFailure{Index: i, Message: err.Error()})
+			continue
+		}
+		// Broadcast to gossip
+		if a.sentinel != nil {
+			encodedSSZ, err := msg.EncodeSSZ(nil)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			for _, subnet := range subnets[i] {
+				if _, err := a.sentinel.PublishGossip(r.Context(), &sentinel.GossipData{
+					Data: encodedSSZ,
+					Name: gossip.TopicNameSyncCommittee(subnet),
+				}); err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(poolingError{Code: http.StatusBadRequest, Message: "some failures", Failures: failures})
+		return
+	}
+	// Only write 200
+	w.WriteHeader(http.StatusOK)
+}
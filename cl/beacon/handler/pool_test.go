@@ -3,10 +3,13 @@ package handler
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
@@ -195,3 +198,87 @@ func TestPoolBlsToExecutionChainges(t *testing.T) {
 	require.Equal(t, msg[0], out.Data[0])
 	require.Equal(t, msg[1], out.Data[1])
 }
+
+func TestPoolAttestations(t *testing.T) {
+	// find server
+	_, _, _, _, postState, handler, opPool, syncedData, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	// the subnets are computed against the head state.
+	resp, err := server.Client().Post(server.URL+"/eth/v1/beacon/pool/attestations", "application/json", bytes.NewBufferString("[]"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.NoError(t, syncedData.OnHeadState(postState))
+
+	unaggregated := solid.NewAttestionFromParameters([]byte{0b101}, solid.NewAttestationData(), libcommon.Bytes96{1})
+	aggregated := solid.NewAttestionFromParameters([]byte{0b111}, solid.NewAttestationData(), libcommon.Bytes96{2})
+	req, err := json.Marshal([]*solid.Attestation{unaggregated, aggregated})
+	require.NoError(t, err)
+	resp, err = server.Client().Post(server.URL+"/eth/v1/beacon/pool/attestations", "application/json", bytes.NewBuffer(req))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	out := poolingError{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	require.Len(t, out.Failures, 1)
+	require.Equal(t, 1, out.Failures[0].Index)
+	require.True(t, opPool.AttestationsPool.Has(unaggregated.Signature()))
+	require.False(t, opPool.AttestationsPool.Has(aggregated.Signature()))
+}
+
+func TestPoolSyncCommittees(t *testing.T) {
+	// find server
+	_, _, _, _, postState, handler, opPool, syncedData, _ := setupTestingHandler(t, clparams.BellatrixVersion)
+	require.NoError(t, syncedData.OnHeadState(postState))
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	// pick the first member of the sync committee.
+	memberKey := postState.CurrentSyncCommittee().GetCommittee()[0]
+	validatorIndex := -1
+	postState.ForEachValidator(func(v solid.Validator, idx, _ int) bool {
+		if v.PublicKey() == memberKey {
+			validatorIndex = idx
+			return false
+		}
+		return true
+	})
+	require.NotEqual(t, -1, validatorIndex)
+
+	root := libcommon.Hash{1}
+	msgs := []*cltypes.SyncCommitteeMessage{
+		{Slot: postState.Slot(), BeaconBlockRoot: root, ValidatorIndex: uint64(validatorIndex), Signature: testSignature(t, 1)},
+		{Slot: postState.Slot(), BeaconBlockRoot: root, ValidatorIndex: 1 << 40, Signature: testSignature(t, 2)},
+	}
+	req, err := json.Marshal(msgs)
+	require.NoError(t, err)
+	resp, err := server.Client().Post(server.URL+"/eth/v1/beacon/pool/sync_committees", "application/json", bytes.NewBuffer(req))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	out := poolingError{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	require.Len(t, out.Failures, 1)
+	require.Equal(t, 1, out.Failures[0].Index)
+	require.True(t, opPool.SyncCommitteeMessagesPool.Has(msgs[0].Signature))
+	require.False(t, opPool.SyncCommitteeMessagesPool.Has(msgs[1].Signature))
+
+	// the pooled message is served as part of the subcommittee contribution.
+	resp, err = http.Get(server.URL + "/eth/v1/validator/sync_committee_contribution?slot=" + strconv.FormatUint(postState.Slot(), 10) + "&subcommittee_index=0&beacon_block_root=" + root.Hex())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	contribution := struct {
+		Data struct {
+			AggregationBits hexutility.Bytes `json:"aggregation_bits"`
+		} `json:"data"`
+	}{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&contribution))
+	require.Equal(t, byte(1), contribution.Data.AggregationBits[0]&1)
+}
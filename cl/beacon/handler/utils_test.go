@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/ledgerwatch/erigon-lib/common/datadir"
@@ -9,14 +10,17 @@ import (
 	"github.com/ledgerwatch/erigon-lib/kv/memdb"
 	"github.com/ledgerwatch/erigon/cl/antiquary"
 	"github.com/ledgerwatch/erigon/cl/antiquary/tests"
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
 	"github.com/ledgerwatch/erigon/cl/beacon/synced_data"
 	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/cl/persistence"
+	"github.com/ledgerwatch/erigon/cl/persistence/blob_storage"
 	state_accessors "github.com/ledgerwatch/erigon/cl/persistence/state"
 	"github.com/ledgerwatch/erigon/cl/persistence/state/historical_states_reader"
 	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
 	"github.com/ledgerwatch/erigon/cl/phase1/forkchoice"
+	"github.com/ledgerwatch/erigon/cl/phase1/network"
 	"github.com/ledgerwatch/erigon/cl/pool"
 	"github.com/ledgerwatch/log/v3"
 	"github.com/spf13/afero"
@@ -52,6 +56,7 @@ func setupTestingHandler(t *testing.T, v clparams.StateVersion) (db kv.RwDB, blo
 	fcu.Pool = opPool
 	syncedData = synced_data.NewSyncedDataManager(true, &bcfg)
 	gC := clparams.GenesisConfigs[clparams.MainnetNetwork]
+	gossipManager := network.NewGossipReceiver(nil, nil, &bcfg, &gC, nil, nil)
 	handler = NewApiHandler(
 		&gC,
 		&bcfg,
@@ -63,6 +68,9 @@ func setupTestingHandler(t *testing.T, v clparams.StateVersion) (db kv.RwDB, blo
 		syncedData,
 		statesReader,
 		nil,
+		gossipManager,
+		beaconevents.NewEmitters(),
+		blob_storage.NewBlobStore(memdb.NewTestDB(t), afero.NewMemMapFs(), math.MaxUint64, &bcfg),
 		"test-version")
 	handler.init()
 	return
@@ -0,0 +1,403 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"net/http"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/gointerfaces/sentinel"
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
+	"github.com/ledgerwatch/erigon/cl/beacon/building"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/gossip"
+	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
+	"github.com/ledgerwatch/erigon/cl/transition"
+	"github.com/ledgerwatch/erigon/cl/utils"
+)
+
+// headStateAtSlot returns a copy of the head state, advanced to the given slot if the head is behind it.
+func (a *ApiHandler) headStateAtSlot(slot uint64) (*state.CachingBeaconState, libcommon.Hash, error) {
+	headRoot, _, err := a.forkchoiceStore.GetHead()
+	if err != nil {
+		return nil, libcommon.Hash{}, err
+	}
+	s, err := a.forkchoiceStore.GetStateAtBlockRoot(headRoot, true)
+	if err != nil {
+		return nil, libcommon.Hash{}, err
+	}
+	if s == nil {
+		return nil, libcommon.Hash{}, beaconhttp.NewEndpointError(http.StatusServiceUnavailable, "beacon node is still syncing")
+	}
+	if s.Slot() < slot {
+		if err := transition.DefaultMachine.ProcessSlots(s, slot); err != nil {
+			return nil, libcommon.Hash{}, err
+		}
+	}
+	return s, headRoot, nil
+}
+
+func (a *ApiHandler) GetEthV1ValidatorAttestationData(w http.ResponseWriter, r *http.Request) (*beaconResponse, error) {
+	slot, err := uint64FromQueryParams(r, "slot")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	committeeIndex, err := uint64FromQueryParams(r, "committee_index")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	if slot == nil || committeeIndex == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, "slot and committee_index are required")
+	}
+	_, headSlot, err := a.forkchoiceStore.GetHead()
+	if err != nil {
+		return nil, err
+	}
+	epoch := *slot / a.beaconChainCfg.SlotsPerEpoch
+	if epoch < headSlot/a.beaconChainCfg.SlotsPerEpoch {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, "cannot produce attestation data for a past epoch")
+	}
+	epochStartSlot := epoch * a.beaconChainCfg.SlotsPerEpoch
+	// we only need the justification of the target epoch, so advancing to its first slot is enough.
+	s, headRoot, err := a.headStateAtSlot(epochStartSlot)
+	if err != nil {
+		return nil, err
+	}
+	if *committeeIndex >= s.CommitteeCount(epoch) {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, fmt.Sprintf("committee index %d out of range", *committeeIndex))
+	}
+
+	beaconBlockRoot := headRoot
+	if *slot < headSlot {
+		beaconBlockRoot = a.forkchoiceStore.Ancestor(headRoot, *slot)
+	}
+	targetRoot := headRoot
+	if epochStartSlot <= headSlot {
+		targetRoot = a.forkchoiceStore.Ancestor(headRoot, epochStartSlot)
+	}
+	return newBeaconResponse(solid.NewAttestionDataFromParameters(
+		*slot,
+		*committeeIndex,
+		beaconBlockRoot,
+		s.CurrentJustifiedCheckpoint(),
+		solid.NewCheckpointFromParameters(targetRoot, epoch),
+	)), nil
+}
+
+// bitlistOverlaps checks whether two bitlists of the same length have any bit in common, ignoring the length bit.
+func bitlistOverlaps(a, b []byte) bool {
+	length := utils.GetBitlistLength(a)
+	for i := 0; i < length; i++ {
+		if a[i/8]&b[i/8]&(1<<(i%8)) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func bitCount(b []byte) (count int) {
+	for _, x := range b {
+		count += bits.OnesCount8(x)
+	}
+	return
+}
+
+// aggregateAttestations greedily merges the given attestations, which must all share the same data, into the
+// largest aggregate with no overlapping participants.
+func aggregateAttestations(atts []*solid.Attestation) (*solid.Attestation, error) {
+	var best *solid.Attestation
+	for _, att := range atts {
+		if best == nil || bitCount(att.AggregationBits()) > bitCount(best.AggregationBits()) {
+			best = att
+		}
+	}
+	aggregationBits := libcommon.Copy(best.AggregationBits())
+	signature := best.Signature()
+	signatures := [][]byte{signature[:]}
+	for _, att := range atts {
+		if att == best || len(att.AggregationBits()) != len(aggregationBits) || bitlistOverlaps(aggregationBits, att.AggregationBits()) {
+			continue
+		}
+		for i, b := range att.AggregationBits() {
+			aggregationBits[i] |= b
+		}
+		attSignature := att.Signature()
+		signatures = append(signatures, attSignature[:])
+	}
+	aggregatedSignature, err := utils.AggregateSignatures(signatures)
+	if err != nil {
+		return nil, err
+	}
+	return solid.NewAttestionFromParameters(aggregationBits, best.AttestantionData(), aggregatedSignature), nil
+}
+
+func (a *ApiHandler) GetEthV1ValidatorAggregateAttestation(w http.ResponseWriter, r *http.Request) (*beaconResponse, error) {
+	attestationDataRoot, err := hashFromQueryParams(r, "attestation_data_root")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	slot, err := uint64FromQueryParams(r, "slot")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	if attestationDataRoot == nil || slot == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, "attestation_data_root and slot are required")
+	}
+	var matching []*solid.Attestation
+	for _, att := range a.operationsPool.AttestationsPool.Raw() {
+		data := att.AttestantionData()
+		if data.Slot() != *slot {
+			continue
+		}
+		dataRoot, err := data.HashSSZ()
+		if err != nil {
+			return nil, err
+		}
+		if dataRoot == *attestationDataRoot {
+			matching = append(matching, att)
+		}
+	}
+	if len(matching) == 0 {
+		return nil, beaconhttp.NewEndpointError(http.StatusNotFound, "no matching attestation found")
+	}
+	aggregate, err := aggregateAttestations(matching)
+	if err != nil {
+		return nil, err
+	}
+	return newBeaconResponse(aggregate), nil
+}
+
+func (a *ApiHandler) PostEthV1ValidatorAggregateAndProofs(w http.ResponseWriter, r *http.Request) {
+	req := []*cltypes.SignedAggregateAndProof{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	failures := []poolingFailure{}
+	for i, v := range req {
+		if v.Message == nil || v.Message.Aggregate == nil {
+			failures = append(failures, poolingFailure{Index: i, Message: "missing aggregate"})
+			continue
+		}
+		if err := a.forkchoiceStore.OnAggregateAndProof(v, false); err != nil {
+			failures = append(failures, poolingFailure{Index: i, Message: err.Error()})
+			continue
+		}
+		// Broadcast to gossip
+		if a.sentinel != nil {
+			encodedSSZ, err := v.EncodeSSZ(nil)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if _, err := a.sentinel.PublishGossip(r.Context(), &sentinel.GossipData{
+				Data: encodedSSZ,
+				Name: gossip.TopicNameBeaconAggregateAndProof,
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(poolingError{Code: http.StatusBadRequest, Message: "some failures", Failures: failures})
+		return
+	}
+	// Only write 200
+	w.WriteHeader(http.StatusOK)
+}
+
+func (a *ApiHandler) PostEthV1ValidatorBeaconCommitteeSubscriptions(w http.ResponseWriter, r *http.Request) {
+	req := []building.BeaconCommitteeSubscription{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, sub := range req {
+		a.builderState.AddBeaconCommitteeSubscription(sub)
+	}
+	a.builderState.PruneSubscriptions(utils.GetCurrentSlot(a.genesisCfg.GenesisTime, a.beaconChainCfg.SecondsPerSlot), a.beaconChainCfg.SlotsPerEpoch)
+	// Only write 200
+	w.WriteHeader(http.StatusOK)
+}
+
+func (a *ApiHandler) PostEthV1ValidatorSyncCommitteeSubscriptions(w http.ResponseWriter, r *http.Request) {
+	req := []building.SyncCommitteeSubscription{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, sub := range req {
+		a.builderState.AddSyncCommitteeSubscription(sub)
+	}
+	a.builderState.PruneSubscriptions(utils.GetCurrentSlot(a.genesisCfg.GenesisTime, a.beaconChainCfg.SecondsPerSlot), a.beaconChainCfg.SlotsPerEpoch)
+	// Only write 200
+	w.WriteHeader(http.StatusOK)
+}
+
+// aggregateContributions merges the given contributions, which must all share slot, root and subcommittee,
+// skipping the ones whose participants overlap with what was already aggregated.
+func aggregateContributions(contributions []*solid.Contribution) (*solid.Contribution, error) {
+	var best *solid.Contribution
+	var bestBits [16]byte
+	for _, c := range contributions {
+		if bits := c.AggregationBits(); best == nil || bitCount(bits[:]) > bitCount(bestBits[:]) {
+			best, bestBits = c, bits
+		}
+	}
+	aggregationBits := bestBits
+	signature := best.Signature()
+	signatures := [][]byte{signature[:]}
+	for _, c := range contributions {
+		if c == best {
+			continue
+		}
+		bits := c.AggregationBits()
+		overlap := false
+		for i := range bits {
+			if bits[i]&aggregationBits[i] != 0 {
+				overlap = true
+				break
+			}
+		}
+		if overlap {
+			continue
+		}
+		for i := range bits {
+			aggregationBits[i] |= bits[i]
+		}
+		contributionSignature := c.Signature()
+		signatures = append(signatures, contributionSignature[:])
+	}
+	aggregatedSignature, err := utils.AggregateSignatures(signatures)
+	if err != nil {
+		return nil, err
+	}
+	return solid.NewContributionFromParameters(best.Slot(), best.BeaconBlockRoot(), best.SubcommitteeIndex(), aggregationBits, aggregatedSignature), nil
+}
+
+// pooledContributions returns the pooled contributions for the given slot and block root, grouped by subcommittee.
+// If a state is given, the pooled sync committee messages are included as single participant contributions.
+func (a *ApiHandler) pooledContributions(s *state.CachingBeaconState, slot uint64, root libcommon.Hash) map[uint64][]*solid.Contribution {
+	out := map[uint64][]*solid.Contribution{}
+	for _, signed := range a.operationsPool.ContributionsPool.Raw() {
+		c := signed.Message.Contribution
+		if c.Slot() != slot || c.BeaconBlockRoot() != root {
+			continue
+		}
+		out[c.SubcommitteeIndex()] = append(out[c.SubcommitteeIndex()], c)
+	}
+	if s == nil {
+		return out
+	}
+	subcommitteeSize := a.beaconChainCfg.SyncCommitteeSize / a.beaconChainCfg.SyncCommitteeSubnetCount
+	for _, msg := range a.operationsPool.SyncCommitteeMessagesPool.Raw() {
+		if msg.Slot != slot || msg.BeaconBlockRoot != root {
+			continue
+		}
+		positions, err := state.SyncCommitteePositions(s, slot, msg.ValidatorIndex)
+		if err != nil {
+			continue
+		}
+		for _, position := range positions {
+			subcommitteeIndex, index := position/subcommitteeSize, position%subcommitteeSize
+			var aggregationBits [16]byte
+			aggregationBits[index/8] |= 1 << (index % 8)
+			out[subcommitteeIndex] = append(out[subcommitteeIndex], solid.NewContributionFromParameters(slot, root, subcommitteeIndex, aggregationBits, msg.Signature))
+		}
+	}
+	return out
+}
+
+func (a *ApiHandler) GetEthV1ValidatorSyncCommitteeContribution(w http.ResponseWriter, r *http.Request) (*beaconResponse, error) {
+	slot, err := uint64FromQueryParams(r, "slot")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	subcommitteeIndex, err := uint64FromQueryParams(r, "subcommittee_index")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	beaconBlockRoot, err := hashFromQueryParams(r, "beacon_block_root")
+	if err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err.Error())
+	}
+	if slot == nil || subcommitteeIndex == nil || beaconBlockRoot == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, "slot, subcommittee_index and beacon_block_root are required")
+	}
+	if *subcommitteeIndex >= a.beaconChainCfg.SyncCommitteeSubnetCount {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, fmt.Sprintf("subcommittee index %d out of range", *subcommitteeIndex))
+	}
+	headState, cn := a.syncedData.HeadState()
+	contributions := a.pooledContributions(headState, *slot, *beaconBlockRoot)[*subcommitteeIndex]
+	cn()
+	if len(contributions) == 0 {
+		return nil, beaconhttp.NewEndpointError(http.StatusNotFound, "no matching contribution found")
+	}
+	contribution, err := aggregateContributions(contributions)
+	if err != nil {
+		return nil, err
+	}
+	return newBeaconResponse(contribution), nil
+}
+
+func (a *ApiHandler) PostEthV1ValidatorContributionAndProofs(w http.ResponseWriter, r *http.Request) {
+	req := []*cltypes.SignedContributionAndProof{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	failures := []poolingFailure{}
+	for i, v := range req {
+		if v.Message == nil || v.Message.Contribution == nil {
+			failures = append(failures, poolingFailure{Index: i, Message: "missing contribution"})
+			continue
+		}
+		if err := a.forkchoiceStore.OnSignedContributionAndProof(v, false); err != nil {
+			failures = append(failures, poolingFailure{Index: i, Message: err.Error()})
+			continue
+		}
+		// Broadcast to gossip
+		if a.sentinel != nil {
+			encodedSSZ, err := v.EncodeSSZ(nil)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if _, err := a.sentinel.PublishGossip(r.Context(), &sentinel.GossipData{
+				Data: encodedSSZ,
+				Name: gossip.TopicNameSyncCommitteeContributionAndProof,
+			}); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(poolingError{Code: http.StatusBadRequest, Message: "some failures", Failures: failures})
+		return
+	}
+	// Only write 200
+	w.WriteHeader(http.StatusOK)
+}
+
+func (a *ApiHandler) PostEthV1ValidatorPrepareBeaconProposer(w http.ResponseWriter, r *http.Request) {
+	req := []building.PrepareBeaconProposer{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, x := range req {
+		a.builderState.SetFeeRecipient(x.ValidatorIndex, x.FeeRecipient)
+	}
+	// validators prepare their proposals every epoch, which is the time to catch up with the deposit contract.
+	go a.syncDeposits()
+	// Only write 200
+	w.WriteHeader(http.StatusOK)
+}
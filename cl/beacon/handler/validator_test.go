@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/Giulio2002/bls"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/utils"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+func testSignature(t *testing.T, seed byte) libcommon.Bytes96 {
+	ikm := make([]byte, 32)
+	ikm[0] = seed
+	var signature libcommon.Bytes96
+	copy(signature[:], new(blst.P2Affine).Sign(blst.KeyGen(ikm), []byte("test"), []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")).Compress())
+	return signature
+}
+
+func TestValidatorAttestationData(t *testing.T) {
+	_, _, _, _, postState, handler, _, _, fcu := setupTestingHandler(t, clparams.Phase0Version)
+
+	headRoot := libcommon.Hash{1}
+	targetRoot := libcommon.Hash{2}
+	headSlot := postState.Slot()
+	epoch := headSlot / 32
+	fcu.HeadVal = headRoot
+	fcu.HeadSlotVal = headSlot
+	fcu.StateAtBlockRootVal[headRoot] = postState
+	fcu.Ancestors[epoch*32] = targetRoot
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/eth/v1/validator/attestation_data?slot=" + strconv.FormatUint(headSlot, 10) + "&committee_index=0")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	out := struct {
+		Data solid.AttestationData `json:"data"`
+	}{Data: solid.NewAttestationData()}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	require.Equal(t, headSlot, out.Data.Slot())
+	require.Equal(t, uint64(0), out.Data.ValidatorIndex())
+	require.Equal(t, headRoot, out.Data.BeaconBlockRoot())
+	require.Equal(t, postState.CurrentJustifiedCheckpoint(), out.Data.Source())
+	require.Equal(t, solid.NewCheckpointFromParameters(targetRoot, epoch), out.Data.Target())
+
+	for _, query := range []string{"", "?slot=1", "?slot=abc&committee_index=0", "?slot=1&committee_index=0"} {
+		resp, err := http.Get(server.URL + "/eth/v1/validator/attestation_data" + query)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+	}
+}
+
+func TestValidatorAggregateAttestation(t *testing.T) {
+	_, _, _, _, _, handler, opPool, _, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	data := solid.NewAttestionDataFromParameters(5, 1, libcommon.Hash{1}, solid.NewCheckpoint(), solid.NewCheckpoint())
+	dataRoot, err := data.HashSSZ()
+	require.NoError(t, err)
+	// bitlists of 4 participants, the last set bit is the length bit.
+	one := solid.NewAttestionFromParameters([]byte{0b10001}, data, testSignature(t, 1))
+	two := solid.NewAttestionFromParameters([]byte{0b10110}, data, testSignature(t, 2))
+	overlapping := solid.NewAttestionFromParameters([]byte{0b10010}, data, testSignature(t, 3))
+	for _, att := range []*solid.Attestation{one, two, overlapping} {
+		opPool.AttestationsPool.Insert(att.Signature(), att)
+	}
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/eth/v1/validator/aggregate_attestation?slot=5&attestation_data_root=" + libcommon.Hash(dataRoot).Hex())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	out := struct {
+		Data *solid.Attestation `json:"data"`
+	}{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	oneSignature, twoSignature := one.Signature(), two.Signature()
+	expectedSignature, err := utils.AggregateSignatures([][]byte{twoSignature[:], oneSignature[:]})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0b10111}, out.Data.AggregationBits())
+	require.Equal(t, libcommon.Bytes96(expectedSignature), libcommon.Bytes96(out.Data.Signature()))
+	require.Equal(t, data, out.Data.AttestantionData())
+
+	resp, err = http.Get(server.URL + "/eth/v1/validator/aggregate_attestation?slot=6&attestation_data_root=" + libcommon.Hash(dataRoot).Hex())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestValidatorSyncCommitteeContribution(t *testing.T) {
+	_, _, _, _, _, handler, opPool, _, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	root := libcommon.Hash{1}
+	for i, bits := range [][16]byte{{0b0011}, {0b0100}, {0b0110}} {
+		contribution := solid.NewContributionFromParameters(7, root, 2, bits, testSignature(t, byte(i+1)))
+		opPool.ContributionsPool.Insert(contribution.Signature(), &cltypes.SignedContributionAndProof{
+			Message: &cltypes.ContributionAndProof{Contribution: contribution},
+		})
+	}
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/eth/v1/validator/sync_committee_contribution?slot=7&subcommittee_index=2&beacon_block_root=" + root.Hex())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	out := struct {
+		Data struct {
+			Slot              uint64            `json:"slot,string"`
+			SubcommitteeIndex uint64            `json:"subcommittee_index,string"`
+			AggregationBits   hexutility.Bytes  `json:"aggregation_bits"`
+			Signature         libcommon.Bytes96 `json:"signature"`
+		} `json:"data"`
+	}{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	require.Equal(t, uint64(7), out.Data.Slot)
+	require.Equal(t, uint64(2), out.Data.SubcommitteeIndex)
+	// {0b0011} and {0b0100} do not overlap, while {0b0110} overlaps with both.
+	require.Equal(t, byte(0b0111), out.Data.AggregationBits[0])
+
+	resp, err = http.Get(server.URL + "/eth/v1/validator/sync_committee_contribution?slot=7&subcommittee_index=1&beacon_block_root=" + root.Hex())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestValidatorPostAggregateAndProofs(t *testing.T) {
+	_, _, _, _, _, handler, opPool, _, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	aggregate := solid.NewAttestionFromParameters([]byte{0b11}, solid.NewAttestationData(), libcommon.Bytes96{1})
+	req, err := json.Marshal([]*cltypes.SignedAggregateAndProof{{
+		Message: &cltypes.AggregateAndProof{AggregatorIndex: 3, Aggregate: aggregate},
+	}})
+	require.NoError(t, err)
+	resp, err := server.Client().Post(server.URL+"/eth/v1/validator/aggregate_and_proofs", "application/json", bytes.NewBuffer(req))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.True(t, opPool.AttestationsPool.Has(aggregate.Signature()))
+}
+
+func TestValidatorPostContributionAndProofs(t *testing.T) {
+	_, _, _, _, _, handler, opPool, _, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	signed := &cltypes.SignedContributionAndProof{
+		Message: &cltypes.ContributionAndProof{
+			AggregatorIndex: 3,
+			Contribution:    solid.NewContributionFromParameters(1, libcommon.Hash{1}, 0, [16]byte{1}, libcommon.Bytes96{2}),
+		},
+		Signature: libcommon.Bytes96{3},
+	}
+	req, err := json.Marshal([]*cltypes.SignedContributionAndProof{signed})
+	require.NoError(t, err)
+	resp, err := server.Client().Post(server.URL+"/eth/v1/validator/contribution_and_proofs", "application/json", bytes.NewBuffer(req))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.True(t, opPool.ContributionsPool.Has(signed.Signature))
+}
+
+func TestValidatorPrepareBeaconProposerAndSubscriptions(t *testing.T) {
+	_, _, _, _, _, handler, _, _, _ := setupTestingHandler(t, clparams.Phase0Version)
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	resp, err := server.Client().Post(server.URL+"/eth/v1/validator/prepare_beacon_proposer", "application/json",
+		bytes.NewBufferString(`[{"validator_index":"1","fee_recipient":"0xabcf8e0d4e9587369b2301d0790347320302cc09"}]`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	feeRecipient, ok := handler.builderState.FeeRecipient(1)
+	require.True(t, ok)
+	require.Equal(t, libcommon.HexToAddress("0xabcf8e0d4e9587369b2301d0790347320302cc09"), feeRecipient)
+
+	// subscriptions for slots in the past are pruned right away
+	resp, err = server.Client().Post(server.URL+"/eth/v1/validator/beacon_committee_subscriptions", "application/json",
+		bytes.NewBufferString(`[{"validator_index":"1","committee_index":"2","committees_at_slot":"4","slot":"1000000000","is_aggregator":true},{"validator_index":"2","committee_index":"2","committees_at_slot":"4","slot":"1","is_aggregator":false}]`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	sub, ok := handler.builderState.BeaconCommitteeSubscription(1)
+	require.True(t, ok)
+	require.True(t, sub.IsAggregator)
+	_, ok = handler.builderState.BeaconCommitteeSubscription(2)
+	require.False(t, ok)
+
+	resp, err = server.Client().Post(server.URL+"/eth/v1/validator/sync_committee_subscriptions", "application/json",
+		bytes.NewBufferString(`[{"validator_index":"1","sync_committee_indices":["0","2"],"until_epoch":"100000000"}]`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	syncSub, ok := handler.builderState.SyncCommitteeSubscription(1)
+	require.True(t, ok)
+	require.Len(t, syncSub.SyncCommitteeIndices, 2)
+}
+
+func TestValidatorProduceBlockPhase0(t *testing.T) {
+	_, _, _, _, postState, handler, _, _, fcu := setupTestingHandler(t, clparams.Phase0Version)
+
+	headRoot, err := postState.BlockRoot()
+	require.NoError(t, err)
+	headSlot := postState.Slot()
+	fcu.HeadVal = headRoot
+	fcu.HeadSlotVal = headSlot
+	fcu.StateAtBlockRootVal[headRoot] = postState
+
+	server := httptest.NewServer(handler.mux)
+	defer server.Close()
+
+	infinity := libcommon.Bytes96(bls.InfiniteSignature)
+	slot := strconv.FormatUint(headSlot+1, 10)
+	resp, err := http.Get(server.URL + "/eth/v2/validator/blocks/" + slot + "?skip_randao_verification&graffiti=0x0102&randao_reveal=" + hexutility.Bytes(infinity[:]).String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "phase0", resp.Header.Get("Eth-Consensus-Version"))
+
+	out := struct {
+		Version string               `json:"version"`
+		Data    *cltypes.BeaconBlock `json:"data"`
+	}{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	require.Equal(t, "phase0", out.Version)
+	require.Equal(t, headSlot+1, out.Data.Slot)
+	require.Equal(t, libcommon.Hash(headRoot), out.Data.ParentRoot)
+	require.Equal(t, libcommon.Hash{1, 2}, out.Data.Body.Graffiti)
+	require.NotEqual(t, libcommon.Hash{}, out.Data.StateRoot)
+
+	// the randao reveal must be the point at infinity when its verification is skipped.
+	resp, err = http.Get(server.URL + "/eth/v2/validator/blocks/" + slot + "?skip_randao_verification&randao_reveal=" + hexutility.Bytes(make([]byte, 96)).String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -6,10 +6,8 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/gfx-labs/sse"
 	"github.com/go-chi/chi/v5"
 	"github.com/ledgerwatch/erigon-lib/common"
-	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
 	"github.com/ledgerwatch/erigon/cl/clparams"
@@ -214,52 +212,3 @@ func (v *ValidatorApiHandler) GetEthV1EthNodeSyncing(w http.ResponseWriter, r *h
 	}
 	return o, nil
 }
-func (v *ValidatorApiHandler) GetEthV3ValidatorBlocksSlot(w http.ResponseWriter, r *http.Request) (any, error) {
-	// TODO: populate this map
-	o := map[string]any{
-		"data": map[string]any{},
-	}
-
-	slotString := chi.URLParam(r, "slot")
-	slot, err := strconv.ParseUint(slotString, 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("fail to parse slot: %w", err)
-	}
-	randaoRevealString := r.URL.Query().Get("randao_reveal")
-	randaoReveal, err := hexutil.Decode(randaoRevealString)
-	if err != nil {
-		return nil, fmt.Errorf("fail to parse randao_reveal: %w", err)
-	}
-	graffitiString := r.URL.Query().Get("randao_reveal")
-	if graffitiString == "" {
-		graffitiString = "0x"
-	}
-	graffiti, err := hexutil.Decode(graffitiString)
-	if err != nil {
-		return nil, fmt.Errorf("fail to parse graffiti: %w", err)
-	}
-	skip_randao_verification := r.URL.Query().Has("skip_randao_verification")
-	//if skip_randao_verification {
-	//  if isInfinity(randaoReveal) {
-	//   return nil, beaconhttp.NewEndpointError(400, "randao reveal must be set to infinity if skip randao verification is set")
-	//  }
-	//}
-	_, _, _, _ = slot, graffiti, randaoReveal, skip_randao_verification
-	return o, nil
-}
-
-func (v *ValidatorApiHandler) EventSourceGetV1Events(w http.ResponseWriter, r *http.Request) {
-	sink, err := sse.DefaultUpgrader.Upgrade(w, r)
-	if err != nil {
-		// OK to ignore this error.
-		return
-	}
-	topics := r.URL.Query()["topics"]
-	for _, topic := range topics {
-		sink.Encode(&sse.Event{
-			Event: []byte(topic),
-			Data:  nil,
-		})
-		// OK to ignore this error. maybe should log it later
-	}
-}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/ledgerwatch/erigon/cl/beacon/beaconhttp"
-	"github.com/ledgerwatch/erigon/cl/beacon/building"
 	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/phase1/forkchoice"
 )
@@ -17,8 +16,6 @@ type ValidatorApiHandler struct {
 	BeaconChainCfg *clparams.BeaconChainConfig
 	GenesisCfg     *clparams.GenesisConfig
 
-	state *building.State
-
 	o   sync.Once
 	mux *chi.Mux
 }
@@ -26,7 +23,6 @@ type ValidatorApiHandler struct {
 func (v *ValidatorApiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	v.o.Do(func() {
 		v.mux = chi.NewRouter()
-		v.state = building.NewState()
 		v.Route(v.mux)
 	})
 	v.mux.ServeHTTP(w, r)
@@ -42,17 +38,17 @@ func (v *ValidatorApiHandler) Route(r chi.Router) {
 						// r.Get("/validators/{validator_id}", beaconhttp.HandleEndpointFunc(v.GetEthV1BeaconStatesStateIdValidatorsValidatorId))
 					})
 				})
-				r.Post("/blocks", beaconhttp.HandleEndpointFunc(v.PostEthV1BeaconBlocks))
 				r.Post("/blinded_blocks", beaconhttp.HandleEndpointFunc(v.PostEthV1BeaconBlindedBlocks))
-				r.Route("/pool", func(r chi.Router) {
-					r.Post("/attestations", beaconhttp.HandleEndpointFunc(v.PostEthV1BeaconPoolAttestations))
-					r.Post("/sync_committees", beaconhttp.HandleEndpointFunc(v.PostEthV1BeaconPoolAttestations))
-				})
+				// implemented by archive api (for now)
+				//	r.Post("/blocks", http.NotFound)
+				//	r.Route("/pool", func(r chi.Router) {
+				//		r.Post("/attestations", http.NotFound)
+				//		r.Post("/sync_committees", http.NotFound)
+				//	})
 			})
 			r.Route("/node", func(r chi.Router) {
 				r.Get("/syncing", beaconhttp.HandleEndpointFunc(v.GetEthV1NodeSyncing))
 			})
-			r.Get("/events", v.EventSourceGetV1Events)
 			r.Route("/validator", func(r chi.Router) {
 				// implemented by archive api (for now)
 				//		r.Route("/duties", func(r chi.Router) {
@@ -61,14 +57,14 @@ func (v *ValidatorApiHandler) Route(r chi.Router) {
 				//			r.Get("/proposer/{epoch}", http.NotFound)
 				//		})
 				//		r.Get("/blinded_blocks/{slot}", http.NotFound) - deprecated
-				r.Get("/attestation_data", http.NotFound)
-				r.Get("/aggregate_attestation", http.NotFound)
-				r.Post("/aggregate_and_proofs", beaconhttp.HandleEndpointFunc(v.PostEthV1ValidatorAggregateAndProofs))
-				r.Post("/beacon_committee_subscriptions", beaconhttp.HandleEndpointFunc(v.PostEthV1ValidatorBeaconCommitteeSubscriptions))
-				r.Post("/sync_committee_subscriptions", beaconhttp.HandleEndpointFunc(v.PostEthV1ValidatorSyncCommitteeSubscriptions))
-				r.Get("/sync_committee_contribution", http.NotFound)
-				r.Post("/contribution_and_proofs", beaconhttp.HandleEndpointFunc(v.PostEthV1ValidatorContributionAndProofs))
-				r.Post("/prepare_beacon_proposer", beaconhttp.HandleEndpointFunc(v.PostEthV1ValidatorPrepareBeaconProposer))
+				//		r.Get("/attestation_data", http.NotFound)
+				//		r.Get("/aggregate_attestation", http.NotFound)
+				//		r.Post("/aggregate_and_proofs", http.NotFound)
+				//		r.Post("/beacon_committee_subscriptions", http.NotFound)
+				//		r.Post("/sync_committee_subscriptions", http.NotFound)
+				//		r.Get("/sync_committee_contribution", http.NotFound)
+				//		r.Post("/contribution_and_proofs", http.NotFound)
+				//		r.Post("/prepare_beacon_proposer", http.NotFound)
 			})
 		})
 		r.Route("/v2", func(r chi.Router) {
@@ -78,12 +74,13 @@ func (v *ValidatorApiHandler) Route(r chi.Router) {
 				})
 			})
 			r.Route("/beacon", func(r chi.Router) {
-				r.Post("/blocks", beaconhttp.HandleEndpointFunc(v.PostEthV2BeaconBlocks))
+				// r.Post("/blocks", http.NotFound) - implemented by archive api (for now)
 				r.Post("/blinded_blocks", beaconhttp.HandleEndpointFunc(v.PostEthV2BeaconBlindedBlocks))
 			})
-			r.Route("/validator", func(r chi.Router) {
-				r.Post("/blocks/{slot}", beaconhttp.HandleEndpointFunc(v.GetEthV3ValidatorBlocksSlot))
-			})
+			// implemented by archive api (for now)
+			//	r.Route("/validator", func(r chi.Router) {
+			//		r.Get("/blocks/{slot}", http.NotFound)
+			//	})
 		})
 		r.Route("/v3", func(r chi.Router) {
 			r.Route("/validator", func(r chi.Router) {
@@ -482,6 +482,12 @@ type BeaconChainConfig struct {
 	// Light client
 	MinSyncCommitteeParticipants uint64 `yaml:"MIN_SYNC_COMMITTEE_PARTICIPANTS" spec:"true"` // MinSyncCommitteeParticipants defines the minimum amount of sync committee participants for which the light client acknowledges the signature.
 
+	// Deneb
+	MaxBlobsPerBlock                 uint64 `yaml:"MAX_BLOBS_PER_BLOCK" spec:"true"`                   // MaxBlobsPerBlock defines the maximum number of blob sidecars attached to a single block.
+	MaxRequestBlobSidecars           uint64 `yaml:"MAX_REQUEST_BLOB_SIDECARS" spec:"true"`             // MaxRequestBlobSidecars is the maximum number of blob sidecars in a single request.
+	MinEpochsForBlobSidecarsRequests uint64 `yaml:"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS" spec:"true"` // MinEpochsForBlobSidecarsRequests is the minimum number of epochs for which blob sidecars are served.
+	BlobSidecarSubnetCount           uint64 `yaml:"BLOB_SIDECAR_SUBNET_COUNT" spec:"true"`             // BlobSidecarSubnetCount is the number of blob sidecar subnets used in the gossipsub protocol.
+
 	// Bellatrix
 	TerminalBlockHash                libcommon.Hash    `yaml:"TERMINAL_BLOCK_HASH" spec:"true"`                  // TerminalBlockHash of beacon chain.
 	TerminalBlockHashActivationEpoch uint64            `yaml:"TERMINAL_BLOCK_HASH_ACTIVATION_EPOCH" spec:"true"` // TerminalBlockHashActivationEpoch of beacon chain.
@@ -745,6 +751,12 @@ var MainnetBeaconConfig BeaconChainConfig = BeaconChainConfig{
 	// Light client
 	MinSyncCommitteeParticipants: 1,
 
+	// Deneb
+	MaxBlobsPerBlock:                 6,
+	MaxRequestBlobSidecars:           768,
+	MinEpochsForBlobSidecarsRequests: 4096,
+	BlobSidecarSubnetCount:           6,
+
 	// Bellatrix
 	TerminalBlockHashActivationEpoch: 18446744073709551615,
 	TerminalBlockHash:                [32]byte{},
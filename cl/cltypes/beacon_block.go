@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/length"
 	"github.com/ledgerwatch/erigon-lib/types/ssz"
 
 	"github.com/ledgerwatch/erigon/cl/clparams"
@@ -231,6 +232,42 @@ func (b *BeaconBody) HashSSZ() ([32]byte, error) {
 	return merkle_tree.HashTreeRoot(b.getSchema(false)...)
 }
 
+// ExecutionBranch returns the merkle branch of the execution payload (the 10th field of the body) against the body root.
+func (b *BeaconBody) ExecutionBranch() ([][32]byte, error) {
+	return merkle_tree.MerkleProof(ExecutionBranchSize, 9, b.getSchema(false)...)
+}
+
+// KzgCommitmentInclusionProof returns the merkle branch of the kzg commitment at the given index against the body root.
+func (b *BeaconBody) KzgCommitmentInclusionProof(index int) ([][32]byte, error) {
+	if b.Version < clparams.DenebVersion || index >= b.BlobKzgCommitments.Len() {
+		return nil, fmt.Errorf("no kzg commitment at index %d", index)
+	}
+	leaves := make([]byte, MaxBlobsCommittmentsPerBlock*length.Hash)
+	var err error
+	b.BlobKzgCommitments.Range(func(i int, commitment *KZGCommitment, _ int) bool {
+		var root [32]byte
+		if root, err = commitment.HashSSZ(); err != nil {
+			return false
+		}
+		copy(leaves[i*length.Hash:], root[:])
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	branch, err := merkle_tree.MerkleProofFromFlatLeaves(leaves, index)
+	if err != nil {
+		return nil, err
+	}
+	// the length of the list is mixed in the list root.
+	branch = append(branch, merkle_tree.Uint64Root(uint64(b.BlobKzgCommitments.Len())))
+	bodyBranch, err := merkle_tree.MerkleProof(KzgCommitmentInclusionProofDepth-blobKzgCommitmentsListDepth-1, blobKzgCommitmentsBodyIndex, b.getSchema(false)...)
+	if err != nil {
+		return nil, err
+	}
+	return append(branch, bodyBranch...), nil
+}
+
 func (b *BeaconBody) getSchema(storage bool) []interface{} {
 	s := []interface{}{b.RandaoReveal[:], b.Eth1Data, b.Graffiti[:], b.ProposerSlashings, b.AttesterSlashings, b.Attestations, b.Deposits, b.VoluntaryExits}
 	if b.Version >= clparams.AltairVersion {
@@ -2,9 +2,11 @@ package cltypes
 
 import (
 	"encoding/json"
+	"fmt"
 
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 	"github.com/ledgerwatch/erigon/cl/merkle_tree"
 	ssz2 "github.com/ledgerwatch/erigon/cl/ssz"
 )
@@ -47,3 +49,27 @@ func (b *KZGCommitment) EncodingSizeSSZ() int {
 func (b *KZGCommitment) HashSSZ() ([32]byte, error) {
 	return merkle_tree.BytesRoot(b[:])
 }
+
+func (b Blob) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hexutility.Bytes(b[:]))
+}
+
+func (b *Blob) UnmarshalJSON(data []byte) error {
+	var hex hexutility.Bytes
+	if err := json.Unmarshal(data, &hex); err != nil {
+		return err
+	}
+	if len(hex) != len(b) {
+		return fmt.Errorf("invalid blob length %d, expected %d", len(hex), len(b))
+	}
+	copy(b[:], hex)
+	return nil
+}
+
+func (b KZGProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal(libcommon.Bytes48(b))
+}
+
+func (b *KZGProof) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*libcommon.Bytes48)(b))
+}
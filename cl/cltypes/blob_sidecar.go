@@ -0,0 +1,135 @@
+package cltypes
+
+import (
+	"encoding/json"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/length"
+	"github.com/ledgerwatch/erigon-lib/types/clonable"
+
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/merkle_tree"
+	ssz2 "github.com/ledgerwatch/erigon/cl/ssz"
+	"github.com/ledgerwatch/erigon/cl/utils"
+)
+
+const (
+	// KzgCommitmentInclusionProofDepth is the depth of the branch proving a kzg commitment against the body root.
+	KzgCommitmentInclusionProofDepth = 17
+	// depth of the blob_kzg_commitments list, log2(MaxBlobsCommittmentsPerBlock).
+	blobKzgCommitmentsListDepth = 12
+	// position of blob_kzg_commitments in the deneb body.
+	blobKzgCommitmentsBodyIndex = 11
+)
+
+/*
+ * BlobSidecar carries a blob and its KZG commitment and proof, together with the header of the block which commits
+ * to it and the branch proving the commitment against that block's body.
+ */
+type BlobSidecar struct {
+	Index                    uint64                   `json:"index,string"`
+	Blob                     Blob                     `json:"blob"`
+	KzgCommitment            KZGCommitment            `json:"kzg_commitment"`
+	KzgProof                 KZGProof                 `json:"kzg_proof"`
+	SignedBlockHeader        *SignedBeaconBlockHeader `json:"signed_block_header"`
+	CommitmentInclusionProof solid.HashVectorSSZ      `json:"kzg_commitment_inclusion_proof"`
+}
+
+func NewBlobSidecar(index uint64, blob *Blob, kzgCommitment KZGCommitment, kzgProof KZGProof, signedBlockHeader *SignedBeaconBlockHeader, commitmentInclusionProof solid.HashVectorSSZ) *BlobSidecar {
+	return &BlobSidecar{
+		Index:                    index,
+		Blob:                     *blob,
+		KzgCommitment:            kzgCommitment,
+		KzgProof:                 kzgProof,
+		SignedBlockHeader:        signedBlockHeader,
+		CommitmentInclusionProof: commitmentInclusionProof,
+	}
+}
+
+func (b *BlobSidecar) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, b.getSchema()...)
+}
+
+func (b *BlobSidecar) DecodeSSZ(buf []byte, version int) error {
+	b.SignedBlockHeader = &SignedBeaconBlockHeader{Header: &BeaconBlockHeader{}}
+	b.CommitmentInclusionProof = solid.NewHashVector(KzgCommitmentInclusionProofDepth)
+	return ssz2.UnmarshalSSZ(buf, version, b.getSchema()...)
+}
+
+func (b *BlobSidecar) EncodingSizeSSZ() int {
+	return length.BlockNum + int(BYTES_PER_BLOB) + length.Bytes48*2 + (&SignedBeaconBlockHeader{Header: &BeaconBlockHeader{}}).EncodingSizeSSZ() +
+		KzgCommitmentInclusionProofDepth*length.Hash
+}
+
+func (b *BlobSidecar) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(b.getSchema()...)
+}
+
+func (*BlobSidecar) Static() bool {
+	return true
+}
+
+func (b *BlobSidecar) Clone() clonable.Clonable {
+	return &BlobSidecar{}
+}
+
+func (b *BlobSidecar) UnmarshalJSON(buf []byte) error {
+	type blobSidecar BlobSidecar
+	tmp := (*blobSidecar)(b)
+	tmp.CommitmentInclusionProof = solid.NewHashVector(KzgCommitmentInclusionProofDepth)
+	return json.Unmarshal(buf, tmp)
+}
+
+func (b *BlobSidecar) getSchema() []interface{} {
+	return []interface{}{&b.Index, b.Blob[:], b.KzgCommitment[:], b.KzgProof[:], b.SignedBlockHeader, b.CommitmentInclusionProof}
+}
+
+// VerifyCommitmentInclusionProof checks that the kzg commitment of the sidecar is part of the body of its block header.
+func (b *BlobSidecar) VerifyCommitmentInclusionProof() bool {
+	if b.SignedBlockHeader == nil || b.SignedBlockHeader.Header == nil || b.CommitmentInclusionProof == nil ||
+		b.CommitmentInclusionProof.Length() != KzgCommitmentInclusionProofDepth {
+		return false
+	}
+	leaf, err := b.KzgCommitment.HashSSZ()
+	if err != nil {
+		return false
+	}
+	branch := make([]libcommon.Hash, KzgCommitmentInclusionProofDepth)
+	for i := range branch {
+		branch[i] = b.CommitmentInclusionProof.Get(i)
+	}
+	index := uint64(blobKzgCommitmentsBodyIndex)<<(blobKzgCommitmentsListDepth+1) + b.Index
+	return utils.IsValidMerkleBranch(leaf, branch, KzgCommitmentInclusionProofDepth, index, b.SignedBlockHeader.Header.BodyRoot)
+}
+
+/*
+ * BlobIdentifier is the key of the blob_sidecars_by_root requests.
+ */
+type BlobIdentifier struct {
+	BlockRoot libcommon.Hash `json:"block_root"`
+	Index     uint64         `json:"index,string"`
+}
+
+func (b *BlobIdentifier) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, b.BlockRoot[:], b.Index)
+}
+
+func (b *BlobIdentifier) DecodeSSZ(buf []byte, version int) error {
+	return ssz2.UnmarshalSSZ(buf, version, b.BlockRoot[:], &b.Index)
+}
+
+func (b *BlobIdentifier) EncodingSizeSSZ() int {
+	return length.Hash + length.BlockNum
+}
+
+func (b *BlobIdentifier) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(b.BlockRoot[:], b.Index)
+}
+
+func (*BlobIdentifier) Static() bool {
+	return true
+}
+
+func (*BlobIdentifier) Clone() clonable.Clonable {
+	return &BlobIdentifier{}
+}
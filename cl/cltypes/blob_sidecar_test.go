@@ -0,0 +1,77 @@
+package cltypes_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/core/types"
+)
+
+func TestBlobSidecarInclusionProof(t *testing.T) {
+	body := cltypes.NewBeaconBody(&clparams.MainnetBeaconConfig)
+	body.Version = clparams.DenebVersion
+	blobGasUsed, excessBlobGas := uint64(0), uint64(0)
+	body.ExecutionPayload = cltypes.NewEth1BlockFromHeaderAndBody(&types.Header{
+		Number:        big.NewInt(1),
+		BaseFee:       big.NewInt(1),
+		BlobGasUsed:   &blobGasUsed,
+		ExcessBlobGas: &excessBlobGas,
+	}, &types.RawBody{}, &clparams.MainnetBeaconConfig)
+	body.EncodingSizeSSZ() // allocates the empty fields
+	for i := 0; i < 3; i++ {
+		body.BlobKzgCommitments.Append(&cltypes.KZGCommitment{byte(i + 1)})
+	}
+	bodyRoot, err := body.HashSSZ()
+	require.NoError(t, err)
+	header := &cltypes.SignedBeaconBlockHeader{Header: &cltypes.BeaconBlockHeader{Slot: 42, BodyRoot: bodyRoot}}
+
+	for i := 0; i < 3; i++ {
+		branch, err := body.KzgCommitmentInclusionProof(i)
+		require.NoError(t, err)
+		require.Len(t, branch, cltypes.KzgCommitmentInclusionProofDepth)
+		proof := solid.NewHashVector(cltypes.KzgCommitmentInclusionProofDepth)
+		for j := range branch {
+			proof.Set(j, branch[j])
+		}
+		sidecar := cltypes.NewBlobSidecar(uint64(i), &cltypes.Blob{byte(i)}, *body.BlobKzgCommitments.Get(i), cltypes.KZGProof{}, header, proof)
+		require.True(t, sidecar.VerifyCommitmentInclusionProof())
+
+		// the proof must not hold for another index
+		sidecar.Index = uint64((i + 1) % 3)
+		require.False(t, sidecar.VerifyCommitmentInclusionProof())
+	}
+	_, err = body.KzgCommitmentInclusionProof(3)
+	require.Error(t, err)
+}
+
+func TestBlobSidecarEncoding(t *testing.T) {
+	proof := solid.NewHashVector(cltypes.KzgCommitmentInclusionProofDepth)
+	proof.Set(16, [32]byte{9})
+	sidecar := cltypes.NewBlobSidecar(1, &cltypes.Blob{1, 2, 3}, cltypes.KZGCommitment{4}, cltypes.KZGProof{5},
+		&cltypes.SignedBeaconBlockHeader{Header: &cltypes.BeaconBlockHeader{Slot: 42}}, proof)
+	expectedRoot, err := sidecar.HashSSZ()
+	require.NoError(t, err)
+
+	encoded, err := sidecar.EncodeSSZ(nil)
+	require.NoError(t, err)
+	require.Len(t, encoded, sidecar.EncodingSizeSSZ())
+	decoded := &cltypes.BlobSidecar{}
+	require.NoError(t, decoded.DecodeSSZ(encoded, int(clparams.DenebVersion)))
+	root, err := decoded.HashSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, root)
+
+	encodedJSON, err := json.Marshal(sidecar)
+	require.NoError(t, err)
+	decoded = &cltypes.BlobSidecar{}
+	require.NoError(t, json.Unmarshal(encodedJSON, decoded))
+	root, err = decoded.HashSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, root)
+}
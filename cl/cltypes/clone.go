@@ -44,6 +44,22 @@ func (*SignedAggregateAndProof) Clone() clonable.Clonable {
 	return &SignedAggregateAndProof{}
 }
 
+func (*ContributionAndProof) Clone() clonable.Clonable {
+	return &ContributionAndProof{}
+}
+
+func (*SignedContributionAndProof) Clone() clonable.Clonable {
+	return &SignedContributionAndProof{}
+}
+
+func (*SyncAggregatorSelectionData) Clone() clonable.Clonable {
+	return &SyncAggregatorSelectionData{}
+}
+
+func (*SyncCommitteeMessage) Clone() clonable.Clonable {
+	return &SyncCommitteeMessage{}
+}
+
 func (*SyncAggregate) Clone() clonable.Clonable {
 	return &SyncAggregate{}
 }
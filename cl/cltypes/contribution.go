@@ -22,7 +22,7 @@ func (a *ContributionAndProof) EncodeSSZ(dst []byte) ([]byte, error) {
 }
 
 func (a *ContributionAndProof) Static() bool {
-	return false
+	return true
 }
 
 func (a *ContributionAndProof) DecodeSSZ(buf []byte, version int) error {
@@ -31,7 +31,7 @@ func (a *ContributionAndProof) DecodeSSZ(buf []byte, version int) error {
 }
 
 func (a *ContributionAndProof) EncodingSizeSSZ() int {
-	return 108 + a.Contribution.EncodingSizeSSZ()
+	return 104 + a.Contribution.EncodingSizeSSZ()
 }
 
 func (a *ContributionAndProof) HashSSZ() ([32]byte, error) {
@@ -43,6 +43,10 @@ type SignedContributionAndProof struct {
 	Signature libcommon.Bytes96     `json:"signature"`
 }
 
+func (a *SignedContributionAndProof) Static() bool {
+	return true
+}
+
 func (a *SignedContributionAndProof) EncodeSSZ(dst []byte) ([]byte, error) {
 	return ssz2.MarshalSSZ(dst, a.Message, a.Signature[:])
 }
@@ -53,7 +57,7 @@ func (a *SignedContributionAndProof) DecodeSSZ(buf []byte, version int) error {
 }
 
 func (a *SignedContributionAndProof) EncodingSizeSSZ() int {
-	return 100 + a.Message.EncodingSizeSSZ()
+	return 96 + a.Message.EncodingSizeSSZ()
 }
 
 func (a *SignedContributionAndProof) HashSSZ() ([32]byte, error) {
@@ -109,3 +113,57 @@ func (agg *SyncContribution) HashSSZ() ([32]byte, error) {
 	return merkle_tree.HashTreeRoot(agg.SyncCommiteeBits[:], agg.SyncCommiteeSignature[:])
 
 }
+
+// SyncAggregatorSelectionData is the message signed by a sync committee member to prove it is an aggregator.
+type SyncAggregatorSelectionData struct {
+	Slot              uint64 `json:"slot,string"`
+	SubcommitteeIndex uint64 `json:"subcommittee_index,string"`
+}
+
+func (a *SyncAggregatorSelectionData) EncodeSSZ(dst []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(dst, a.Slot, a.SubcommitteeIndex)
+}
+
+func (a *SyncAggregatorSelectionData) Static() bool {
+	return true
+}
+
+func (a *SyncAggregatorSelectionData) DecodeSSZ(buf []byte, version int) error {
+	return ssz2.UnmarshalSSZ(buf, version, &a.Slot, &a.SubcommitteeIndex)
+}
+
+func (a *SyncAggregatorSelectionData) EncodingSizeSSZ() int {
+	return 16
+}
+
+func (a *SyncAggregatorSelectionData) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(a.Slot, a.SubcommitteeIndex)
+}
+
+// SyncCommitteeMessage is the vote of a single sync committee member for the block root at the given slot.
+type SyncCommitteeMessage struct {
+	Slot            uint64            `json:"slot,string"`
+	BeaconBlockRoot libcommon.Hash    `json:"beacon_block_root"`
+	ValidatorIndex  uint64            `json:"validator_index,string"`
+	Signature       libcommon.Bytes96 `json:"signature"`
+}
+
+func (m *SyncCommitteeMessage) EncodeSSZ(dst []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(dst, m.Slot, m.BeaconBlockRoot[:], m.ValidatorIndex, m.Signature[:])
+}
+
+func (m *SyncCommitteeMessage) Static() bool {
+	return true
+}
+
+func (m *SyncCommitteeMessage) DecodeSSZ(buf []byte, version int) error {
+	return ssz2.UnmarshalSSZ(buf, version, &m.Slot, m.BeaconBlockRoot[:], &m.ValidatorIndex, m.Signature[:])
+}
+
+func (m *SyncCommitteeMessage) EncodingSizeSSZ() int {
+	return 144
+}
+
+func (m *SyncCommitteeMessage) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(m.Slot, m.BeaconBlockRoot[:], m.ValidatorIndex, m.Signature[:])
+}
@@ -0,0 +1,70 @@
+package cltypes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedContributionAndProof(t *testing.T) {
+	root := libcommon.HexToHash("0x0102")
+	bits := [16]byte{0xff, 1}
+	signature := libcommon.Bytes96{9, 8, 7}
+	contribution := solid.NewContributionFromParameters(42, root, 3, bits, signature)
+	require.Equal(t, uint64(42), contribution.Slot())
+	require.Equal(t, root, contribution.BeaconBlockRoot())
+	require.Equal(t, uint64(3), contribution.SubcommitteeIndex())
+	require.Equal(t, bits, contribution.AggregationBits())
+	require.Equal(t, signature, contribution.Signature())
+
+	signed := &cltypes.SignedContributionAndProof{
+		Message: &cltypes.ContributionAndProof{
+			AggregatorIndex: 7,
+			SelectionProof:  libcommon.Bytes96{1},
+			Contribution:    contribution,
+		},
+		Signature: libcommon.Bytes96{2},
+	}
+	encoded, err := signed.EncodeSSZ(nil)
+	require.NoError(t, err)
+	require.Len(t, encoded, 360)
+	require.Equal(t, signed.EncodingSizeSSZ(), len(encoded))
+
+	decoded := &cltypes.SignedContributionAndProof{}
+	require.NoError(t, decoded.DecodeSSZ(encoded, 0))
+	require.Equal(t, signed, decoded)
+
+	expectedRoot, err := signed.HashSSZ()
+	require.NoError(t, err)
+	haveRoot, err := decoded.HashSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, haveRoot)
+}
+
+func TestSyncCommitteeMessage(t *testing.T) {
+	msg := &cltypes.SyncCommitteeMessage{
+		Slot:            42,
+		BeaconBlockRoot: libcommon.HexToHash("0x0102"),
+		ValidatorIndex:  7,
+		Signature:       libcommon.Bytes96{9, 8, 7},
+	}
+	encoded, err := msg.EncodeSSZ(nil)
+	require.NoError(t, err)
+	require.Len(t, encoded, msg.EncodingSizeSSZ())
+
+	decoded := &cltypes.SyncCommitteeMessage{}
+	require.NoError(t, decoded.DecodeSSZ(encoded, 0))
+	require.Equal(t, msg, decoded)
+
+	encodedJSON, err := json.Marshal(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(encodedJSON), `"validator_index":"7"`)
+	decoded = &cltypes.SyncCommitteeMessage{}
+	require.NoError(t, json.Unmarshal(encodedJSON, decoded))
+	require.Equal(t, msg, decoded)
+}
@@ -1,6 +1,8 @@
 package cltypes
 
 import (
+	"encoding/json"
+
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
 	"github.com/ledgerwatch/erigon/cl/merkle_tree"
@@ -23,6 +25,18 @@ func NewIndexedAttestation() *IndexedAttestation {
 	}
 }
 
+// UnmarshalJSON allocates the attestation data first, as it can only be decoded in place.
+func (i *IndexedAttestation) UnmarshalJSON(buf []byte) error {
+	type indexedAttestation IndexedAttestation
+	if i.AttestingIndices == nil {
+		i.AttestingIndices = solid.NewRawUint64List(2048, nil)
+	}
+	if i.Data == nil {
+		i.Data = solid.NewAttestationData()
+	}
+	return json.Unmarshal(buf, (*indexedAttestation)(i))
+}
+
 func (i *IndexedAttestation) Static() bool {
 	return false
 }
@@ -0,0 +1,310 @@
+package cltypes
+
+import (
+	"github.com/ledgerwatch/erigon-lib/types/clonable"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/merkle_tree"
+	ssz2 "github.com/ledgerwatch/erigon/cl/ssz"
+)
+
+// Depth of the merkle branches carried by the light client objects.
+const (
+	ExecutionBranchSize     = 4
+	SyncCommitteeBranchSize = 5
+	FinalityBranchSize      = 6
+)
+
+/*
+ * LightClientHeader is the header light clients follow, from capella onwards it
+ * also proves the execution payload header against the body root.
+ */
+type LightClientHeader struct {
+	Beacon          *BeaconBlockHeader  `json:"beacon"`
+	ExecutionHeader *Eth1Header         `json:"execution,omitempty"`
+	ExecutionBranch solid.HashVectorSSZ `json:"execution_branch,omitempty"`
+
+	version clparams.StateVersion
+}
+
+func NewLightClientHeader(version clparams.StateVersion) *LightClientHeader {
+	if version < clparams.CapellaVersion {
+		return &LightClientHeader{
+			version: version,
+			Beacon:  &BeaconBlockHeader{},
+		}
+	}
+	return &LightClientHeader{
+		version:         version,
+		Beacon:          &BeaconBlockHeader{},
+		ExecutionHeader: NewEth1Header(version),
+		ExecutionBranch: solid.NewHashVector(ExecutionBranchSize),
+	}
+}
+
+func (l *LightClientHeader) Version() clparams.StateVersion {
+	return l.version
+}
+
+func (l *LightClientHeader) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, l.getSchema()...)
+}
+
+func (l *LightClientHeader) DecodeSSZ(buf []byte, version int) error {
+	*l = *NewLightClientHeader(clparams.StateVersion(version))
+	return ssz2.UnmarshalSSZ(buf, version, l.getSchema()...)
+}
+
+func (l *LightClientHeader) EncodingSizeSSZ() int {
+	size := l.Beacon.EncodingSizeSSZ()
+	if l.version >= clparams.CapellaVersion {
+		size += l.ExecutionHeader.EncodingSizeSSZ() + 4 // the extra 4 is for the offset
+		size += l.ExecutionBranch.EncodingSizeSSZ()
+	}
+	return size
+}
+
+func (l *LightClientHeader) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(l.getSchema()...)
+}
+
+func (l *LightClientHeader) Static() bool {
+	return l.version < clparams.CapellaVersion
+}
+
+func (l *LightClientHeader) Clone() clonable.Clonable {
+	return NewLightClientHeader(l.version)
+}
+
+func (l *LightClientHeader) getSchema() []interface{} {
+	schema := []interface{}{l.Beacon}
+	if l.version >= clparams.CapellaVersion {
+		schema = append(schema, l.ExecutionHeader, l.ExecutionBranch)
+	}
+	return schema
+}
+
+// lightClientHeaderSizeSSZ returns the size taken by the header within its container.
+func lightClientHeaderSizeSSZ(h *LightClientHeader) int {
+	if h.Static() {
+		return h.EncodingSizeSSZ()
+	}
+	return h.EncodingSizeSSZ() + 4
+}
+
+/*
+ * LightClientBootstrap is served to light clients starting from a trusted block root,
+ * it proves the sync committee they start following.
+ */
+type LightClientBootstrap struct {
+	Header                     *LightClientHeader   `json:"header"`
+	CurrentSyncCommittee       *solid.SyncCommittee `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch solid.HashVectorSSZ  `json:"current_sync_committee_branch"`
+}
+
+func NewLightClientBootstrap(version clparams.StateVersion) *LightClientBootstrap {
+	return &LightClientBootstrap{
+		Header:                     NewLightClientHeader(version),
+		CurrentSyncCommittee:       &solid.SyncCommittee{},
+		CurrentSyncCommitteeBranch: solid.NewHashVector(SyncCommitteeBranchSize),
+	}
+}
+
+func (l *LightClientBootstrap) Version() clparams.StateVersion {
+	return l.Header.Version()
+}
+
+func (l *LightClientBootstrap) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, l.Header, l.CurrentSyncCommittee, l.CurrentSyncCommitteeBranch)
+}
+
+func (l *LightClientBootstrap) DecodeSSZ(buf []byte, version int) error {
+	*l = *NewLightClientBootstrap(clparams.StateVersion(version))
+	return ssz2.UnmarshalSSZ(buf, version, l.Header, l.CurrentSyncCommittee, l.CurrentSyncCommitteeBranch)
+}
+
+func (l *LightClientBootstrap) EncodingSizeSSZ() int {
+	return lightClientHeaderSizeSSZ(l.Header) + l.CurrentSyncCommittee.EncodingSizeSSZ() + l.CurrentSyncCommitteeBranch.EncodingSizeSSZ()
+}
+
+func (l *LightClientBootstrap) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(l.Header, l.CurrentSyncCommittee, l.CurrentSyncCommitteeBranch)
+}
+
+func (l *LightClientBootstrap) Static() bool {
+	return l.Header.Static()
+}
+
+func (l *LightClientBootstrap) Clone() clonable.Clonable {
+	if l == nil || l.Header == nil {
+		return &LightClientBootstrap{}
+	}
+	return NewLightClientBootstrap(l.Version())
+}
+
+/*
+ * LightClientUpdate carries the next sync committee and the finalized header attested by
+ * the sync committee, light clients use it to move from one sync committee period to the next.
+ */
+type LightClientUpdate struct {
+	AttestedHeader          *LightClientHeader   `json:"attested_header"`
+	NextSyncCommittee       *solid.SyncCommittee `json:"next_sync_committee"`
+	NextSyncCommitteeBranch solid.HashVectorSSZ  `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader   `json:"finalized_header"`
+	FinalityBranch          solid.HashVectorSSZ  `json:"finality_branch"`
+	SyncAggregate           *SyncAggregate       `json:"sync_aggregate"`
+	SignatureSlot           uint64               `json:"signature_slot,string"`
+}
+
+func NewLightClientUpdate(version clparams.StateVersion) *LightClientUpdate {
+	return &LightClientUpdate{
+		AttestedHeader:          NewLightClientHeader(version),
+		NextSyncCommittee:       &solid.SyncCommittee{},
+		NextSyncCommitteeBranch: solid.NewHashVector(SyncCommitteeBranchSize),
+		FinalizedHeader:         NewLightClientHeader(version),
+		FinalityBranch:          solid.NewHashVector(FinalityBranchSize),
+		SyncAggregate:           &SyncAggregate{},
+	}
+}
+
+func (l *LightClientUpdate) Version() clparams.StateVersion {
+	return l.AttestedHeader.Version()
+}
+
+func (l *LightClientUpdate) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, l.getSchema()...)
+}
+
+func (l *LightClientUpdate) DecodeSSZ(buf []byte, version int) error {
+	*l = *NewLightClientUpdate(clparams.StateVersion(version))
+	return ssz2.UnmarshalSSZ(buf, version, l.getSchema()...)
+}
+
+func (l *LightClientUpdate) EncodingSizeSSZ() int {
+	return lightClientHeaderSizeSSZ(l.AttestedHeader) + l.NextSyncCommittee.EncodingSizeSSZ() + l.NextSyncCommitteeBranch.EncodingSizeSSZ() +
+		lightClientHeaderSizeSSZ(l.FinalizedHeader) + l.FinalityBranch.EncodingSizeSSZ() + l.SyncAggregate.EncodingSizeSSZ() + 8
+}
+
+func (l *LightClientUpdate) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(l.getSchema()...)
+}
+
+func (l *LightClientUpdate) Static() bool {
+	return l.AttestedHeader.Static()
+}
+
+func (l *LightClientUpdate) Clone() clonable.Clonable {
+	if l == nil || l.AttestedHeader == nil {
+		return &LightClientUpdate{}
+	}
+	return NewLightClientUpdate(l.Version())
+}
+
+func (l *LightClientUpdate) getSchema() []interface{} {
+	return []interface{}{l.AttestedHeader, l.NextSyncCommittee, l.NextSyncCommitteeBranch, l.FinalizedHeader, l.FinalityBranch, l.SyncAggregate, &l.SignatureSlot}
+}
+
+/*
+ * LightClientFinalityUpdate is the latest finalized header attested by the sync committee.
+ */
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *LightClientHeader  `json:"attested_header"`
+	FinalizedHeader *LightClientHeader  `json:"finalized_header"`
+	FinalityBranch  solid.HashVectorSSZ `json:"finality_branch"`
+	SyncAggregate   *SyncAggregate      `json:"sync_aggregate"`
+	SignatureSlot   uint64              `json:"signature_slot,string"`
+}
+
+func NewLightClientFinalityUpdate(version clparams.StateVersion) *LightClientFinalityUpdate {
+	return &LightClientFinalityUpdate{
+		AttestedHeader:  NewLightClientHeader(version),
+		FinalizedHeader: NewLightClientHeader(version),
+		FinalityBranch:  solid.NewHashVector(FinalityBranchSize),
+		SyncAggregate:   &SyncAggregate{},
+	}
+}
+
+func (l *LightClientFinalityUpdate) Version() clparams.StateVersion {
+	return l.AttestedHeader.Version()
+}
+
+func (l *LightClientFinalityUpdate) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, l.getSchema()...)
+}
+
+func (l *LightClientFinalityUpdate) DecodeSSZ(buf []byte, version int) error {
+	*l = *NewLightClientFinalityUpdate(clparams.StateVersion(version))
+	return ssz2.UnmarshalSSZ(buf, version, l.getSchema()...)
+}
+
+func (l *LightClientFinalityUpdate) EncodingSizeSSZ() int {
+	return lightClientHeaderSizeSSZ(l.AttestedHeader) + lightClientHeaderSizeSSZ(l.FinalizedHeader) + l.FinalityBranch.EncodingSizeSSZ() +
+		l.SyncAggregate.EncodingSizeSSZ() + 8
+}
+
+func (l *LightClientFinalityUpdate) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(l.getSchema()...)
+}
+
+func (l *LightClientFinalityUpdate) Static() bool {
+	return l.AttestedHeader.Static()
+}
+
+func (l *LightClientFinalityUpdate) Clone() clonable.Clonable {
+	if l == nil || l.AttestedHeader == nil {
+		return &LightClientFinalityUpdate{}
+	}
+	return NewLightClientFinalityUpdate(l.Version())
+}
+
+func (l *LightClientFinalityUpdate) getSchema() []interface{} {
+	return []interface{}{l.AttestedHeader, l.FinalizedHeader, l.FinalityBranch, l.SyncAggregate, &l.SignatureSlot}
+}
+
+/*
+ * LightClientOptimisticUpdate is the latest header attested by the sync committee.
+ */
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *LightClientHeader `json:"attested_header"`
+	SyncAggregate  *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot  uint64             `json:"signature_slot,string"`
+}
+
+func NewLightClientOptimisticUpdate(version clparams.StateVersion) *LightClientOptimisticUpdate {
+	return &LightClientOptimisticUpdate{
+		AttestedHeader: NewLightClientHeader(version),
+		SyncAggregate:  &SyncAggregate{},
+	}
+}
+
+func (l *LightClientOptimisticUpdate) Version() clparams.StateVersion {
+	return l.AttestedHeader.Version()
+}
+
+func (l *LightClientOptimisticUpdate) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, l.AttestedHeader, l.SyncAggregate, &l.SignatureSlot)
+}
+
+func (l *LightClientOptimisticUpdate) DecodeSSZ(buf []byte, version int) error {
+	*l = *NewLightClientOptimisticUpdate(clparams.StateVersion(version))
+	return ssz2.UnmarshalSSZ(buf, version, l.AttestedHeader, l.SyncAggregate, &l.SignatureSlot)
+}
+
+func (l *LightClientOptimisticUpdate) EncodingSizeSSZ() int {
+	return lightClientHeaderSizeSSZ(l.AttestedHeader) + l.SyncAggregate.EncodingSizeSSZ() + 8
+}
+
+func (l *LightClientOptimisticUpdate) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(l.AttestedHeader, l.SyncAggregate, &l.SignatureSlot)
+}
+
+func (l *LightClientOptimisticUpdate) Static() bool {
+	return l.AttestedHeader.Static()
+}
+
+func (l *LightClientOptimisticUpdate) Clone() clonable.Clonable {
+	if l == nil || l.AttestedHeader == nil {
+		return &LightClientOptimisticUpdate{}
+	}
+	return NewLightClientOptimisticUpdate(l.Version())
+}
@@ -0,0 +1,47 @@
+package cltypes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+)
+
+func TestLightClientUpdateEncoding(t *testing.T) {
+	for _, version := range []clparams.StateVersion{clparams.AltairVersion, clparams.CapellaVersion, clparams.DenebVersion} {
+		update := cltypes.NewLightClientUpdate(version)
+		update.AttestedHeader.Beacon.Slot = 8192
+		update.FinalizedHeader.Beacon.Slot = 8128
+		update.FinalityBranch.Set(3, libcommon.Hash{1})
+		update.SignatureSlot = 8193
+		if version >= clparams.CapellaVersion {
+			update.AttestedHeader.ExecutionHeader.BlockNumber = 42
+			update.AttestedHeader.ExecutionBranch.Set(0, libcommon.Hash{2})
+		}
+
+		encoded, err := update.EncodeSSZ(nil)
+		require.NoError(t, err)
+		require.Len(t, encoded, update.EncodingSizeSSZ())
+		decoded := &cltypes.LightClientUpdate{}
+		require.NoError(t, decoded.DecodeSSZ(encoded, int(version)))
+		require.Equal(t, version, decoded.Version())
+
+		expectedRoot, err := update.HashSSZ()
+		require.NoError(t, err)
+		haveRoot, err := decoded.HashSSZ()
+		require.NoError(t, err)
+		require.Equal(t, expectedRoot, haveRoot)
+
+		encodedJSON, err := json.Marshal(update)
+		require.NoError(t, err)
+		decoded = cltypes.NewLightClientUpdate(version)
+		require.NoError(t, json.Unmarshal(encodedJSON, decoded))
+		haveRoot, err = decoded.HashSSZ()
+		require.NoError(t, err)
+		require.Equal(t, expectedRoot, haveRoot)
+	}
+}
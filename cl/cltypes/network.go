@@ -105,3 +105,51 @@ func (s *Status) DecodeSSZ(buf []byte, version int) error {
 func (s *Status) EncodingSizeSSZ() int {
 	return 84
 }
+
+/*
+ * LightClientUpdatesByRangeRequest is the request for the best light client updates of a range of sync committee periods.
+ */
+type LightClientUpdatesByRangeRequest struct {
+	StartPeriod uint64
+	Count       uint64
+}
+
+func (l *LightClientUpdatesByRangeRequest) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, l.StartPeriod, l.Count)
+}
+
+func (l *LightClientUpdatesByRangeRequest) DecodeSSZ(buf []byte, v int) error {
+	return ssz2.UnmarshalSSZ(buf, v, &l.StartPeriod, &l.Count)
+}
+
+func (l *LightClientUpdatesByRangeRequest) EncodingSizeSSZ() int {
+	return 2 * 8
+}
+
+func (*LightClientUpdatesByRangeRequest) Clone() clonable.Clonable {
+	return &LightClientUpdatesByRangeRequest{}
+}
+
+/*
+ * BlobsByRangeRequest is the request for getting the blob sidecars of a range of slots.
+ */
+type BlobsByRangeRequest struct {
+	StartSlot uint64
+	Count     uint64
+}
+
+func (b *BlobsByRangeRequest) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, b.StartSlot, b.Count)
+}
+
+func (b *BlobsByRangeRequest) DecodeSSZ(buf []byte, v int) error {
+	return ssz2.UnmarshalSSZ(buf, v, &b.StartSlot, &b.Count)
+}
+
+func (b *BlobsByRangeRequest) EncodingSizeSSZ() int {
+	return 2 * 8
+}
+
+func (*BlobsByRangeRequest) Clone() clonable.Clonable {
+	return &BlobsByRangeRequest{}
+}
@@ -25,6 +25,11 @@ var testBlockRangeRequest = &cltypes.BeaconBlocksByRangeRequest{
 	Count:     666,
 }
 
+var testLightClientUpdatesByRangeRequest = &cltypes.LightClientUpdatesByRangeRequest{
+	StartPeriod: 100,
+	Count:       10,
+}
+
 var testStatus = &cltypes.Status{
 	FinalizedEpoch: 666,
 	HeadSlot:       94,
@@ -46,6 +51,7 @@ func TestMarshalNetworkTypes(t *testing.T) {
 		testPing,
 		testBlockRangeRequest,
 		testStatus,
+		testLightClientUpdatesByRangeRequest,
 	}
 
 	unmarshalDestinations := []ssz.EncodableSSZ{
@@ -53,6 +59,7 @@ func TestMarshalNetworkTypes(t *testing.T) {
 		&cltypes.Ping{},
 		&cltypes.BeaconBlocksByRangeRequest{},
 		&cltypes.Status{},
+		&cltypes.LightClientUpdatesByRangeRequest{},
 	}
 	for i, tc := range cases {
 		marshalledBytes, err := tc.EncodeSSZ(nil)
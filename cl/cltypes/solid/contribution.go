@@ -7,7 +7,6 @@ import (
 	"github.com/ledgerwatch/erigon-lib/common"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
-	"github.com/ledgerwatch/erigon-lib/common/length"
 	"github.com/ledgerwatch/erigon-lib/types/clonable"
 	"github.com/ledgerwatch/erigon-lib/types/ssz"
 	"github.com/ledgerwatch/erigon/cl/merkle_tree"
@@ -27,9 +26,9 @@ const (
 // Contribution type represents a statement or confirmation of some occurrence or phenomenon.
 type Contribution [160]byte
 
-// Static returns whether the contribution is static or not. For Contribution, it's always false.
+// Static returns whether the contribution is static or not. For Contribution, it's always true.
 func (*Contribution) Static() bool {
-	return false
+	return true
 }
 
 // NewAttestionFromParameters creates a new Contribution instance using provided parameters
@@ -90,7 +89,7 @@ func (a Contribution) Slot() uint64 {
 	return binary.LittleEndian.Uint64(a[:8])
 }
 func (a Contribution) BeaconBlockRoot() (o libcommon.Hash) {
-	copy(o[:], a[16:40])
+	copy(o[:], a[8:40])
 	return
 }
 func (a Contribution) SubcommitteeIndex() uint64 {
@@ -101,28 +100,28 @@ func (a Contribution) AggregationBits() (o [16]byte) {
 	return
 }
 func (a Contribution) Signature() (o libcommon.Bytes96) {
-	copy(o[:], a[96:160])
+	copy(o[:], a[64:160])
 	return
 }
 
-func (a Contribution) SetSlot(slot uint64) {
+func (a *Contribution) SetSlot(slot uint64) {
 	binary.LittleEndian.PutUint64(a[:8], slot)
 }
 
-func (a Contribution) SetBeaconBlockRoot(hsh common.Hash) {
-	copy(a[40:48], hsh[:])
+func (a *Contribution) SetBeaconBlockRoot(hsh common.Hash) {
+	copy(a[8:40], hsh[:])
 }
 
-func (a Contribution) SetSubcommitteeIndex(validatorIndex uint64) {
+func (a *Contribution) SetSubcommitteeIndex(validatorIndex uint64) {
 	binary.LittleEndian.PutUint64(a[40:48], validatorIndex)
 }
 
-func (a Contribution) SetAggregationBits(xs [16]byte) {
+func (a *Contribution) SetAggregationBits(xs [16]byte) {
 	copy(a[48:64], xs[:])
 }
 
 // SetSignature sets the signature of the Contribution instance.
-func (a Contribution) SetSignature(signature [96]byte) {
+func (a *Contribution) SetSignature(signature [96]byte) {
 	copy(a[64:], signature[:])
 }
 
@@ -147,36 +146,12 @@ func (a *Contribution) EncodeSSZ(dst []byte) ([]byte, error) {
 	return buf, nil
 }
 
-// CopyHashBufferTo copies the hash buffer of the Contribution instance to the provided byte slice.
-func (a *Contribution) CopyHashBufferTo(o []byte) error {
-	for i := 0; i < 160; i++ {
-		o[i] = 0
-	}
-
-	// hash signature first
-	copy(o[:128], a[64:160])
-	if err := merkle_tree.InPlaceRoot(o); err != nil {
-		return err
-	}
-	copy(o[:128:160], o[:32])
-
-	copy(o[:32], a[:8])
-	copy(o[32:64], a[8:40])
-	copy(o[64:96], a[40:48])
-	copy(o[96:128], a[48:64])
-	return nil
-}
-
 // HashSSZ hashes the Contribution instance using SSZ.
-// It creates a byte slice `leaves` with a size based on length.Hash,
-// then fills this slice with the values from the Contribution's hash buffer.
 func (a *Contribution) HashSSZ() (o [32]byte, err error) {
-	leaves := make([]byte, length.Hash*5)
-	if err = a.CopyHashBufferTo(leaves); err != nil {
-		return
-	}
-	err = merkle_tree.MerkleRootFromFlatLeaves(leaves, o[:])
-	return
+	root := a.BeaconBlockRoot()
+	aggregationBits := a.AggregationBits()
+	signature := a.Signature()
+	return merkle_tree.HashTreeRoot(a.Slot(), root[:], a.SubcommitteeIndex(), aggregationBits[:], signature[:])
 }
 
 // Clone creates a new clone of the Contribution instance.
@@ -32,7 +32,7 @@ func (arr *hashList) Bytes() []byte {
 func (arr *hashList) MarshalJSON() ([]byte, error) {
 	list := make([]libcommon.Hash, arr.l)
 	for i := 0; i < arr.l; i++ {
-		list[0] = arr.Get(i)
+		list[i] = arr.Get(i)
 	}
 	return json.Marshal(list)
 }
@@ -44,7 +44,6 @@ func (arr *hashList) UnmarshalJSON(buf []byte) error {
 		return err
 	}
 	arr.Clear()
-	arr.l = len(list)
 	for _, elem := range list {
 		arr.Append(elem)
 	}
@@ -0,0 +1,31 @@
+package solid_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashVectorJSON(t *testing.T) {
+	vector := solid.NewHashVector(3)
+	vector.Set(0, libcommon.Hash{1})
+	vector.Set(2, libcommon.Hash{3})
+
+	encoded, err := json.Marshal(vector)
+	require.NoError(t, err)
+	decoded := solid.NewHashVector(3)
+	require.NoError(t, json.Unmarshal(encoded, decoded))
+	require.Equal(t, 3, decoded.Length())
+	require.Equal(t, libcommon.Hash{1}, decoded.Get(0))
+	require.Equal(t, libcommon.Hash{}, decoded.Get(1))
+	require.Equal(t, libcommon.Hash{3}, decoded.Get(2))
+
+	expectedRoot, err := vector.HashSSZ()
+	require.NoError(t, err)
+	haveRoot, err := decoded.HashSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, haveRoot)
+}
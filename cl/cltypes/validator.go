@@ -1,6 +1,8 @@
 package cltypes
 
 import (
+	"encoding/json"
+
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/types/clonable"
 	"github.com/ledgerwatch/erigon-lib/types/ssz"
@@ -63,6 +65,15 @@ func (d *Deposit) DecodeSSZ(buf []byte, version int) error {
 	return ssz2.UnmarshalSSZ(buf, version, d.Proof, d.Data)
 }
 
+// UnmarshalJSON allocates the proof first, as it can only be decoded in place.
+func (d *Deposit) UnmarshalJSON(buf []byte) error {
+	type deposit Deposit
+	if d.Proof == nil {
+		d.Proof = solid.NewHashVector(33)
+	}
+	return json.Unmarshal(buf, (*deposit)(d))
+}
+
 func (d *Deposit) EncodingSizeSSZ() int {
 	return 1240
 }
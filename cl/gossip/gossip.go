@@ -6,14 +6,19 @@ import (
 )
 
 const (
-	TopicNameBeaconBlock             = "beacon_block"
-	TopicNameBeaconAggregateAndProof = "beacon_aggregate_and_proof"
-	TopicNameVoluntaryExit           = "voluntary_exit"
-	TopicNameProposerSlashing        = "proposer_slashing"
-	TopicNameAttesterSlashing        = "attester_slashing"
-	TopicNameBlsToExecutionChange    = "bls_to_execution_change"
-
-	TopicNamePrefixBlobSidecar = "blob_sidecar_"
+	TopicNameBeaconBlock                       = "beacon_block"
+	TopicNameBeaconAggregateAndProof           = "beacon_aggregate_and_proof"
+	TopicNameVoluntaryExit                     = "voluntary_exit"
+	TopicNameProposerSlashing                  = "proposer_slashing"
+	TopicNameAttesterSlashing                  = "attester_slashing"
+	TopicNameBlsToExecutionChange              = "bls_to_execution_change"
+	TopicNameSyncCommitteeContributionAndProof = "sync_committee_contribution_and_proof"
+	TopicNameLightClientFinalityUpdate         = "light_client_finality_update"
+	TopicNameLightClientOptimisticUpdate       = "light_client_optimistic_update"
+
+	TopicNamePrefixBlobSidecar       = "blob_sidecar_"
+	TopicNamePrefixBeaconAttestation = "beacon_attestation_"
+	TopicNamePrefixSyncCommittee     = "sync_committee_"
 )
 
 func TopicNameBlobSidecar(d int) string {
@@ -23,3 +28,21 @@ func TopicNameBlobSidecar(d int) string {
 func IsTopicBlobSidecar(d string) bool {
 	return strings.Contains(d, TopicNamePrefixBlobSidecar)
 }
+
+func TopicNameBeaconAttestation(d uint64) string {
+	return TopicNamePrefixBeaconAttestation + strconv.FormatUint(d, 10)
+}
+
+func IsTopicBeaconAttestation(d string) bool {
+	return strings.Contains(d, TopicNamePrefixBeaconAttestation)
+}
+
+func TopicNameSyncCommittee(d uint64) string {
+	return TopicNamePrefixSyncCommittee + strconv.FormatUint(d, 10)
+}
+
+// IsTopicSyncCommittee reports whether the topic is one of the sync committee subnets, which share their prefix
+// with the contribution and proof topic.
+func IsTopicSyncCommittee(d string) bool {
+	return strings.Contains(d, TopicNamePrefixSyncCommittee) && !strings.Contains(d, TopicNameSyncCommitteeContributionAndProof)
+}
@@ -19,6 +19,59 @@ import (
 func HashTreeRoot(schema ...interface{}) ([32]byte, error) {
 	// Calculate the total number of leaves needed based on the schema length
 	leaves := make([]byte, NextPowerOfTwo(uint64(len(schema)*length.Hash)))
+	if err := writeSchemaLeaves(leaves, schema...); err != nil {
+		return [32]byte{}, err
+	}
+
+	// Calculate the Merkle root from the flat leaves
+	if err := MerkleRootFromFlatLeaves(leaves, leaves); err != nil {
+		return [32]byte{}, err
+	}
+
+	// Convert the bytes of the resulting hash into a [32]byte and return it
+	return common.BytesToHash(leaves[:length.Hash]), nil
+}
+
+// MerkleProof returns the branch of the leaf at proofIndex in the tree of the given depth built out of the schema,
+// ordered from the leaf up to the root. The schema supports the same types as HashTreeRoot.
+func MerkleProof(depth, proofIndex int, schema ...interface{}) ([][32]byte, error) {
+	if len(schema) > 1<<depth {
+		return nil, fmt.Errorf("schema of %d elements does not fit in a tree of depth %d", len(schema), depth)
+	}
+	leaves := make([]byte, (1<<depth)*length.Hash)
+	if err := writeSchemaLeaves(leaves, schema...); err != nil {
+		return nil, err
+	}
+	return MerkleProofFromFlatLeaves(leaves, proofIndex)
+}
+
+// MerkleProofFromFlatLeaves returns the branch of the leaf at proofIndex, ordered from the leaf up to the root.
+func MerkleProofFromFlatLeaves(leaves []byte, proofIndex int) ([][32]byte, error) {
+	if len(leaves)%length.Hash != 0 {
+		return nil, errors.New("leaves must be a multiple of 32 bytes")
+	}
+	layer := make([][32]byte, NextPowerOfTwo(uint64(len(leaves)/length.Hash)))
+	for i := 0; i < len(leaves)/length.Hash; i++ {
+		copy(layer[i][:], leaves[i*length.Hash:])
+	}
+	if proofIndex < 0 || proofIndex >= len(layer) {
+		return nil, fmt.Errorf("proof index %d out of range for %d leaves", proofIndex, len(layer))
+	}
+	depth := GetDepth(uint64(len(layer)))
+	branch := make([][32]byte, 0, depth)
+	for i := uint8(0); i < depth; i++ {
+		branch = append(branch, layer[proofIndex^1])
+		if err := gohashtree.Hash(layer, layer); err != nil {
+			return nil, err
+		}
+		layer = layer[:len(layer)/2]
+		proofIndex /= 2
+	}
+	return branch, nil
+}
+
+// writeSchemaLeaves writes the root of each element of the schema as consecutive leaves.
+func writeSchemaLeaves(leaves []byte, schema ...interface{}) error {
 	pos := 0
 
 	// Iterate over each element in the schema
@@ -39,7 +92,7 @@ func HashTreeRoot(schema ...interface{}) ([32]byte, error) {
 				// If the slice is longer or equal to the length of a hash, calculate the hash of the slice and store it in the leaves
 				root, err := BytesRoot(obj)
 				if err != nil {
-					return [32]byte{}, err
+					return err
 				}
 				copy(leaves[pos:], root[:])
 			}
@@ -47,7 +100,7 @@ func HashTreeRoot(schema ...interface{}) ([32]byte, error) {
 			// If the element implements the HashableSSZ interface, calculate the SSZ hash and store it in the leaves
 			root, err := obj.HashSSZ()
 			if err != nil {
-				return [32]byte{}, err
+				return err
 			}
 			copy(leaves[pos:], root[:])
 		default:
@@ -58,14 +111,7 @@ func HashTreeRoot(schema ...interface{}) ([32]byte, error) {
 		// Move the position pointer to the next leaf
 		pos += length.Hash
 	}
-
-	// Calculate the Merkle root from the flat leaves
-	if err := MerkleRootFromFlatLeaves(leaves, leaves); err != nil {
-		return [32]byte{}, err
-	}
-
-	// Convert the bytes of the resulting hash into a [32]byte and return it
-	return common.BytesToHash(leaves[:length.Hash]), nil
+	return nil
 }
 
 // HashByteSlice is gohashtree HashBytSlice but using our hopefully safer header converstion
@@ -33,3 +33,42 @@ func TestHashTreeRootTxs(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, common.Hash(root), common.HexToHash("0x987269bc1075122edff32bfc38479757103cee5c1ed6e990de7ffee85b5dd18a"))
 }
+
+func TestMerkleProof(t *testing.T) {
+	bs := state.New(&clparams.MainnetBeaconConfig)
+	require.NoError(t, utils.DecodeSSZSnappy(bs, beaconState, int(clparams.DenebVersion)))
+	root, err := bs.HashSSZ()
+	require.NoError(t, err)
+
+	toHashes := func(branch [][32]byte) []common.Hash {
+		hashes := make([]common.Hash, len(branch))
+		for i := range branch {
+			hashes[i] = branch[i]
+		}
+		return hashes
+	}
+
+	currentCommitteeRoot, err := bs.CurrentSyncCommittee().HashSSZ()
+	require.NoError(t, err)
+	branch, err := bs.CurrentSyncCommitteeBranch()
+	require.NoError(t, err)
+	require.True(t, utils.IsValidMerkleBranch(currentCommitteeRoot, toHashes(branch), 5, 22, root))
+
+	nextCommitteeRoot, err := bs.NextSyncCommittee().HashSSZ()
+	require.NoError(t, err)
+	branch, err = bs.NextSyncCommitteeBranch()
+	require.NoError(t, err)
+	require.True(t, utils.IsValidMerkleBranch(nextCommitteeRoot, toHashes(branch), 5, 23, root))
+
+	branch, err = bs.FinalityRootBranch()
+	require.NoError(t, err)
+	require.True(t, utils.IsValidMerkleBranch(bs.FinalizedCheckpoint().BlockRoot(), toHashes(branch), 6, 41, root))
+
+	// a plain schema proves its elements like the state does with its fields.
+	schema := []interface{}{uint64(1), uint64(2), uint64(3)}
+	schemaRoot, err := merkle_tree.HashTreeRoot(schema...)
+	require.NoError(t, err)
+	branch, err = merkle_tree.MerkleProof(2, 2, schema...)
+	require.NoError(t, err)
+	require.True(t, utils.IsValidMerkleBranch(merkle_tree.Uint64Root(3), toHashes(branch), 2, 2, schemaRoot))
+}
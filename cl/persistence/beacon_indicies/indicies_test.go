@@ -172,3 +172,26 @@ func TestWriteExecutionBlockHash(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tHash2, tHash3)
 }
+
+func TestLightClientBootstrap(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	tx, _ := db.BeginRw(context.Background())
+	defer tx.Rollback()
+
+	blockRoot := libcommon.Hash{1}
+	bootstrap := cltypes.NewLightClientBootstrap(clparams.CapellaVersion)
+	bootstrap.Header.Beacon.Slot = 56
+	require.NoError(t, WriteHeaderSlot(tx, blockRoot, 56))
+	require.NoError(t, WriteLightClientBootstrap(tx, 56, blockRoot, bootstrap))
+
+	retrieved, err := ReadLightClientBootstrap(tx, blockRoot)
+	require.NoError(t, err)
+	require.Equal(t, clparams.CapellaVersion, retrieved.Version())
+	require.Equal(t, uint64(56), retrieved.Header.Beacon.Slot)
+
+	require.NoError(t, PruneLightClientBootstraps(tx, 57))
+	retrieved, err = ReadLightClientBootstrap(tx, blockRoot)
+	require.NoError(t, err)
+	require.Nil(t, retrieved)
+}
@@ -0,0 +1,146 @@
+package beacon_indicies
+
+import (
+	"fmt"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/types/ssz"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/persistence/base_encoding"
+)
+
+// Light client objects are stored as [version] + [ssz], as their encoding depends on the fork of their attested header.
+
+func encodeLightClientObject(obj ssz.EncodableSSZ, version clparams.StateVersion) ([]byte, error) {
+	return obj.EncodeSSZ([]byte{byte(version)})
+}
+
+func decodeLightClientObject(buf []byte, obj ssz.EncodableSSZ) error {
+	if len(buf) == 0 {
+		return fmt.Errorf("empty light client object")
+	}
+	return obj.DecodeSSZ(buf[1:], int(buf[0]))
+}
+
+func lightClientBootstrapKey(slot uint64, blockRoot libcommon.Hash) []byte {
+	return append(base_encoding.Encode64ToBytes4(slot), blockRoot[:]...)
+}
+
+func WriteLightClientBootstrap(tx kv.RwTx, slot uint64, blockRoot libcommon.Hash, bootstrap *cltypes.LightClientBootstrap) error {
+	encoded, err := encodeLightClientObject(bootstrap, bootstrap.Version())
+	if err != nil {
+		return err
+	}
+	return tx.Put(kv.LightClientBootstraps, lightClientBootstrapKey(slot, blockRoot), encoded)
+}
+
+func ReadLightClientBootstrap(tx kv.Tx, blockRoot libcommon.Hash) (*cltypes.LightClientBootstrap, error) {
+	slot, err := ReadBlockSlotByBlockRoot(tx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if slot == nil {
+		return nil, nil
+	}
+	encoded, err := tx.GetOne(kv.LightClientBootstraps, lightClientBootstrapKey(*slot, blockRoot))
+	if err != nil {
+		return nil, err
+	}
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	bootstrap := &cltypes.LightClientBootstrap{}
+	if err := decodeLightClientObject(encoded, bootstrap); err != nil {
+		return nil, err
+	}
+	return bootstrap, nil
+}
+
+// PruneLightClientBootstraps removes the bootstraps of blocks before the given slot.
+func PruneLightClientBootstraps(tx kv.RwTx, to uint64) error {
+	cursor, err := tx.RwCursor(kv.LightClientBootstraps)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for k, _, err := cursor.First(); k != nil && base_encoding.Decode64FromBytes4(k[:4]) < to; k, _, err = cursor.Next() {
+		if err != nil {
+			return err
+		}
+		if err := cursor.DeleteCurrent(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func WriteLightClientUpdate(tx kv.RwTx, period uint64, update *cltypes.LightClientUpdate) error {
+	encoded, err := encodeLightClientObject(update, update.Version())
+	if err != nil {
+		return err
+	}
+	return tx.Put(kv.LightClientUpdates, base_encoding.Encode64ToBytes4(period), encoded)
+}
+
+func ReadLightClientUpdate(tx kv.Tx, period uint64) (*cltypes.LightClientUpdate, error) {
+	encoded, err := tx.GetOne(kv.LightClientUpdates, base_encoding.Encode64ToBytes4(period))
+	if err != nil {
+		return nil, err
+	}
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	update := &cltypes.LightClientUpdate{}
+	if err := decodeLightClientObject(encoded, update); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+func WriteLightClientFinalityUpdate(tx kv.RwTx, update *cltypes.LightClientFinalityUpdate) error {
+	encoded, err := encodeLightClientObject(update, update.Version())
+	if err != nil {
+		return err
+	}
+	return tx.Put(kv.LightClient, kv.LightClientFinalityUpdate, encoded)
+}
+
+func ReadLightClientFinalityUpdate(tx kv.Tx) (*cltypes.LightClientFinalityUpdate, error) {
+	encoded, err := tx.GetOne(kv.LightClient, kv.LightClientFinalityUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	update := &cltypes.LightClientFinalityUpdate{}
+	if err := decodeLightClientObject(encoded, update); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+func WriteLightClientOptimisticUpdate(tx kv.RwTx, update *cltypes.LightClientOptimisticUpdate) error {
+	encoded, err := encodeLightClientObject(update, update.Version())
+	if err != nil {
+		return err
+	}
+	return tx.Put(kv.LightClient, kv.LightClientOptimisticUpdate, encoded)
+}
+
+func ReadLightClientOptimisticUpdate(tx kv.Tx) (*cltypes.LightClientOptimisticUpdate, error) {
+	encoded, err := tx.GetOne(kv.LightClient, kv.LightClientOptimisticUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	update := &cltypes.LightClientOptimisticUpdate{}
+	if err := decodeLightClientObject(encoded, update); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
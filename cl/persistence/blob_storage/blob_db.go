@@ -0,0 +1,209 @@
+package blob_storage
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/length"
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication/ssz_snappy"
+	"github.com/spf13/afero"
+)
+
+const subdivisionSlot = 10_000
+
+type BlobStorage interface {
+	WriteBlobSidecars(ctx context.Context, blockRoot libcommon.Hash, blobSidecars []*cltypes.BlobSidecar) error
+	ReadBlobSidecars(ctx context.Context, slot uint64, blockRoot libcommon.Hash) (out []*cltypes.BlobSidecar, found bool, err error)
+	KzgCommitmentsCount(ctx context.Context, blockRoot libcommon.Hash) (uint32, error)
+	// Prune removes the sidecars of the blocks which are more than the retention distance behind currentSlot.
+	Prune(ctx context.Context, currentSlot uint64) error
+}
+
+type BlobStore struct {
+	db                kv.RwDB
+	fs                afero.Fs
+	beaconChainConfig *clparams.BeaconChainConfig
+	slotsKept         uint64
+}
+
+func NewBlobStore(db kv.RwDB, fs afero.Fs, slotsKept uint64, beaconChainConfig *clparams.BeaconChainConfig) BlobStorage {
+	return &BlobStore{fs: fs, db: db, slotsKept: slotsKept, beaconChainConfig: beaconChainConfig}
+}
+
+// blobSidecarFilePath returns the folder and path of a sidecar: "{slot/10_000}/{slot}/{root}_{index}.sz".
+func blobSidecarFilePath(slot, index uint64, blockRoot libcommon.Hash) (folderPath, filePath string) {
+	folderPath = fmt.Sprintf("%d/%d", slot/subdivisionSlot, slot)
+	filePath = fmt.Sprintf("%s/%x_%d.sz", folderPath, blockRoot, index)
+	return
+}
+
+// WriteBlobSidecars stores the given sidecars of a block, all of them must belong to blockRoot.
+func (bs *BlobStore) WriteBlobSidecars(ctx context.Context, blockRoot libcommon.Hash, blobSidecars []*cltypes.BlobSidecar) error {
+	var written uint32
+	for _, blobSidecar := range blobSidecars {
+		folderPath, filePath := blobSidecarFilePath(blobSidecar.SignedBlockHeader.Header.Slot, blobSidecar.Index, blockRoot)
+		if _, err := bs.fs.Stat(filePath); err == nil {
+			// we already have it.
+			continue
+		}
+		if err := bs.fs.MkdirAll(folderPath, 0o755); err != nil {
+			return err
+		}
+		file, err := bs.fs.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0o755)
+		if err != nil {
+			return err
+		}
+		if err := ssz_snappy.EncodeAndWrite(file, blobSidecar); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Sync(); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+		written++
+	}
+	if written == 0 {
+		return nil
+	}
+
+	tx, err := bs.db.BeginRw(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	count, err := readKzgCommitmentsCount(tx, blockRoot)
+	if err != nil {
+		return err
+	}
+	value := make([]byte, 4)
+	binary.BigEndian.PutUint32(value, count+written)
+	if err := tx.Put(kv.BlockRootToKzgCommitments, blockRoot[:], value); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ReadBlobSidecars reads the sidecars we have for the block, ordered by index.
+func (bs *BlobStore) ReadBlobSidecars(ctx context.Context, slot uint64, blockRoot libcommon.Hash) ([]*cltypes.BlobSidecar, bool, error) {
+	var out []*cltypes.BlobSidecar
+	for i := uint64(0); i < bs.beaconChainConfig.MaxBlobsPerBlock; i++ {
+		_, filePath := blobSidecarFilePath(slot, i, blockRoot)
+		file, err := bs.fs.Open(filePath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, false, err
+		}
+		blobSidecar := &cltypes.BlobSidecar{}
+		err = ssz_snappy.DecodeAndReadNoForkDigest(file, blobSidecar, clparams.DenebVersion)
+		file.Close()
+		if err != nil {
+			return nil, false, err
+		}
+		out = append(out, blobSidecar)
+	}
+	return out, len(out) > 0, nil
+}
+
+// KzgCommitmentsCount returns how many sidecars we stored for the block.
+func (bs *BlobStore) KzgCommitmentsCount(ctx context.Context, blockRoot libcommon.Hash) (uint32, error) {
+	tx, err := bs.db.BeginRo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	return readKzgCommitmentsCount(tx, blockRoot)
+}
+
+func readKzgCommitmentsCount(tx kv.Tx, blockRoot libcommon.Hash) (uint32, error) {
+	value, err := tx.GetOne(kv.BlockRootToKzgCommitments, blockRoot[:])
+	if err != nil {
+		return 0, err
+	}
+	if len(value) != 4 {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint32(value), nil
+}
+
+func (bs *BlobStore) Prune(ctx context.Context, currentSlot uint64) error {
+	if currentSlot < bs.slotsKept {
+		return nil
+	}
+	pruneBelow := currentSlot - bs.slotsKept
+
+	tx, err := bs.db.BeginRw(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	subdivisions, err := afero.ReadDir(bs.fs, ".")
+	if err != nil {
+		return err
+	}
+	for _, subdivision := range subdivisions {
+		subdivisionIdx, err := strconv.ParseUint(subdivision.Name(), 10, 64)
+		if err != nil || !subdivision.IsDir() || subdivisionIdx*subdivisionSlot >= pruneBelow {
+			continue
+		}
+		slotFolders, err := afero.ReadDir(bs.fs, subdivision.Name())
+		if err != nil {
+			return err
+		}
+		kept := 0
+		for _, slotFolder := range slotFolders {
+			slot, err := strconv.ParseUint(slotFolder.Name(), 10, 64)
+			if err != nil || slot >= pruneBelow {
+				kept++
+				continue
+			}
+			if err := bs.removeSlotFolder(tx, subdivision.Name()+"/"+slotFolder.Name()); err != nil {
+				return err
+			}
+		}
+		if kept == 0 {
+			if err := bs.fs.RemoveAll(subdivision.Name()); err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// removeSlotFolder deletes the sidecars of a slot, together with the counters of their blocks.
+func (bs *BlobStore) removeSlotFolder(tx kv.RwTx, folderPath string) error {
+	files, err := afero.ReadDir(bs.fs, folderPath)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		rootHex, _, found := strings.Cut(file.Name(), "_")
+		if !found || len(rootHex) != 2*length.Hash {
+			continue
+		}
+		blockRoot, err := hex.DecodeString(rootHex)
+		if err != nil {
+			continue
+		}
+		if err := tx.Delete(kv.BlockRootToKzgCommitments, blockRoot); err != nil {
+			return err
+		}
+	}
+	return bs.fs.RemoveAll(folderPath)
+}
@@ -0,0 +1,62 @@
+package blob_storage
+
+import (
+	"context"
+	"testing"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlobSidecar(slot, index uint64) *cltypes.BlobSidecar {
+	blob := &cltypes.Blob{}
+	blob[0] = byte(index)
+	return cltypes.NewBlobSidecar(index, blob, cltypes.KZGCommitment{1}, cltypes.KZGProof{2},
+		&cltypes.SignedBeaconBlockHeader{Header: &cltypes.BeaconBlockHeader{Slot: slot}}, solid.NewHashVector(cltypes.KzgCommitmentInclusionProofDepth))
+}
+
+func TestBlobStorage(t *testing.T) {
+	ctx := context.Background()
+	db := memdb.NewTestDB(t)
+	cfg := clparams.MainnetBeaconConfig
+	bs := NewBlobStore(db, afero.NewMemMapFs(), 100, &cfg)
+
+	oldRoot, newRoot := libcommon.Hash{1}, libcommon.Hash{2}
+	require.NoError(t, bs.WriteBlobSidecars(ctx, oldRoot, []*cltypes.BlobSidecar{newTestBlobSidecar(10, 0), newTestBlobSidecar(10, 2)}))
+	require.NoError(t, bs.WriteBlobSidecars(ctx, newRoot, []*cltypes.BlobSidecar{newTestBlobSidecar(10_050, 0)}))
+	// writing the same sidecar twice does not count it twice.
+	require.NoError(t, bs.WriteBlobSidecars(ctx, oldRoot, []*cltypes.BlobSidecar{newTestBlobSidecar(10, 2)}))
+
+	count, err := bs.KzgCommitmentsCount(ctx, oldRoot)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), count)
+
+	sidecars, found, err := bs.ReadBlobSidecars(ctx, 10, oldRoot)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Len(t, sidecars, 2)
+	for i, index := range []uint64{0, 2} {
+		expected, err := newTestBlobSidecar(10, index).HashSSZ()
+		require.NoError(t, err)
+		have, err := sidecars[i].HashSSZ()
+		require.NoError(t, err)
+		require.Equal(t, expected, have)
+	}
+
+	require.NoError(t, bs.Prune(ctx, 10_100))
+	_, found, err = bs.ReadBlobSidecars(ctx, 10, oldRoot)
+	require.NoError(t, err)
+	require.False(t, found)
+	count, err = bs.KzgCommitmentsCount(ctx, oldRoot)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	_, found, err = bs.ReadBlobSidecars(ctx, 10_050, newRoot)
+	require.NoError(t, err)
+	require.True(t, found)
+}
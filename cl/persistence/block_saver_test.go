@@ -15,6 +15,7 @@ import (
 	"github.com/ledgerwatch/erigon/cl/phase1/execution_client"
 	"github.com/ledgerwatch/erigon/cl/utils"
 	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_types"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -79,6 +80,22 @@ func (m *mockEngine) GetBodiesByHashes(hashes []libcommon.Hash) ([]*types.RawBod
 	panic("unimplemented")
 }
 
+func (m *mockEngine) AssembleBlock(finalized libcommon.Hash, head libcommon.Hash, attributes *engine_types.PayloadAttributes) (uint64, error) {
+	panic("unimplemented")
+}
+
+func (m *mockEngine) GetAssembledBlock(id uint64, version clparams.StateVersion) (*engine_types.GetPayloadResponse, error) {
+	panic("unimplemented")
+}
+
+func (m *mockEngine) HeaderNumber(hash libcommon.Hash) (*uint64, error) {
+	panic("unimplemented")
+}
+
+func (m *mockEngine) GetLogs(address libcommon.Address, fromBlock, toBlock uint64) ([]*types.Log, error) {
+	panic("unimplemented")
+}
+
 //go:embed test_data/test_block.ssz_snappy
 var testBlock []byte
 
@@ -244,3 +244,55 @@ func ExpectedWithdrawals(b abstract.BeaconState) []*cltypes.Withdrawal {
 	// Return the withdrawals slice
 	return withdrawals
 }
+
+// ComputeSubnetForAttestation returns the subnet on which the attestations of the given committee are gossiped.
+func ComputeSubnetForAttestation(cfg *clparams.BeaconChainConfig, subnetCount, committeesPerSlot, slot, committeeIndex uint64) uint64 {
+	committeesSinceEpochStart := committeesPerSlot * (slot % cfg.SlotsPerEpoch)
+	return (committeesSinceEpochStart + committeeIndex) % subnetCount
+}
+
+// SyncCommitteePositions returns the positions of the validator in the sync committee which is active at the given slot,
+// which must either be in the sync committee period of the state or in the next one.
+func SyncCommitteePositions(b abstract.BeaconState, slot, validatorIndex uint64) ([]uint64, error) {
+	cfg := b.BeaconConfig()
+	statePeriod := Epoch(b) / cfg.EpochsPerSyncCommitteePeriod
+	var syncCommittee *solid.SyncCommittee
+	switch GetEpochAtSlot(cfg, slot) / cfg.EpochsPerSyncCommitteePeriod {
+	case statePeriod:
+		syncCommittee = b.CurrentSyncCommittee()
+	case statePeriod + 1:
+		syncCommittee = b.NextSyncCommittee()
+	default:
+		return nil, fmt.Errorf("slot %d is outside of the known sync committee periods", slot)
+	}
+	validator, err := b.ValidatorForValidatorIndex(int(validatorIndex))
+	if err != nil {
+		return nil, err
+	}
+	publicKey := validator.PublicKey()
+	var positions []uint64
+	for i, committeeKey := range syncCommittee.GetCommittee() {
+		if committeeKey == publicKey {
+			positions = append(positions, uint64(i))
+		}
+	}
+	return positions, nil
+}
+
+// ComputeSubnetsForSyncCommittee returns the sync committee subnets the validator publishes its messages to at the given slot.
+func ComputeSubnetsForSyncCommittee(b abstract.BeaconState, slot, validatorIndex uint64) ([]uint64, error) {
+	positions, err := SyncCommitteePositions(b, slot, validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	cfg := b.BeaconConfig()
+	subcommitteeSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+	var subnets []uint64
+	for _, position := range positions {
+		subnet := position / subcommitteeSize
+		if len(subnets) == 0 || subnets[len(subnets)-1] != subnet {
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets, nil
+}
@@ -62,6 +62,16 @@ func (b *CachingBeaconState) SetPreviousStateRoot(root libcommon.Hash) {
 }
 
 func (b *CachingBeaconState) _updateProposerIndex() (err error) {
+	proposerIndex, err := b.computeBeaconProposerIndexForSlot(b.Slot())
+	if err != nil {
+		return err
+	}
+	b.proposerIndex = &proposerIndex
+	return
+}
+
+// computeBeaconProposerIndexForSlot computes the proposer of a slot belonging to the state's epoch.
+func (b *CachingBeaconState) computeBeaconProposerIndexForSlot(slot uint64) (uint64, error) {
 	epoch := Epoch(b)
 
 	hash := sha256.New()
@@ -72,7 +82,7 @@ func (b *CachingBeaconState) _updateProposerIndex() (err error) {
 	mix := b.GetRandaoMix(int(mixPosition))
 	input := shuffling2.GetSeed(b.BeaconConfig(), mix, epoch, b.BeaconConfig().DomainBeaconProposer)
 	slotByteArray := make([]byte, 8)
-	binary.LittleEndian.PutUint64(slotByteArray, b.Slot())
+	binary.LittleEndian.PutUint64(slotByteArray, slot)
 
 	// Add slot to the end of the input.
 	inputWithSlot := append(input[:], slotByteArray...)
@@ -85,9 +95,7 @@ func (b *CachingBeaconState) _updateProposerIndex() (err error) {
 	// Write the seed to an array.
 	seedArray := [32]byte{}
 	copy(seedArray[:], seed)
-	b.proposerIndex = new(uint64)
-	*b.proposerIndex, err = shuffling2.ComputeProposerIndex(b.BeaconState, indices, seedArray)
-	return
+	return shuffling2.ComputeProposerIndex(b.BeaconState, indices, seedArray)
 }
 
 // _initializeValidatorsPhase0 initializes the validators matching flags based on previous/current attestations
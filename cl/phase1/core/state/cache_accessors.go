@@ -82,6 +82,17 @@ func (b *CachingBeaconState) GetBeaconProposerIndex() (uint64, error) {
 	return *b.proposerIndex, nil
 }
 
+// GetBeaconProposerIndexForSlot gets the beacon proposer index of any slot within the state's epoch.
+func (b *CachingBeaconState) GetBeaconProposerIndexForSlot(slot uint64) (uint64, error) {
+	if slot == b.Slot() {
+		return b.GetBeaconProposerIndex()
+	}
+	if GetEpochAtSlot(b.BeaconConfig(), slot) != Epoch(b) {
+		return 0, fmt.Errorf("slot %d is not in the state epoch %d", slot, Epoch(b))
+	}
+	return b.computeBeaconProposerIndexForSlot(slot)
+}
+
 // BaseRewardPerIncrement return base rewards for processing sync committee and duties.
 func (b *CachingBeaconState) BaseRewardPerIncrement() uint64 {
 	if b.totalActiveBalanceCache == nil {
@@ -23,6 +23,34 @@ func (b *BeaconState) HashSSZ() (out [32]byte, err error) {
 	return
 }
 
+// CurrentSyncCommitteeBranch returns the merkle branch of the current sync committee against the state root.
+func (b *BeaconState) CurrentSyncCommitteeBranch() ([][32]byte, error) {
+	return b.leafBranch(CurrentSyncCommitteeLeafIndex)
+}
+
+// NextSyncCommitteeBranch returns the merkle branch of the next sync committee against the state root.
+func (b *BeaconState) NextSyncCommitteeBranch() ([][32]byte, error) {
+	return b.leafBranch(NextSyncCommitteeLeafIndex)
+}
+
+// FinalityRootBranch returns the merkle branch of the finalized checkpoint root against the state root.
+func (b *BeaconState) FinalityRootBranch() ([][32]byte, error) {
+	branch, err := b.leafBranch(FinalizedCheckpointLeafIndex)
+	if err != nil {
+		return nil, err
+	}
+	// the root is the second field of the checkpoint, so its sibling is the epoch.
+	epochRoot := merkle_tree.Uint64Root(b.finalizedCheckpoint.Epoch())
+	return append([][32]byte{epochRoot}, branch...), nil
+}
+
+func (b *BeaconState) leafBranch(idx StateLeafIndex) ([][32]byte, error) {
+	if err := b.computeDirtyLeaves(); err != nil {
+		return nil, err
+	}
+	return merkle_tree.MerkleProofFromFlatLeaves(b.leaves, int(idx))
+}
+
 func preparateRootsForHashing(roots []common.Hash) [][32]byte {
 	ret := make([][32]byte, len(roots))
 	for i := range roots {
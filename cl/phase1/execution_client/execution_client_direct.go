@@ -5,20 +5,27 @@ import (
 	"fmt"
 
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"github.com/ledgerwatch/erigon-lib/gointerfaces/execution"
+	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_types"
 	"github.com/ledgerwatch/erigon/turbo/execution/eth1/eth1_chain_reader.go"
 )
 
 type ExecutionClientDirect struct {
 	chainRW eth1_chain_reader.ChainReaderWriterEth1
+	logs    LogsReader // may be nil
 	ctx     context.Context
 }
 
-func NewExecutionClientDirect(ctx context.Context, chainRW eth1_chain_reader.ChainReaderWriterEth1) (*ExecutionClientDirect, error) {
+// NewExecutionClientDirect creates an execution client using the execution module, logs are read from the given
+// reader, if any.
+func NewExecutionClientDirect(ctx context.Context, chainRW eth1_chain_reader.ChainReaderWriterEth1, logs LogsReader) (*ExecutionClientDirect, error) {
 	return &ExecutionClientDirect{
 		chainRW: chainRW,
+		logs:    logs,
 		ctx:     ctx,
 	}, nil
 }
@@ -99,3 +106,47 @@ func (cc *ExecutionClientDirect) GetBodiesByHashes(hashes []libcommon.Hash) ([]*
 func (cc *ExecutionClientDirect) FrozenBlocks() uint64 {
 	return cc.chainRW.FrozenBlocks()
 }
+
+// Block production
+
+func (cc *ExecutionClientDirect) AssembleBlock(finalized libcommon.Hash, head libcommon.Hash, attributes *engine_types.PayloadAttributes) (uint64, error) {
+	if err := cc.ForkChoiceUpdate(finalized, head); err != nil {
+		return 0, err
+	}
+	id, busy, err := cc.chainRW.AssembleBlock(head, attributes)
+	if err != nil {
+		return 0, err
+	}
+	if busy {
+		return 0, fmt.Errorf("execution layer is busy, cannot assemble block")
+	}
+	return id, nil
+}
+
+func (cc *ExecutionClientDirect) GetAssembledBlock(id uint64, _ clparams.StateVersion) (*engine_types.GetPayloadResponse, error) {
+	payload, blobsBundle, blockValue, busy, err := cc.chainRW.GetAssembledBlock(id)
+	if err != nil {
+		return nil, err
+	}
+	if busy {
+		return nil, fmt.Errorf("execution layer is busy, cannot retrieve assembled block")
+	}
+	return &engine_types.GetPayloadResponse{
+		ExecutionPayload: engine_types.ConvertPayloadFromRpc(payload),
+		BlockValue:       (*hexutil.Big)(blockValue),
+		BlobsBundle:      engine_types.ConvertBlobsFromRpc(blobsBundle),
+	}, nil
+}
+
+// Deposits
+
+func (cc *ExecutionClientDirect) HeaderNumber(hash libcommon.Hash) (*uint64, error) {
+	return cc.chainRW.HeaderNumber(hash)
+}
+
+func (cc *ExecutionClientDirect) GetLogs(address libcommon.Address, fromBlock, toBlock uint64) ([]*types.Log, error) {
+	if cc.logs == nil {
+		return nil, fmt.Errorf("no source of execution logs")
+	}
+	return cc.logs.GetLogs(address, fromBlock, toBlock)
+}
@@ -2,6 +2,7 @@ package execution_client
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"math/big"
@@ -228,3 +229,97 @@ func (cc *ExecutionClientRpc) GetBodiesByHashes(hashes []libcommon.Hash) ([]*typ
 func (cc *ExecutionClientRpc) FrozenBlocks() uint64 {
 	panic("unimplemented")
 }
+
+// Block production
+
+// AssembleBlock updates the forkchoice and asks the execution layer to start building a payload on top of head.
+func (cc *ExecutionClientRpc) AssembleBlock(finalized libcommon.Hash, head libcommon.Hash, attributes *engine_types.PayloadAttributes) (uint64, error) {
+	forkChoiceRequest := engine_types.ForkChoiceState{
+		HeadHash:           head,
+		SafeBlockHash:      head,
+		FinalizedBlockHash: finalized,
+	}
+	// determine the engine method
+	engineMethod := rpc_helper.ForkChoiceUpdatedV1
+	if attributes.ParentBeaconBlockRoot != nil {
+		engineMethod = rpc_helper.ForkChoiceUpdatedV3
+	} else if attributes.Withdrawals != nil {
+		engineMethod = rpc_helper.ForkChoiceUpdatedV2
+	}
+	forkChoiceResp := &engine_types.ForkChoiceUpdatedResponse{}
+	log.Debug("[ExecutionClientRpc] Calling EL", "method", engineMethod)
+	if err := cc.client.CallContext(cc.ctx, forkChoiceResp, engineMethod, forkChoiceRequest, attributes); err != nil {
+		return 0, fmt.Errorf("execution Client RPC failed to retrieve ForkChoiceUpdate response, err: %w", err)
+	}
+	if err := checkPayloadStatus(forkChoiceResp.PayloadStatus); err != nil {
+		return 0, err
+	}
+	if forkChoiceResp.PayloadId == nil || len(*forkChoiceResp.PayloadId) != 8 {
+		return 0, fmt.Errorf("execution layer did not start building a payload, status: %s", forkChoiceResp.PayloadStatus.Status)
+	}
+	return binary.BigEndian.Uint64(*forkChoiceResp.PayloadId), nil
+}
+
+// GetAssembledBlock retrieves the payload built for the given payload id.
+func (cc *ExecutionClientRpc) GetAssembledBlock(id uint64, version clparams.StateVersion) (*engine_types.GetPayloadResponse, error) {
+	payloadId := engine_types.ConvertPayloadId(id)
+	var engineMethod string
+	switch version {
+	case clparams.BellatrixVersion:
+		engineMethod = rpc_helper.GetPayloadV1
+	case clparams.CapellaVersion:
+		engineMethod = rpc_helper.GetPayloadV2
+	case clparams.DenebVersion:
+		engineMethod = rpc_helper.GetPayloadV3
+	default:
+		return nil, fmt.Errorf("invalid payload version")
+	}
+	log.Debug("[ExecutionClientRpc] Calling EL", "method", engineMethod)
+	if version == clparams.BellatrixVersion {
+		// engine_getPayloadV1 returns the bare payload.
+		payload := &engine_types.ExecutionPayload{}
+		if err := cc.client.CallContext(cc.ctx, payload, engineMethod, payloadId); err != nil {
+			return nil, fmt.Errorf("execution Client RPC failed to retrieve the payload, err: %w", err)
+		}
+		return &engine_types.GetPayloadResponse{ExecutionPayload: payload}, nil
+	}
+	resp := &engine_types.GetPayloadResponse{}
+	if err := cc.client.CallContext(cc.ctx, resp, engineMethod, payloadId); err != nil {
+		return nil, fmt.Errorf("execution Client RPC failed to retrieve the payload, err: %w", err)
+	}
+	if resp.ExecutionPayload == nil {
+		return nil, fmt.Errorf("execution layer returned an empty payload")
+	}
+	return resp, nil
+}
+
+// Deposits
+
+// HeaderNumber returns the number of the block with the given hash, nil if the execution layer does not know it.
+func (cc *ExecutionClientRpc) HeaderNumber(hash libcommon.Hash) (*uint64, error) {
+	var header *struct {
+		Number hexutil.Uint64 `json:"number"`
+	}
+	if err := cc.client.CallContext(cc.ctx, &header, rpc_helper.GetBlockByHash, hash, false); err != nil {
+		return nil, fmt.Errorf("execution Client RPC failed to retrieve the block, err: %w", err)
+	}
+	if header == nil {
+		return nil, nil
+	}
+	number := uint64(header.Number)
+	return &number, nil
+}
+
+// GetLogs returns the logs emitted by address in the given range of blocks.
+func (cc *ExecutionClientRpc) GetLogs(address libcommon.Address, fromBlock, toBlock uint64) ([]*types.Log, error) {
+	filter := map[string]interface{}{
+		"address":   address,
+		"fromBlock": hexutil.Uint64(fromBlock),
+		"toBlock":   hexutil.Uint64(toBlock),
+	}
+	logs := []*types.Log{}
+	if err := cc.client.CallContext(cc.ctx, &logs, rpc_helper.GetLogs, filter); err != nil {
+		return nil, fmt.Errorf("execution Client RPC failed to retrieve the logs, err: %w", err)
+	}
+	return logs, nil
+}
@@ -3,8 +3,10 @@ package execution_client
 import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
+	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_types"
 )
 
 var errContextExceeded = "rpc error: code = DeadlineExceeded desc = context deadline exceeded"
@@ -24,4 +26,15 @@ type ExecutionEngine interface {
 	GetBodiesByHashes(hashes []libcommon.Hash) ([]*types.RawBody, error)
 	// Snapshots
 	FrozenBlocks() uint64
+	// Block production
+	AssembleBlock(finalized libcommon.Hash, head libcommon.Hash, attributes *engine_types.PayloadAttributes) (uint64, error)
+	GetAssembledBlock(id uint64, version clparams.StateVersion) (*engine_types.GetPayloadResponse, error)
+	// Deposits
+	HeaderNumber(hash libcommon.Hash) (*uint64, error)
+	LogsReader
+}
+
+// LogsReader reads the logs of the execution chain, which the execution module does not serve.
+type LogsReader interface {
+	GetLogs(address libcommon.Address, fromBlock, toBlock uint64) ([]*types.Log, error)
 }
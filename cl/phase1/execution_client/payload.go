@@ -0,0 +1,75 @@
+package execution_client
+
+import (
+	"fmt"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_types"
+)
+
+// ConvertPayloadToEth1Block converts an engine API execution payload into its consensus representation.
+func ConvertPayloadToEth1Block(payload *engine_types.ExecutionPayload, version clparams.StateVersion, beaconCfg *clparams.BeaconChainConfig) (*cltypes.Eth1Block, error) {
+	if payload.BaseFeePerGas == nil {
+		return nil, fmt.Errorf("payload is missing the base fee")
+	}
+	if len(payload.LogsBloom) != types.BloomByteLength {
+		return nil, fmt.Errorf("invalid logs bloom length: %d", len(payload.LogsBloom))
+	}
+	// base fee is stored little endian on the consensus side.
+	baseFeeBytes := payload.BaseFeePerGas.ToInt().Bytes()
+	if len(baseFeeBytes) > 32 {
+		return nil, fmt.Errorf("base fee overflows 32 bytes")
+	}
+	var baseFee libcommon.Hash
+	for i, b := range baseFeeBytes {
+		baseFee[len(baseFeeBytes)-1-i] = b
+	}
+
+	block := cltypes.NewEth1Block(version, beaconCfg)
+	block.ParentHash = payload.ParentHash
+	block.FeeRecipient = payload.FeeRecipient
+	block.StateRoot = payload.StateRoot
+	block.ReceiptsRoot = payload.ReceiptsRoot
+	copy(block.LogsBloom[:], payload.LogsBloom)
+	block.PrevRandao = payload.PrevRandao
+	block.BlockNumber = uint64(payload.BlockNumber)
+	block.GasLimit = uint64(payload.GasLimit)
+	block.GasUsed = uint64(payload.GasUsed)
+	block.Time = uint64(payload.Timestamp)
+	block.Extra = solid.NewExtraData()
+	block.Extra.SetBytes(payload.ExtraData)
+	block.BaseFeePerGas = baseFee
+	block.BlockHash = payload.BlockHash
+
+	txs := make([][]byte, len(payload.Transactions))
+	for i, tx := range payload.Transactions {
+		txs[i] = tx
+	}
+	block.Transactions = solid.NewTransactionsSSZFromTransactions(txs)
+
+	if version >= clparams.CapellaVersion {
+		withdrawals := make([]*cltypes.Withdrawal, len(payload.Withdrawals))
+		for i, w := range payload.Withdrawals {
+			withdrawals[i] = &cltypes.Withdrawal{
+				Index:     w.Index,
+				Validator: w.Validator,
+				Address:   w.Address,
+				Amount:    w.Amount,
+			}
+		}
+		block.Withdrawals = solid.NewStaticListSSZFromList(withdrawals, int(beaconCfg.MaxWithdrawalsPerPayload), 44)
+	}
+	if version >= clparams.DenebVersion {
+		if payload.BlobGasUsed == nil || payload.ExcessBlobGas == nil {
+			return nil, fmt.Errorf("deneb payload is missing blob gas fields")
+		}
+		block.BlobGasUsed = uint64(*payload.BlobGasUsed)
+		block.ExcessBlobGas = uint64(*payload.ExcessBlobGas)
+	}
+	return block, nil
+}
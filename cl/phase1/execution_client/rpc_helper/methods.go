@@ -8,5 +8,13 @@ const ForkChoiceUpdatedV1 = "engine_forkchoiceUpdatedV1"
 const ForkChoiceUpdatedV2 = "engine_forkchoiceUpdatedV2"
 const ForkChoiceUpdatedV3 = "engine_forkchoiceUpdatedV3"
 
+const GetPayloadV1 = "engine_getPayloadV1"
+const GetPayloadV2 = "engine_getPayloadV2"
+const GetPayloadV3 = "engine_getPayloadV3"
+
 const GetPayloadBodiesByHashV1 = "engine_getPayloadBodiesByHashV1"
 const GetPayloadBodiesByRangeV1 = "engine_getPayloadBodiesByRangeV1"
+
+// eth methods which the engine API endpoint serves too
+const GetBlockByHash = "eth_getBlockByHash"
+const GetLogs = "eth_getLogs"
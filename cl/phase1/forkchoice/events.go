@@ -0,0 +1,172 @@
+package forkchoice
+
+import (
+	"context"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/log/v3"
+
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
+	"github.com/ledgerwatch/erigon/cl/transition"
+)
+
+// emitHeadEvents publishes head, chain_reorg and payload_attributes events whenever the computed head changes.
+func (f *ForkChoiceStore) emitHeadEvents(headHash libcommon.Hash, headSlot uint64) {
+	if f.publishedHeadHash == headHash {
+		return
+	}
+	oldHeadHash, oldHeadSlot := f.publishedHeadHash, f.publishedHeadSlot
+	f.publishedHeadHash, f.publishedHeadSlot = headHash, headSlot
+
+	header, has := f.forkGraph.GetHeader(headHash)
+	if !has {
+		return
+	}
+	epoch := f.computeEpochAtSlot(headSlot)
+	head := &beaconevents.HeadData{
+		Slot:                     headSlot,
+		Block:                    headHash,
+		State:                    header.Root,
+		EpochTransition:          f.computeSlotsSinceEpochStart(headSlot) == 0,
+		CurrentDutyDependentRoot: f.dependentRoot(headHash, epoch),
+	}
+	if epoch > 0 {
+		head.PreviousDutyDependentRoot = f.dependentRoot(headHash, epoch-1)
+	}
+	f.emitters.Publish(beaconevents.TopicHead, head)
+
+	// The old head is not in the new head chain, so a reorg happened.
+	if oldHeadHash != (libcommon.Hash{}) && f.Ancestor(headHash, oldHeadSlot) != oldHeadHash {
+		if oldHeader, has := f.forkGraph.GetHeader(oldHeadHash); has {
+			f.emitters.Publish(beaconevents.TopicChainReorg, &beaconevents.ChainReorgData{
+				Slot:         headSlot,
+				Depth:        f.reorgDepth(oldHeadHash, headHash),
+				OldHeadBlock: oldHeadHash,
+				NewHeadBlock: headHash,
+				OldHeadState: oldHeader.Root,
+				NewHeadState: header.Root,
+				Epoch:        epoch,
+			})
+		}
+	}
+
+	if f.emitters.HasSubscribers(beaconevents.TopicPayloadAttributes) {
+		// computing them may process an epoch, which must not hold the head update
+		f.payloadAttributesHead = headHash
+		select {
+		case f.payloadAttributesReady <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// payloadAttributesLoop publishes the payload attributes on top of the latest head, skipping the heads which
+// were replaced before their turn. Only copying the head state is done under the fork choice lock.
+func (f *ForkChoiceStore) payloadAttributesLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-f.payloadAttributesReady:
+		}
+		f.mu.Lock()
+		headHash := f.payloadAttributesHead
+		f.payloadAttributesHead = libcommon.Hash{}
+		var s *state.CachingBeaconState
+		var err error
+		if headHash != (libcommon.Hash{}) {
+			s, err = f.forkGraph.GetState(headHash, true)
+		}
+		f.mu.Unlock()
+		if err != nil {
+			log.Debug("could not compute payload attributes event", "err", err)
+			continue
+		}
+		if s == nil {
+			continue
+		}
+		if err := f.emitPayloadAttributes(s, headHash, s.Slot()+1); err != nil {
+			log.Debug("could not compute payload attributes event", "err", err)
+		}
+	}
+}
+
+// dependentRoot returns the block root at the last slot before the given epoch, on which its duties depend.
+func (f *ForkChoiceStore) dependentRoot(headHash libcommon.Hash, epoch uint64) libcommon.Hash {
+	startSlot := f.computeStartSlotAtEpoch(epoch)
+	if startSlot == 0 {
+		return f.Ancestor(headHash, 0)
+	}
+	return f.Ancestor(headHash, startSlot-1)
+}
+
+// reorgDepth counts the blocks of the old chain which are not part of the new one.
+func (f *ForkChoiceStore) reorgDepth(oldHeadHash, newHeadHash libcommon.Hash) uint64 {
+	depth := uint64(0)
+	curr := oldHeadHash
+	for {
+		header, has := f.forkGraph.GetHeader(curr)
+		if !has || f.Ancestor(newHeadHash, header.Slot) == curr {
+			return depth
+		}
+		depth++
+		curr = header.ParentRoot
+	}
+}
+
+// emitPayloadAttributes publishes the payload attributes a proposer building on top of the head would use.
+// s is a copy of the head state, which is modified.
+func (f *ForkChoiceStore) emitPayloadAttributes(s *state.CachingBeaconState, headHash libcommon.Hash, proposalSlot uint64) error {
+	if s.Version() < clparams.BellatrixVersion {
+		return nil
+	}
+	// Proposers and withdrawals for the next epoch are only known after epoch processing.
+	if f.computeEpochAtSlot(proposalSlot) != state.Epoch(s) {
+		if err := transition.DefaultMachine.ProcessSlots(s, proposalSlot); err != nil {
+			return err
+		}
+	}
+	proposerIndex, err := s.GetBeaconProposerIndexForSlot(proposalSlot)
+	if err != nil {
+		return err
+	}
+	epoch := f.computeEpochAtSlot(proposalSlot)
+	parentPayload := s.LatestExecutionPayloadHeader()
+	event := &beaconevents.PayloadAttributesEvent{
+		Version: clparams.ClVersionToString(f.beaconCfg.GetCurrentStateVersion(epoch)),
+		Data: beaconevents.PayloadAttributesData{
+			ProposerIndex:     proposerIndex,
+			ProposalSlot:      proposalSlot,
+			ParentBlockNumber: parentPayload.BlockNumber,
+			ParentBlockRoot:   headHash,
+			ParentBlockHash:   parentPayload.BlockHash,
+			PayloadAttributes: beaconevents.PayloadAttributes{
+				Timestamp:  state.ComputeTimestampAtSlot(s, proposalSlot),
+				PrevRandao: s.GetRandaoMixes(epoch),
+			},
+		},
+	}
+	if s.Version() >= clparams.CapellaVersion {
+		event.Data.PayloadAttributes.Withdrawals = state.ExpectedWithdrawals(s)
+	}
+	if s.Version() >= clparams.DenebVersion {
+		event.Data.PayloadAttributes.ParentBeaconBlockRoot = &headHash
+	}
+	f.emitters.Publish(beaconevents.TopicPayloadAttributes, event)
+	return nil
+}
+
+// emitFinalizedCheckpoint publishes the new finalized checkpoint.
+func (f *ForkChoiceStore) emitFinalizedCheckpoint(checkpoint solid.Checkpoint) {
+	data := &beaconevents.FinalizedCheckpointData{
+		Block: checkpoint.BlockRoot(),
+		Epoch: checkpoint.Epoch(),
+	}
+	if header, has := f.forkGraph.GetHeader(checkpoint.BlockRoot()); has {
+		data.State = header.Root
+	}
+	f.emitters.Publish(beaconevents.TopicFinalizedCheckpoint, data)
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/ledgerwatch/erigon/cl/antiquary/tests"
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
 	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
 	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
 	"github.com/ledgerwatch/erigon/cl/phase1/forkchoice"
@@ -54,7 +55,7 @@ func TestForkChoiceBasic(t *testing.T) {
 	anchorState := state.New(&clparams.MainnetBeaconConfig)
 	require.NoError(t, utils.DecodeSSZSnappy(anchorState, anchorStateEncoded, int(clparams.AltairVersion)))
 	pool := pool.NewOperationsPool(&clparams.MainnetBeaconConfig)
-	store, err := forkchoice.NewForkChoiceStore(context.Background(), anchorState, nil, nil, pool, fork_graph.NewForkGraphDisk(anchorState, afero.NewMemMapFs()))
+	store, err := forkchoice.NewForkChoiceStore(context.Background(), anchorState, nil, nil, pool, fork_graph.NewForkGraphDisk(anchorState, afero.NewMemMapFs()), beaconevents.NewEmitters())
 	require.NoError(t, err)
 	// first steps
 	store.OnTick(0)
@@ -111,6 +112,33 @@ func TestForkChoiceBasic(t *testing.T) {
 	require.Equal(t, len(pool.VoluntaryExistsPool.Raw()), 1)
 }
 
+func TestForkChoiceLightClientBootstrap(t *testing.T) {
+	block0x3a := cltypes.NewSignedBeaconBlock(&clparams.MainnetBeaconConfig)
+	require.NoError(t, utils.DecodeSSZSnappy(block0x3a, block3aEncoded, int(clparams.AltairVersion)))
+	anchorState := state.New(&clparams.MainnetBeaconConfig)
+	require.NoError(t, utils.DecodeSSZSnappy(anchorState, anchorStateEncoded, int(clparams.AltairVersion)))
+	store, err := forkchoice.NewForkChoiceStore(context.Background(), anchorState, nil, nil, pool.NewOperationsPool(&clparams.MainnetBeaconConfig), fork_graph.NewForkGraphDisk(anchorState, afero.NewMemMapFs()), beaconevents.NewEmitters())
+	require.NoError(t, err)
+	store.OnTick(12)
+	require.NoError(t, store.OnBlock(block0x3a, false, true))
+
+	blockRoot, err := block0x3a.Block.HashSSZ()
+	require.NoError(t, err)
+	bootstrap, ok := store.GetLightClientBootstrap(blockRoot)
+	require.True(t, ok)
+	headerRoot, err := bootstrap.Header.Beacon.HashSSZ()
+	require.NoError(t, err)
+	require.Equal(t, blockRoot, headerRoot)
+	// the current sync committee must be proven against the state root of the block.
+	committeeRoot, err := bootstrap.CurrentSyncCommittee.HashSSZ()
+	require.NoError(t, err)
+	branch := make([]libcommon.Hash, bootstrap.CurrentSyncCommitteeBranch.Length())
+	for i := range branch {
+		branch[i] = bootstrap.CurrentSyncCommitteeBranch.Get(i)
+	}
+	require.True(t, utils.IsValidMerkleBranch(committeeRoot, branch, cltypes.SyncCommitteeBranchSize, 22, bootstrap.Header.Beacon.Root))
+}
+
 func TestForkChoiceChainBellatrix(t *testing.T) {
 	blocks, anchorState, _ := tests.GetBellatrixRandom()
 
@@ -125,7 +153,7 @@ func TestForkChoiceChainBellatrix(t *testing.T) {
 	}
 	// Initialize forkchoice store
 	pool := pool.NewOperationsPool(&clparams.MainnetBeaconConfig)
-	store, err := forkchoice.NewForkChoiceStore(context.Background(), anchorState, nil, nil, pool, fork_graph.NewForkGraphDisk(anchorState, afero.NewMemMapFs()))
+	store, err := forkchoice.NewForkChoiceStore(context.Background(), anchorState, nil, nil, pool, fork_graph.NewForkGraphDisk(anchorState, afero.NewMemMapFs()), beaconevents.NewEmitters())
 	store.OnTick(2000)
 	require.NoError(t, err)
 	for _, block := range blocks {
@@ -148,4 +176,10 @@ func TestForkChoiceChainBellatrix(t *testing.T) {
 
 	require.Equal(t, intermediaryState.CurrentSyncCommittee(), currentIntermediarySyncCommittee)
 	require.Equal(t, intermediaryState.NextSyncCommittee(), nextIntermediarySyncCommittee)
+
+	// the blocks of this chain carry no sync committee participation, so they only produce bootstraps.
+	bootstrap, ok := store.GetLightClientBootstrap(intermediaryBlockRoot)
+	require.True(t, ok)
+	require.Equal(t, clparams.BellatrixVersion, bootstrap.Version())
+	require.Nil(t, store.GetLightClientOptimisticUpdate())
 }
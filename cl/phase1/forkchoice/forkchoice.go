@@ -5,7 +5,9 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
 	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
 	"github.com/ledgerwatch/erigon/cl/freezer"
 	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
@@ -106,6 +108,11 @@ type ForkChoiceStore struct {
 	randaoDeltas     *lru.Cache[libcommon.Hash, randaoDelta]       // small entry can be lots of elements.
 	// participation tracking
 	participation *lru.Cache[uint64, *solid.BitList] // epoch -> [partecipation]
+	// light client server
+	lightClientData             *lru.Cache[libcommon.Hash, *lightClientBlockData]
+	lightClientUpdates          *lru.Cache[uint64, *cltypes.LightClientUpdate] // period -> best update
+	lightClientFinalityUpdate   *cltypes.LightClientFinalityUpdate
+	lightClientOptimisticUpdate *cltypes.LightClientOptimisticUpdate
 
 	mu sync.Mutex
 	// EL
@@ -115,6 +122,14 @@ type ForkChoiceStore struct {
 	// operations pool
 	operationsPool pool.OperationsPool
 	beaconCfg      *clparams.BeaconChainConfig
+
+	// events
+	emitters          *beaconevents.Emitters
+	publishedHeadHash libcommon.Hash
+	publishedHeadSlot uint64
+	// payload attributes are computed outside of the head update, by payloadAttributesLoop
+	payloadAttributesHead  libcommon.Hash // head to build on, zero if there is nothing to publish
+	payloadAttributesReady chan struct{}
 }
 
 type LatestMessage struct {
@@ -128,7 +143,7 @@ type childrens struct {
 }
 
 // NewForkChoiceStore initialize a new store from the given anchor state, either genesis or checkpoint sync state.
-func NewForkChoiceStore(ctx context.Context, anchorState *state2.CachingBeaconState, engine execution_client.ExecutionEngine, recorder freezer.Freezer, operationsPool pool.OperationsPool, forkGraph fork_graph.ForkGraph) (*ForkChoiceStore, error) {
+func NewForkChoiceStore(ctx context.Context, anchorState *state2.CachingBeaconState, engine execution_client.ExecutionEngine, recorder freezer.Freezer, operationsPool pool.OperationsPool, forkGraph fork_graph.ForkGraph, emitters *beaconevents.Emitters) (*ForkChoiceStore, error) {
 	anchorRoot, err := anchorState.BlockRoot()
 	if err != nil {
 		return nil, err
@@ -187,6 +202,16 @@ func NewForkChoiceStore(ctx context.Context, anchorState *state2.CachingBeaconSt
 		return nil, err
 	}
 
+	lightClientData, err := lru.New[libcommon.Hash, *lightClientBlockData](checkpointsPerCache)
+	if err != nil {
+		return nil, err
+	}
+
+	lightClientUpdates, err := lru.New[uint64, *cltypes.LightClientUpdate](lightClientUpdatesPerCache)
+	if err != nil {
+		return nil, err
+	}
+
 	participation.Add(state.Epoch(anchorState.BeaconState), anchorState.CurrentEpochParticipation().Copy())
 
 	totalActiveBalances.Add(anchorRoot, anchorState.GetTotalActiveBalance())
@@ -195,7 +220,7 @@ func NewForkChoiceStore(ctx context.Context, anchorState *state2.CachingBeaconSt
 	randaoMixesLists.Add(anchorRoot, r)
 	headSet := make(map[libcommon.Hash]struct{})
 	headSet[anchorRoot] = struct{}{}
-	return &ForkChoiceStore{
+	f := &ForkChoiceStore{
 		ctx:                           ctx,
 		highestSeen:                   anchorState.Slot(),
 		time:                          anchorState.GenesisTime() + anchorState.BeaconConfig().SecondsPerSlot*anchorState.Slot(),
@@ -223,7 +248,13 @@ func NewForkChoiceStore(ctx context.Context, anchorState *state2.CachingBeaconSt
 		headSet:                       headSet,
 		weights:                       make(map[libcommon.Hash]uint64),
 		participation:                 participation,
-	}, nil
+		lightClientData:               lightClientData,
+		lightClientUpdates:            lightClientUpdates,
+		emitters:                      emitters,
+		payloadAttributesReady:        make(chan struct{}, 1),
+	}
+	go f.payloadAttributesLoop(ctx)
+	return f, nil
 }
 
 // Highest seen returns highest seen slot
@@ -59,6 +59,7 @@ type ForkChoiceStorageMock struct {
 	ProposerBoostRootVal   common.Hash
 	SlotVal                uint64
 	TimeVal                uint64
+	EngineVal              execution_client.ExecutionEngine
 
 	ParticipationVal *solid.BitList
 
@@ -68,6 +69,12 @@ type ForkChoiceStorageMock struct {
 	GetFinalityCheckpointsVal map[common.Hash][3]solid.Checkpoint
 	WeightsMock               []ForkNode
 
+	LightClientBootstrapsVal       map[common.Hash]*cltypes.LightClientBootstrap
+	NewestLightClientUpdateVal     *cltypes.LightClientUpdate
+	LightClientUpdatesVal          map[uint64]*cltypes.LightClientUpdate
+	LightClientFinalityUpdateVal   *cltypes.LightClientFinalityUpdate
+	LightClientOptimisticUpdateVal *cltypes.LightClientOptimisticUpdate
+
 	Pool pool.OperationsPool
 }
 
@@ -88,6 +95,8 @@ func NewForkChoiceStorageMock() *ForkChoiceStorageMock {
 		StateAtSlotVal:            make(map[uint64]*state.CachingBeaconState),
 		GetSyncCommitteesVal:      make(map[common.Hash][2]*solid.SyncCommittee),
 		GetFinalityCheckpointsVal: make(map[common.Hash][3]solid.Checkpoint),
+		LightClientBootstrapsVal:  make(map[common.Hash]*cltypes.LightClientBootstrap),
+		LightClientUpdatesVal:     make(map[uint64]*cltypes.LightClientUpdate),
 	}
 }
 
@@ -100,7 +109,7 @@ func (f *ForkChoiceStorageMock) AnchorSlot() uint64 {
 }
 
 func (f *ForkChoiceStorageMock) Engine() execution_client.ExecutionEngine {
-	panic("implement me")
+	return f.EngineVal
 }
 
 func (f *ForkChoiceStorageMock) FinalizedCheckpoint() solid.Checkpoint {
@@ -217,6 +226,47 @@ func (f *ForkChoiceStorageMock) OnBlsToExecutionChange(signedChange *cltypes.Sig
 	return nil
 }
 
+func (f *ForkChoiceStorageMock) OnAggregateAndProof(aggregateAndProof *cltypes.SignedAggregateAndProof, test bool) error {
+	f.Pool.AttestationsPool.Insert(aggregateAndProof.Message.Aggregate.Signature(), aggregateAndProof.Message.Aggregate)
+	return nil
+}
+
+func (f *ForkChoiceStorageMock) OnSignedContributionAndProof(signedContribution *cltypes.SignedContributionAndProof, test bool) error {
+	f.Pool.ContributionsPool.Insert(signedContribution.Signature, signedContribution)
+	return nil
+}
+
+func (f *ForkChoiceStorageMock) OnSyncCommitteeMessage(msg *cltypes.SyncCommitteeMessage, test bool) error {
+	f.Pool.SyncCommitteeMessagesPool.Insert(msg.Signature, msg)
+	return nil
+}
+
+func (f *ForkChoiceStorageMock) OnBlobSidecar(blobSidecar *cltypes.BlobSidecar, test bool) error {
+	return nil
+}
+
 func (f *ForkChoiceStorageMock) ForkNodes() []ForkNode {
 	return f.WeightsMock
 }
+
+func (f *ForkChoiceStorageMock) GetLightClientBootstrap(blockRoot common.Hash) (*cltypes.LightClientBootstrap, bool) {
+	bootstrap, ok := f.LightClientBootstrapsVal[blockRoot]
+	return bootstrap, ok
+}
+
+func (f *ForkChoiceStorageMock) NewestLightClientUpdate() *cltypes.LightClientUpdate {
+	return f.NewestLightClientUpdateVal
+}
+
+func (f *ForkChoiceStorageMock) GetLightClientUpdate(period uint64) (*cltypes.LightClientUpdate, bool) {
+	update, ok := f.LightClientUpdatesVal[period]
+	return update, ok
+}
+
+func (f *ForkChoiceStorageMock) GetLightClientFinalityUpdate() *cltypes.LightClientFinalityUpdate {
+	return f.LightClientFinalityUpdateVal
+}
+
+func (f *ForkChoiceStorageMock) GetLightClientOptimisticUpdate() *cltypes.LightClientOptimisticUpdate {
+	return f.LightClientOptimisticUpdateVal
+}
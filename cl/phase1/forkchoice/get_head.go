@@ -88,6 +88,7 @@ func (f *ForkChoiceStore) getHead() (libcommon.Hash, uint64, error) {
 				return libcommon.Hash{}, 0, fmt.Errorf("no slot for head is stored")
 			}
 			f.headSlot = header.Slot
+			f.emitHeadEvents(f.headHash, f.headSlot)
 			return f.headHash, f.headSlot, nil
 		}
 		// Average case scenario.
@@ -41,14 +41,24 @@ type ForkChoiceStorageReader interface {
 	GetStateAtSlot(slot uint64, alwaysCopy bool) (*state.CachingBeaconState, error)
 	GetStateAtStateRoot(root libcommon.Hash, alwaysCopy bool) (*state.CachingBeaconState, error)
 	ForkNodes() []ForkNode
+
+	GetLightClientBootstrap(blockRoot libcommon.Hash) (*cltypes.LightClientBootstrap, bool)
+	NewestLightClientUpdate() *cltypes.LightClientUpdate
+	GetLightClientUpdate(period uint64) (*cltypes.LightClientUpdate, bool)
+	GetLightClientFinalityUpdate() *cltypes.LightClientFinalityUpdate
+	GetLightClientOptimisticUpdate() *cltypes.LightClientOptimisticUpdate
 }
 
 type ForkChoiceStorageWriter interface {
 	OnAttestation(attestation *solid.Attestation, fromBlock, insert bool) error
+	OnAggregateAndProof(aggregateAndProof *cltypes.SignedAggregateAndProof, test bool) error
 	OnAttesterSlashing(attesterSlashing *cltypes.AttesterSlashing, test bool) error
 	OnVoluntaryExit(signedVoluntaryExit *cltypes.SignedVoluntaryExit, test bool) error
 	OnProposerSlashing(proposerSlashing *cltypes.ProposerSlashing, test bool) error
 	OnBlsToExecutionChange(signedChange *cltypes.SignedBLSToExecutionChange, test bool) error
+	OnSignedContributionAndProof(signedContribution *cltypes.SignedContributionAndProof, test bool) error
+	OnSyncCommitteeMessage(msg *cltypes.SyncCommitteeMessage, test bool) error
+	OnBlobSidecar(blobSidecar *cltypes.BlobSidecar, test bool) error
 	OnBlock(block *cltypes.SignedBeaconBlock, newPayload bool, fullValidation bool) error
 	OnTick(time uint64)
 }
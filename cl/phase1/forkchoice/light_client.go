@@ -0,0 +1,277 @@
+package forkchoice
+
+import (
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
+)
+
+// lightClientUpdatesPerCache is the number of sync committee periods whose best update we keep in memory, older ones
+// are only served from the database.
+const lightClientUpdatesPerCache = 8
+
+// lightClientBlockData is what a processed block brings to the light client server: its own bootstrap and the data
+// proven by the updates its children attest to.
+type lightClientBlockData struct {
+	bootstrap               *cltypes.LightClientBootstrap
+	nextSyncCommittee       *solid.SyncCommittee
+	nextSyncCommitteeBranch [][32]byte
+	finalizedRoot           libcommon.Hash
+	finalityBranch          [][32]byte
+}
+
+// GetLightClientBootstrap returns the bootstrap of a processed block.
+func (f *ForkChoiceStore) GetLightClientBootstrap(blockRoot libcommon.Hash) (*cltypes.LightClientBootstrap, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.lightClientData.Get(blockRoot)
+	if !ok {
+		return nil, false
+	}
+	return data.bootstrap, true
+}
+
+// GetLightClientUpdate returns the best update seen for the given sync committee period.
+func (f *ForkChoiceStore) GetLightClientUpdate(period uint64) (*cltypes.LightClientUpdate, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lightClientUpdates.Get(period)
+}
+
+// NewestLightClientUpdate returns the best update of the most recently attested sync committee period.
+func (f *ForkChoiceStore) NewestLightClientUpdate() *cltypes.LightClientUpdate {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.lightClientOptimisticUpdate == nil {
+		return nil
+	}
+	update, _ := f.lightClientUpdates.Get(f.beaconCfg.SyncCommitteePeriod(f.lightClientOptimisticUpdate.AttestedHeader.Beacon.Slot))
+	return update
+}
+
+// GetLightClientFinalityUpdate returns the finality update attesting the most recent header.
+func (f *ForkChoiceStore) GetLightClientFinalityUpdate() *cltypes.LightClientFinalityUpdate {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lightClientFinalityUpdate
+}
+
+// GetLightClientOptimisticUpdate returns the optimistic update attesting the most recent header.
+func (f *ForkChoiceStore) GetLightClientOptimisticUpdate() *cltypes.LightClientOptimisticUpdate {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lightClientOptimisticUpdate
+}
+
+// onLightClientBlock derives the light client data of a processed block from its post state, then builds the updates
+// attested by its sync aggregate.
+func (f *ForkChoiceStore) onLightClientBlock(block *cltypes.SignedBeaconBlock, blockRoot libcommon.Hash, s *state.CachingBeaconState) error {
+	if s.Version() < clparams.AltairVersion {
+		return nil
+	}
+	header, err := lightClientHeaderFromBlock(block)
+	if err != nil {
+		return err
+	}
+	currentSyncCommitteeBranch, err := s.CurrentSyncCommitteeBranch()
+	if err != nil {
+		return err
+	}
+	nextSyncCommitteeBranch, err := s.NextSyncCommitteeBranch()
+	if err != nil {
+		return err
+	}
+	finalityBranch, err := s.FinalityRootBranch()
+	if err != nil {
+		return err
+	}
+	data := &lightClientBlockData{
+		bootstrap: &cltypes.LightClientBootstrap{
+			Header:                     header,
+			CurrentSyncCommitteeBranch: branchToHashVector(currentSyncCommitteeBranch),
+		},
+		nextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		finalizedRoot:           s.FinalizedCheckpoint().BlockRoot(),
+		finalityBranch:          finalityBranch,
+	}
+	// sync committees only change once per period, so they are shared with the parent whenever possible.
+	parent, hasParent := f.lightClientData.Get(block.Block.ParentRoot)
+	if hasParent && parent.bootstrap.CurrentSyncCommittee.Equal(s.CurrentSyncCommittee()) {
+		data.bootstrap.CurrentSyncCommittee = parent.bootstrap.CurrentSyncCommittee
+	} else {
+		data.bootstrap.CurrentSyncCommittee = s.CurrentSyncCommittee().Copy()
+	}
+	if hasParent && parent.nextSyncCommittee.Equal(s.NextSyncCommittee()) {
+		data.nextSyncCommittee = parent.nextSyncCommittee
+	} else {
+		data.nextSyncCommittee = s.NextSyncCommittee().Copy()
+	}
+	f.lightClientData.Add(blockRoot, data)
+
+	if !hasParent {
+		return nil
+	}
+	f.onLightClientSyncAggregate(block, parent)
+	return nil
+}
+
+// onLightClientSyncAggregate builds the updates of the parent block from the sync aggregate of its child.
+func (f *ForkChoiceStore) onLightClientSyncAggregate(block *cltypes.SignedBeaconBlock, attested *lightClientBlockData) {
+	syncAggregate := block.Block.Body.SyncAggregate
+	if syncAggregate == nil || uint64(syncAggregate.Sum()) < f.beaconCfg.MinSyncCommitteeParticipants {
+		return
+	}
+	attestedHeader := attested.bootstrap.Header
+	signatureSlot := block.Block.Slot
+	aggregate := *syncAggregate
+	update := cltypes.NewLightClientUpdate(attestedHeader.Version())
+	update.AttestedHeader = attestedHeader
+	update.SyncAggregate = &aggregate
+	update.SignatureSlot = signatureSlot
+
+	attestedPeriod := f.beaconCfg.SyncCommitteePeriod(attestedHeader.Beacon.Slot)
+	// the next sync committee is only useful to light clients if it is attested in the period it is signed in.
+	if attestedPeriod == f.beaconCfg.SyncCommitteePeriod(signatureSlot) {
+		update.NextSyncCommittee = attested.nextSyncCommittee
+		update.NextSyncCommitteeBranch = branchToHashVector(attested.nextSyncCommitteeBranch)
+	}
+	// the finalized header is unknown if its block was not processed by us, e.g. the anchor block.
+	finalized, hasFinality := f.lightClientData.Get(attested.finalizedRoot)
+	if hasFinality {
+		update.FinalizedHeader = upgradeLightClientHeader(finalized.bootstrap.Header, attestedHeader.Version())
+		update.FinalityBranch = branchToHashVector(attested.finalityBranch)
+	}
+
+	if best, ok := f.lightClientUpdates.Get(attestedPeriod); !ok || f.isBetterLightClientUpdate(update, best) {
+		f.lightClientUpdates.Add(attestedPeriod, update)
+	}
+	// the latest updates are the ones with the highest attested header, and then the highest signature slot.
+	if hasFinality && (f.lightClientFinalityUpdate == nil ||
+		isNewerLightClientUpdate(update, f.lightClientFinalityUpdate.AttestedHeader, f.lightClientFinalityUpdate.SignatureSlot)) {
+		f.lightClientFinalityUpdate = &cltypes.LightClientFinalityUpdate{
+			AttestedHeader:  update.AttestedHeader,
+			FinalizedHeader: update.FinalizedHeader,
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   update.SyncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		}
+	}
+	if f.lightClientOptimisticUpdate == nil ||
+		isNewerLightClientUpdate(update, f.lightClientOptimisticUpdate.AttestedHeader, f.lightClientOptimisticUpdate.SignatureSlot) {
+		f.lightClientOptimisticUpdate = &cltypes.LightClientOptimisticUpdate{
+			AttestedHeader: update.AttestedHeader,
+			SyncAggregate:  update.SyncAggregate,
+			SignatureSlot:  update.SignatureSlot,
+		}
+	}
+}
+
+func isNewerLightClientUpdate(update *cltypes.LightClientUpdate, attestedHeader *cltypes.LightClientHeader, signatureSlot uint64) bool {
+	if update.AttestedHeader.Beacon.Slot != attestedHeader.Beacon.Slot {
+		return update.AttestedHeader.Beacon.Slot > attestedHeader.Beacon.Slot
+	}
+	return update.SignatureSlot > signatureSlot
+}
+
+// isBetterLightClientUpdate implements is_better_update from the light client specs.
+func (f *ForkChoiceStore) isBetterLightClientUpdate(newUpdate, oldUpdate *cltypes.LightClientUpdate) bool {
+	maxParticipants := int(f.beaconCfg.SyncCommitteeSize)
+	newParticipants, oldParticipants := newUpdate.SyncAggregate.Sum(), oldUpdate.SyncAggregate.Sum()
+	newSupermajority, oldSupermajority := newParticipants*3 >= maxParticipants*2, oldParticipants*3 >= maxParticipants*2
+	if newSupermajority != oldSupermajority {
+		return newSupermajority
+	}
+	if !newSupermajority && newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+	// Compare presence of relevant sync committee
+	newRelevantSyncCommittee, oldRelevantSyncCommittee := f.hasRelevantSyncCommittee(newUpdate), f.hasRelevantSyncCommittee(oldUpdate)
+	if newRelevantSyncCommittee != oldRelevantSyncCommittee {
+		return newRelevantSyncCommittee
+	}
+	// Compare indication of any finality
+	newFinality, oldFinality := !isZeroBranch(newUpdate.FinalityBranch), !isZeroBranch(oldUpdate.FinalityBranch)
+	if newFinality != oldFinality {
+		return newFinality
+	}
+	// Compare sync committee finality
+	if newFinality {
+		newSyncCommitteeFinality := f.beaconCfg.SyncCommitteePeriod(newUpdate.FinalizedHeader.Beacon.Slot) == f.beaconCfg.SyncCommitteePeriod(newUpdate.AttestedHeader.Beacon.Slot)
+		oldSyncCommitteeFinality := f.beaconCfg.SyncCommitteePeriod(oldUpdate.FinalizedHeader.Beacon.Slot) == f.beaconCfg.SyncCommitteePeriod(oldUpdate.AttestedHeader.Beacon.Slot)
+		if newSyncCommitteeFinality != oldSyncCommitteeFinality {
+			return newSyncCommitteeFinality
+		}
+	}
+	// Tiebreaker 1: Sync committee participation beyond supermajority
+	if newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+	// Tiebreaker 2: Prefer older data (fewer changes to best)
+	if newUpdate.AttestedHeader.Beacon.Slot != oldUpdate.AttestedHeader.Beacon.Slot {
+		return newUpdate.AttestedHeader.Beacon.Slot < oldUpdate.AttestedHeader.Beacon.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func (f *ForkChoiceStore) hasRelevantSyncCommittee(update *cltypes.LightClientUpdate) bool {
+	return !isZeroBranch(update.NextSyncCommitteeBranch) &&
+		f.beaconCfg.SyncCommitteePeriod(update.AttestedHeader.Beacon.Slot) == f.beaconCfg.SyncCommitteePeriod(update.SignatureSlot)
+}
+
+// lightClientHeaderFromBlock builds the light client header of a block, proving its execution payload from capella onwards.
+func lightClientHeaderFromBlock(block *cltypes.SignedBeaconBlock) (*cltypes.LightClientHeader, error) {
+	header := cltypes.NewLightClientHeader(block.Version())
+	header.Beacon = block.SignedBeaconBlockHeader().Header
+	if block.Version() < clparams.CapellaVersion {
+		return header, nil
+	}
+	executionHeader, err := block.Block.Body.ExecutionPayload.PayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	executionBranch, err := block.Block.Body.ExecutionBranch()
+	if err != nil {
+		return nil, err
+	}
+	header.ExecutionHeader = executionHeader
+	header.ExecutionBranch = branchToHashVector(executionBranch)
+	return header, nil
+}
+
+// upgradeLightClientHeader converts a header to the format of a later fork, as light client objects carry all their
+// headers in the format of the attested one.
+func upgradeLightClientHeader(header *cltypes.LightClientHeader, version clparams.StateVersion) *cltypes.LightClientHeader {
+	if header.Version() == version {
+		return header
+	}
+	upgraded := cltypes.NewLightClientHeader(version)
+	upgraded.Beacon = header.Beacon
+	if header.Version() < clparams.CapellaVersion {
+		return upgraded
+	}
+	upgraded.ExecutionHeader = header.ExecutionHeader.Copy()
+	if version >= clparams.DenebVersion {
+		upgraded.ExecutionHeader.Deneb()
+	}
+	upgraded.ExecutionBranch = header.ExecutionBranch
+	return upgraded
+}
+
+func branchToHashVector(branch [][32]byte) solid.HashVectorSSZ {
+	vector := solid.NewHashVector(len(branch))
+	for i := range branch {
+		vector.Set(i, branch[i])
+	}
+	return vector
+}
+
+func isZeroBranch(branch solid.HashVectorSSZ) bool {
+	zero := true
+	branch.Range(func(_ int, h libcommon.Hash, _ int) bool {
+		zero = h == (libcommon.Hash{})
+		return zero
+	})
+	return zero
+}
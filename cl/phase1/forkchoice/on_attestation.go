@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
 	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
 	"github.com/ledgerwatch/erigon/cl/phase1/cache"
@@ -65,6 +66,7 @@ func (f *ForkChoiceStore) OnAttestation(attestation *solid.Attestation, fromBloc
 	if !fromBlock && insert {
 		// Add to the pool when verified.
 		f.operationsPool.AttestationsPool.Insert(attestation.Signature(), attestation)
+		f.emitters.Publish(beaconevents.TopicAttestation, attestation)
 	}
 	return nil
 }
@@ -92,7 +94,7 @@ func (f *ForkChoiceStore) OnAggregateAndProof(aggregateAndProof *cltypes.SignedA
 		log.Warn("invalid aggregate and proof")
 		return fmt.Errorf("invalid aggregate and proof")
 	}
-	return f.OnAttestation(aggregateAndProof.Message.Aggregate, false, false)
+	return f.OnAttestation(aggregateAndProof.Message.Aggregate, false, true)
 }
 
 // scheduleAttestationForLaterProcessing scheudules an attestation for later processing
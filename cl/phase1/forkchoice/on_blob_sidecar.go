@@ -0,0 +1,75 @@
+package forkchoice
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Giulio2002/bls"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/ledgerwatch/erigon-lib/crypto/kzg"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/fork"
+	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
+)
+
+// OnBlobSidecar is a non-official handler which verifies a blob sidecar before it is stored: its commitment must be part
+// of the block body, its kzg proof must match the blob and its header must be signed by the block proposer.
+func (f *ForkChoiceStore) OnBlobSidecar(blobSidecar *cltypes.BlobSidecar, test bool) error {
+	if blobSidecar.Index >= f.beaconCfg.MaxBlobsPerBlock {
+		return fmt.Errorf("blob sidecar index %d is out of range", blobSidecar.Index)
+	}
+	header := blobSidecar.SignedBlockHeader.Header
+	if header.Slot > f.Slot() {
+		return fmt.Errorf("blob sidecar slot %d is in the future", header.Slot)
+	}
+	if !blobSidecar.VerifyCommitmentInclusionProof() {
+		return errors.New("blob sidecar commitment inclusion proof is invalid")
+	}
+	if err := kzg.Ctx().VerifyBlobKZGProof(gokzg4844.Blob(blobSidecar.Blob), gokzg4844.KZGCommitment(blobSidecar.KzgCommitment), gokzg4844.KZGProof(blobSidecar.KzgProof)); err != nil {
+		return fmt.Errorf("blob sidecar kzg proof is invalid: %v", err)
+	}
+
+	// Take lock as we interact with state.
+	f.mu.Lock()
+	if header.Slot <= f.computeStartSlotAtEpoch(f.finalizedCheckpoint.Epoch()) {
+		f.mu.Unlock()
+		return fmt.Errorf("blob sidecar slot %d is already finalized", header.Slot)
+	}
+	headHash, _, err := f.getHead()
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	s, err := f.forkGraph.GetState(headHash, false)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	proposer, err := s.ValidatorForValidatorIndex(int(header.ProposerIndex))
+	if err != nil {
+		f.mu.Unlock()
+		return fmt.Errorf("unable to retrieve proposer: %v", err)
+	}
+	domain, err := s.GetDomain(s.BeaconConfig().DomainBeaconProposer, state.GetEpochAtSlot(s.BeaconConfig(), header.Slot))
+	if err != nil {
+		f.mu.Unlock()
+		return fmt.Errorf("unable to get domain: %v", err)
+	}
+	pk := proposer.PublicKey()
+	f.mu.Unlock()
+	if test {
+		return nil
+	}
+	signingRoot, err := fork.ComputeSigningRoot(header, domain)
+	if err != nil {
+		return fmt.Errorf("unable to compute signing root: %v", err)
+	}
+	valid, err := bls.Verify(blobSidecar.SignedBlockHeader.Signature[:], signingRoot[:], pk[:])
+	if err != nil {
+		return fmt.Errorf("unable to verify signature: %v", err)
+	}
+	if !valid {
+		return errors.New("blob sidecar header signature is invalid")
+	}
+	return nil
+}
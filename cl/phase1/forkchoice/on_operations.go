@@ -2,10 +2,14 @@ package forkchoice
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 
 	"github.com/Giulio2002/bls"
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon/cl/beacon/beaconevents"
+	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/cl/fork"
 	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
@@ -67,6 +71,7 @@ func (f *ForkChoiceStore) OnVoluntaryExit(signedVoluntaryExit *cltypes.SignedVol
 		}
 	}
 	f.operationsPool.VoluntaryExistsPool.Insert(voluntaryExit.ValidatorIndex, signedVoluntaryExit)
+	f.emitters.Publish(beaconevents.TopicVoluntaryExit, signedVoluntaryExit)
 	return nil
 }
 
@@ -214,3 +219,192 @@ func (f *ForkChoiceStore) OnBlsToExecutionChange(signedChange *cltypes.SignedBLS
 	f.operationsPool.BLSToExecutionChangesPool.Insert(signedChange.Signature, signedChange)
 	return nil
 }
+
+// OnSignedContributionAndProof is a non-official handler for sync committee contributions. it pushes the contribution in the pool.
+func (f *ForkChoiceStore) OnSignedContributionAndProof(signedContribution *cltypes.SignedContributionAndProof, test bool) error {
+	if f.operationsPool.ContributionsPool.Has(signedContribution.Signature) {
+		return nil
+	}
+	message := signedContribution.Message
+	contribution := message.Contribution
+	subcommitteeIndex := contribution.SubcommitteeIndex()
+	if subcommitteeIndex >= f.beaconCfg.SyncCommitteeSubnetCount {
+		return fmt.Errorf("invalid subcommittee index: %d", subcommitteeIndex)
+	}
+	aggregationBits := contribution.AggregationBits()
+	if bytes.Equal(aggregationBits[:], make([]byte, len(aggregationBits))) {
+		return fmt.Errorf("contribution has no participants")
+	}
+	subcommitteeSize := f.beaconCfg.SyncCommitteeSize / f.beaconCfg.SyncCommitteeSubnetCount
+	modulo := utils.Max64(1, subcommitteeSize/f.beaconCfg.TargetAggregatorsPerSyncSubcommittee)
+	hashedSelectionProof := utils.Sha256(message.SelectionProof[:])
+	if binary.LittleEndian.Uint64(hashedSelectionProof[:8])%modulo != 0 {
+		return fmt.Errorf("validator %d is not a sync committee aggregator", message.AggregatorIndex)
+	}
+
+	// Take lock as we interact with state.
+	f.mu.Lock()
+	headHash, _, err := f.getHead()
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	s, err := f.forkGraph.GetState(headHash, false)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	if s.Version() < clparams.AltairVersion {
+		f.mu.Unlock()
+		return fmt.Errorf("sync committees are not active before altair")
+	}
+	syncCommittee := s.CurrentSyncCommittee()
+	contributionPeriod := state.GetEpochAtSlot(f.beaconCfg, contribution.Slot()) / f.beaconCfg.EpochsPerSyncCommitteePeriod
+	if contributionPeriod == state.Epoch(s)/f.beaconCfg.EpochsPerSyncCommitteePeriod+1 {
+		syncCommittee = s.NextSyncCommittee()
+	}
+	subcommittee := syncCommittee.GetCommittee()[subcommitteeIndex*subcommitteeSize : (subcommitteeIndex+1)*subcommitteeSize]
+	aggregator, err := s.ValidatorForValidatorIndex(int(message.AggregatorIndex))
+	if err != nil {
+		f.mu.Unlock()
+		return fmt.Errorf("unable to retrieve aggregator: %v", err)
+	}
+	aggregatorPublicKey := aggregator.PublicKey()
+	isMember := false
+	participants := make([][]byte, 0, subcommitteeSize)
+	for i, publicKey := range subcommittee {
+		if publicKey == aggregatorPublicKey {
+			isMember = true
+		}
+		if aggregationBits[i/8]&(1<<(i%8)) > 0 {
+			participants = append(participants, common.CopyBytes(publicKey[:]))
+		}
+	}
+	if !isMember {
+		f.mu.Unlock()
+		return fmt.Errorf("aggregator %d is not part of subcommittee %d", message.AggregatorIndex, subcommitteeIndex)
+	}
+	contributionEpoch := state.GetEpochAtSlot(f.beaconCfg, contribution.Slot())
+	selectionProofDomain, err := s.GetDomain(f.beaconCfg.DomainSyncCommitteeSelectionProof, contributionEpoch)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	contributionAndProofDomain, err := s.GetDomain(f.beaconCfg.DomainContributionAndProof, contributionEpoch)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	syncCommitteeDomain, err := s.GetDomain(f.beaconCfg.DomainSyncCommittee, contributionEpoch)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	f.mu.Unlock()
+
+	if !test {
+		// Verify the selection proof.
+		signingRoot, err := fork.ComputeSigningRoot(&cltypes.SyncAggregatorSelectionData{
+			Slot:              contribution.Slot(),
+			SubcommitteeIndex: subcommitteeIndex,
+		}, selectionProofDomain)
+		if err != nil {
+			return err
+		}
+		valid, err := bls.Verify(message.SelectionProof[:], signingRoot[:], aggregatorPublicKey[:])
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("invalid selection proof")
+		}
+		// Verify the aggregator signature.
+		signingRoot, err = fork.ComputeSigningRoot(message, contributionAndProofDomain)
+		if err != nil {
+			return err
+		}
+		valid, err = bls.Verify(signedContribution.Signature[:], signingRoot[:], aggregatorPublicKey[:])
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("invalid contribution and proof signature")
+		}
+		// Verify the aggregate signature of the participants.
+		blockRoot := contribution.BeaconBlockRoot()
+		signingRoot = utils.Sha256(blockRoot[:], syncCommitteeDomain)
+		signature := contribution.Signature()
+		valid, err = bls.VerifyAggregate(signature[:], signingRoot[:], participants)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("invalid contribution aggregate signature")
+		}
+	}
+	f.operationsPool.ContributionsPool.Insert(signedContribution.Signature, signedContribution)
+	return nil
+}
+
+// OnSyncCommitteeMessage is a non-official handler for sync committee messages. it pushes the message in the pool.
+func (f *ForkChoiceStore) OnSyncCommitteeMessage(msg *cltypes.SyncCommitteeMessage, test bool) error {
+	if f.operationsPool.SyncCommitteeMessagesPool.Has(msg.Signature) {
+		return nil
+	}
+
+	// Take lock as we interact with state.
+	f.mu.Lock()
+	// Messages are only useful for the current slot (allowing for one slot of clock disparity).
+	if currentSlot := f.Slot(); msg.Slot > currentSlot+1 || msg.Slot+1 < currentSlot {
+		f.mu.Unlock()
+		return fmt.Errorf("sync committee message for slot %d is not current, current slot is %d", msg.Slot, currentSlot)
+	}
+	headHash, _, err := f.getHead()
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	s, err := f.forkGraph.GetState(headHash, false)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	if s.Version() < clparams.AltairVersion {
+		f.mu.Unlock()
+		return fmt.Errorf("sync committees are not active before altair")
+	}
+	positions, err := state.SyncCommitteePositions(s, msg.Slot, msg.ValidatorIndex)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	if len(positions) == 0 {
+		f.mu.Unlock()
+		return fmt.Errorf("validator %d is not part of the sync committee", msg.ValidatorIndex)
+	}
+	validator, err := s.ValidatorForValidatorIndex(int(msg.ValidatorIndex))
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	publicKey := validator.PublicKey()
+	domain, err := s.GetDomain(f.beaconCfg.DomainSyncCommittee, state.GetEpochAtSlot(f.beaconCfg, msg.Slot))
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	f.mu.Unlock()
+
+	if !test {
+		signingRoot := utils.Sha256(msg.BeaconBlockRoot[:], domain)
+		valid, err := bls.Verify(msg.Signature[:], signingRoot[:], publicKey[:])
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("invalid sync committee message signature")
+		}
+	}
+	f.operationsPool.SyncCommitteeMessagesPool.Insert(msg.Signature, msg)
+	return nil
+}
@@ -24,7 +24,7 @@ func (f *ForkChoiceStore) updateCheckpoints(justifiedCheckpoint, finalizedCheckp
 	if finalizedCheckpoint.Epoch() > f.finalizedCheckpoint.Epoch() {
 		f.onNewFinalized(finalizedCheckpoint)
 		f.finalizedCheckpoint = finalizedCheckpoint
-
+		f.emitFinalizedCheckpoint(finalizedCheckpoint)
 	}
 }
 
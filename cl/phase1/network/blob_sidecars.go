@@ -0,0 +1,30 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/gossip"
+)
+
+func blobSidecarSubnet(topic string) (uint64, error) {
+	return strconv.ParseUint(strings.TrimPrefix(topic, gossip.TopicNamePrefixBlobSidecar), 10, 64)
+}
+
+// onBlobSidecar verifies a blob sidecar received on the given subnet and stores it.
+func (g *GossipManager) onBlobSidecar(ctx context.Context, subnet uint64, blobSidecar *cltypes.BlobSidecar, test bool) error {
+	if blobSidecar.Index%g.beaconConfig.BlobSidecarSubnetCount != subnet {
+		return fmt.Errorf("blob sidecar %d received on the wrong subnet %d", blobSidecar.Index, subnet)
+	}
+	if err := g.forkChoice.OnBlobSidecar(blobSidecar, test); err != nil {
+		return err
+	}
+	blockRoot, err := blobSidecar.SignedBlockHeader.Header.HashSSZ()
+	if err != nil {
+		return err
+	}
+	return g.blobStorage.WriteBlobSidecars(ctx, blockRoot, []*cltypes.BlobSidecar{blobSidecar})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ledgerwatch/erigon/cl/freezer"
 	"github.com/ledgerwatch/erigon/cl/gossip"
+	"github.com/ledgerwatch/erigon/cl/persistence/blob_storage"
 	"github.com/ledgerwatch/erigon/cl/phase1/forkchoice"
 	"github.com/ledgerwatch/erigon/cl/sentinel/peers"
 
@@ -22,9 +23,10 @@ import (
 
 // Gossip manager is sending all messages to fork choice or others
 type GossipManager struct {
-	recorder   freezer.Freezer
-	forkChoice *forkchoice.ForkChoiceStore
-	sentinel   sentinel.SentinelClient
+	recorder    freezer.Freezer
+	forkChoice  *forkchoice.ForkChoiceStore
+	sentinel    sentinel.SentinelClient
+	blobStorage blob_storage.BlobStorage
 	// configs
 	beaconConfig  *clparams.BeaconChainConfig
 	genesisConfig *clparams.GenesisConfig
@@ -32,13 +34,18 @@ type GossipManager struct {
 	mu        sync.RWMutex
 	subs      map[int]chan *peers.PeeredObject[*cltypes.SignedBeaconBlock]
 	totalSubs int
+
+	// light client updates we already gossiped, only accessed by the stages.
+	publishedFinalityUpdate   *cltypes.LightClientFinalityUpdate
+	publishedOptimisticUpdate *cltypes.LightClientOptimisticUpdate
 }
 
 func NewGossipReceiver(s sentinel.SentinelClient, forkChoice *forkchoice.ForkChoiceStore,
-	beaconConfig *clparams.BeaconChainConfig, genesisConfig *clparams.GenesisConfig, recorder freezer.Freezer) *GossipManager {
+	beaconConfig *clparams.BeaconChainConfig, genesisConfig *clparams.GenesisConfig, recorder freezer.Freezer, blobStorage blob_storage.BlobStorage) *GossipManager {
 	return &GossipManager{
 		sentinel:      s,
 		forkChoice:    forkChoice,
+		blobStorage:   blobStorage,
 		beaconConfig:  beaconConfig,
 		genesisConfig: genesisConfig,
 		recorder:      recorder,
@@ -64,6 +71,23 @@ func (g *GossipManager) SubscribeSignedBeaconBlocks(ctx context.Context) <-chan
 	return out
 }
 
+func (g *GossipManager) notifyBlockSubscribers(block *peers.PeeredObject[*cltypes.SignedBeaconBlock]) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for _, v := range g.subs {
+		select {
+		case v <- block:
+		default:
+		}
+	}
+}
+
+// ImportSignedBeaconBlock hands a block which did not come from gossip (e.g. one published through the beacon api) to
+// the subscribers, so that it is imported and persisted exactly like the gossiped ones.
+func (g *GossipManager) ImportSignedBeaconBlock(block *cltypes.SignedBeaconBlock) {
+	g.notifyBlockSubscribers(&peers.PeeredObject[*cltypes.SignedBeaconBlock]{Data: block})
+}
+
 func operationsContract[T ssz.EncodableSSZ](ctx context.Context, g *GossipManager, l log.Ctx, data *sentinel.GossipData, version int, name string, fn func(T, bool) error) error {
 	var t T
 	object := t.Clone().(T)
@@ -135,14 +159,7 @@ func (g *GossipManager) onRecv(ctx context.Context, data *sentinel.GossipData, l
 			return err
 		}
 
-		g.mu.RLock()
-		for _, v := range g.subs {
-			select {
-			case v <- &peers.PeeredObject[*cltypes.SignedBeaconBlock]{Data: block, Peer: data.Peer.Pid}:
-			default:
-			}
-		}
-		g.mu.RUnlock()
+		g.notifyBlockSubscribers(&peers.PeeredObject[*cltypes.SignedBeaconBlock]{Data: block, Peer: data.Peer.Pid})
 
 	case gossip.TopicNameVoluntaryExit:
 		if err := operationsContract[*cltypes.SignedVoluntaryExit](ctx, g, l, data, int(version), "voluntary exit", g.forkChoice.OnVoluntaryExit); err != nil {
@@ -164,6 +181,30 @@ func (g *GossipManager) onRecv(ctx context.Context, data *sentinel.GossipData, l
 		if err := operationsContract[*cltypes.SignedAggregateAndProof](ctx, g, l, data, int(version), "aggregate and proof", g.forkChoice.OnAggregateAndProof); err != nil {
 			return err
 		}
+	case gossip.TopicNameSyncCommitteeContributionAndProof:
+		if err := operationsContract[*cltypes.SignedContributionAndProof](ctx, g, l, data, int(version), "contribution and proof", g.forkChoice.OnSignedContributionAndProof); err != nil {
+			return err
+		}
+	case gossip.TopicNameLightClientFinalityUpdate:
+		if err := operationsContract[*cltypes.LightClientFinalityUpdate](ctx, g, l, data, int(version), "light client finality update", g.onLightClientFinalityUpdate); err != nil {
+			return err
+		}
+	case gossip.TopicNameLightClientOptimisticUpdate:
+		if err := operationsContract[*cltypes.LightClientOptimisticUpdate](ctx, g, l, data, int(version), "light client optimistic update", g.onLightClientOptimisticUpdate); err != nil {
+			return err
+		}
+	default:
+		if gossip.IsTopicBlobSidecar(data.Name) {
+			subnet, err := blobSidecarSubnet(data.Name)
+			if err != nil {
+				return err
+			}
+			if err := operationsContract[*cltypes.BlobSidecar](ctx, g, l, data, int(version), "blob sidecar", func(blobSidecar *cltypes.BlobSidecar, test bool) error {
+				return g.onBlobSidecar(ctx, subnet, blobSidecar, test)
+			}); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
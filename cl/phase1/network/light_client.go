@@ -0,0 +1,83 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ledgerwatch/erigon-lib/gointerfaces/sentinel"
+	"github.com/ledgerwatch/erigon-lib/types/ssz"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/gossip"
+	"github.com/ledgerwatch/erigon/cl/utils"
+)
+
+// PublishLightClientUpdates gossips the latest light client updates computed by forkchoice, if they were not
+// published yet. Peers ignore updates received before a third of their signature slot, so we hold them until then.
+func (g *GossipManager) PublishLightClientUpdates(ctx context.Context) error {
+	if finalityUpdate := g.forkChoice.GetLightClientFinalityUpdate(); finalityUpdate != nil && finalityUpdate != g.publishedFinalityUpdate &&
+		g.isLightClientUpdateTimely(finalityUpdate.SignatureSlot) {
+		if err := g.publishLightClientUpdate(ctx, gossip.TopicNameLightClientFinalityUpdate, finalityUpdate); err != nil {
+			return err
+		}
+		g.publishedFinalityUpdate = finalityUpdate
+	}
+	if optimisticUpdate := g.forkChoice.GetLightClientOptimisticUpdate(); optimisticUpdate != nil && optimisticUpdate != g.publishedOptimisticUpdate &&
+		g.isLightClientUpdateTimely(optimisticUpdate.SignatureSlot) {
+		if err := g.publishLightClientUpdate(ctx, gossip.TopicNameLightClientOptimisticUpdate, optimisticUpdate); err != nil {
+			return err
+		}
+		g.publishedOptimisticUpdate = optimisticUpdate
+	}
+	return nil
+}
+
+func (g *GossipManager) isLightClientUpdateTimely(signatureSlot uint64) bool {
+	slotTime := utils.GetSlotTime(g.genesisConfig.GenesisTime, g.beaconConfig.SecondsPerSlot, signatureSlot)
+	return time.Since(slotTime) >= time.Duration(g.beaconConfig.SecondsPerSlot)*time.Second/3
+}
+
+func (g *GossipManager) publishLightClientUpdate(ctx context.Context, topic string, update ssz.Marshaler) error {
+	encoded, err := update.EncodeSSZ(nil)
+	if err != nil {
+		return err
+	}
+	_, err = g.sentinel.PublishGossip(ctx, &sentinel.GossipData{
+		Data: encoded,
+		Name: topic,
+	})
+	return err
+}
+
+// onLightClientFinalityUpdate only lets through the updates which match the one we computed ourselves.
+func (g *GossipManager) onLightClientFinalityUpdate(update *cltypes.LightClientFinalityUpdate, _ bool) error {
+	local := g.forkChoice.GetLightClientFinalityUpdate()
+	if local == nil {
+		return fmt.Errorf("no local light client finality update")
+	}
+	return checkLightClientUpdateEqual(update, local)
+}
+
+// onLightClientOptimisticUpdate only lets through the updates which match the one we computed ourselves.
+func (g *GossipManager) onLightClientOptimisticUpdate(update *cltypes.LightClientOptimisticUpdate, _ bool) error {
+	local := g.forkChoice.GetLightClientOptimisticUpdate()
+	if local == nil {
+		return fmt.Errorf("no local light client optimistic update")
+	}
+	return checkLightClientUpdateEqual(update, local)
+}
+
+func checkLightClientUpdateEqual(received, local ssz.HashableSSZ) error {
+	receivedRoot, err := received.HashSSZ()
+	if err != nil {
+		return err
+	}
+	localRoot, err := local.HashSSZ()
+	if err != nil {
+		return err
+	}
+	if receivedRoot != localRoot {
+		return fmt.Errorf("light client update does not match the local one")
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
 	"github.com/ledgerwatch/erigon/cl/persistence"
 	"github.com/ledgerwatch/erigon/cl/persistence/beacon_indicies"
+	"github.com/ledgerwatch/erigon/cl/persistence/blob_storage"
 	"github.com/ledgerwatch/erigon/cl/persistence/db_config"
 	state_accessors "github.com/ledgerwatch/erigon/cl/persistence/state"
 	"github.com/ledgerwatch/erigon/cl/phase1/core/state"
@@ -47,6 +48,7 @@ type Cfg struct {
 	sn              *freezeblocks.CaplinSnapshots
 	antiquary       *antiquary.Antiquary
 	syncedData      *synced_data.SyncedDataManager
+	blobStorage     blob_storage.BlobStorage
 
 	hasDownloaded, backfilling bool
 }
@@ -76,6 +78,7 @@ func ClStagesCfg(
 	dbConfig db_config.DatabaseConfiguration,
 	backfilling bool,
 	syncedData *synced_data.SyncedDataManager,
+	blobStorage blob_storage.BlobStorage,
 ) *Cfg {
 	return &Cfg{
 		rpc:             rpc,
@@ -93,6 +96,7 @@ func ClStagesCfg(
 		sn:              sn,
 		backfilling:     backfilling,
 		syncedData:      syncedData,
+		blobStorage:     blobStorage,
 	}
 }
 
@@ -179,6 +183,44 @@ func ConsensusClStages(ctx context.Context,
 
 	rpcSource := persistence.NewBeaconRpcSource(cfg.rpc)
 	gossipSource := persistence.NewGossipSource(ctx, cfg.gossipManager)
+	// The light client data is persisted so that it can be served to peers and survives restarts, these keep track
+	// of what was written last to not write the same data over and over.
+	var (
+		lastLightClientUpdate        *cltypes.LightClientUpdate
+		lastLightClientBootstrapRoot common.Hash
+	)
+	writeLightClientData := func(tx kv.RwTx) error {
+		if finalityUpdate := cfg.forkChoice.GetLightClientFinalityUpdate(); finalityUpdate != nil {
+			if err := beacon_indicies.WriteLightClientFinalityUpdate(tx, finalityUpdate); err != nil {
+				return err
+			}
+		}
+		if optimisticUpdate := cfg.forkChoice.GetLightClientOptimisticUpdate(); optimisticUpdate != nil {
+			if err := beacon_indicies.WriteLightClientOptimisticUpdate(tx, optimisticUpdate); err != nil {
+				return err
+			}
+		}
+		if update := cfg.forkChoice.NewestLightClientUpdate(); update != nil && update != lastLightClientUpdate {
+			if err := beacon_indicies.WriteLightClientUpdate(tx, cfg.beaconCfg.SyncCommitteePeriod(update.AttestedHeader.Beacon.Slot), update); err != nil {
+				return err
+			}
+			lastLightClientUpdate = update
+		}
+		// light clients bootstrap from finalized blocks.
+		finalizedRoot := cfg.forkChoice.FinalizedCheckpoint().BlockRoot()
+		if finalizedRoot == lastLightClientBootstrapRoot {
+			return nil
+		}
+		bootstrap, ok := cfg.forkChoice.GetLightClientBootstrap(finalizedRoot)
+		if !ok {
+			return nil
+		}
+		if err := beacon_indicies.WriteLightClientBootstrap(tx, bootstrap.Header.Beacon.Slot, finalizedRoot, bootstrap); err != nil {
+			return err
+		}
+		lastLightClientBootstrapRoot = finalizedRoot
+		return nil
+	}
 	processBlock := func(tx kv.RwTx, block *cltypes.SignedBeaconBlock, newPayload, fullValidation bool) error {
 		if err := cfg.forkChoice.OnBlock(block, newPayload, fullValidation); err != nil {
 			log.Warn("fail to process block", "reason", err, "slot", block.Block.Slot)
@@ -187,6 +229,9 @@ func ConsensusClStages(ctx context.Context,
 		if err := beacon_indicies.WriteHighestFinalized(tx, cfg.forkChoice.FinalizedSlot()); err != nil {
 			return err
 		}
+		if err := writeLightClientData(tx); err != nil {
+			return err
+		}
 		// Write block to database optimistically if we are very behind.
 		return cfg.beaconDB.WriteBlock(ctx, tx, block, false)
 	}
@@ -592,6 +637,11 @@ func ConsensusClStages(ctx context.Context,
 					return SleepForSlot
 				},
 				ActionFunc: func(ctx context.Context, logger log.Logger, cfg *Cfg, args Args) error {
+					// this runs once per slot after we listened for forks, which is late enough for peers to accept the
+					// light client updates of the new head.
+					if err := cfg.gossipManager.PublishLightClientUpdates(ctx); err != nil {
+						logger.Debug("failed to publish light client updates", "err", err)
+					}
 					tx, err := cfg.indiciesDB.BeginRw(ctx)
 					if err != nil {
 						return err
@@ -610,6 +660,15 @@ func ConsensusClStages(ctx context.Context,
 						if err := beacon_indicies.PruneBlockRoots(ctx, tx, 0, cfg.forkChoice.HighestSeen()-100_000); err != nil {
 							return err
 						}
+						if highestSeen := cfg.forkChoice.HighestSeen(); highestSeen > 100_000 {
+							if err := beacon_indicies.PruneLightClientBootstraps(tx, highestSeen-100_000); err != nil {
+								return err
+							}
+						}
+					}
+					// blob sidecars are only kept for the period peers are allowed to request them.
+					if err := cfg.blobStorage.Prune(ctx, cfg.forkChoice.HighestSeen()); err != nil {
+						return err
 					}
 
 					return tx.Commit()
@@ -30,6 +30,8 @@ type OperationsPool struct {
 	ProposerSlashingsPool     *OperationPool[libcommon.Bytes96, *cltypes.ProposerSlashing]
 	BLSToExecutionChangesPool *OperationPool[libcommon.Bytes96, *cltypes.SignedBLSToExecutionChange]
 	VoluntaryExistsPool       *OperationPool[uint64, *cltypes.SignedVoluntaryExit]
+	ContributionsPool         *OperationPool[libcommon.Bytes96, *cltypes.SignedContributionAndProof]
+	SyncCommitteeMessagesPool *OperationPool[libcommon.Bytes96, *cltypes.SyncCommitteeMessage]
 }
 
 func NewOperationsPool(beaconCfg *clparams.BeaconChainConfig) OperationsPool {
@@ -39,6 +41,8 @@ func NewOperationsPool(beaconCfg *clparams.BeaconChainConfig) OperationsPool {
 		ProposerSlashingsPool:     NewOperationPool[libcommon.Bytes96, *cltypes.ProposerSlashing](int(beaconCfg.MaxAttestations), "proposerSlashingsPool"),
 		BLSToExecutionChangesPool: NewOperationPool[libcommon.Bytes96, *cltypes.SignedBLSToExecutionChange](int(beaconCfg.MaxBlsToExecutionChanges), "blsExecutionChangesPool"),
 		VoluntaryExistsPool:       NewOperationPool[uint64, *cltypes.SignedVoluntaryExit](int(beaconCfg.MaxBlsToExecutionChanges), "voluntaryExitsPool"),
+		ContributionsPool:         NewOperationPool[libcommon.Bytes96, *cltypes.SignedContributionAndProof](int(beaconCfg.SyncCommitteeSubnetCount*beaconCfg.TargetAggregatorsPerSyncSubcommittee), "contributionsPool"),
+		SyncCommitteeMessagesPool: NewOperationPool[libcommon.Bytes96, *cltypes.SyncCommitteeMessage](int(beaconCfg.SyncCommitteeSize), "syncCommitteeMessagesPool"),
 	}
 }
 
@@ -72,6 +72,21 @@ var BlsToExecutionChangeSsz = GossipTopic{
 	CodecStr: SSZSnappyCodec,
 }
 
+var SyncCommitteeContributionAndProofSsz = GossipTopic{
+	Name:     gossip.TopicNameSyncCommitteeContributionAndProof,
+	CodecStr: SSZSnappyCodec,
+}
+
+var LightClientFinalityUpdateSsz = GossipTopic{
+	Name:     gossip.TopicNameLightClientFinalityUpdate,
+	CodecStr: SSZSnappyCodec,
+}
+
+var LightClientOptimisticUpdateSsz = GossipTopic{
+	Name:     gossip.TopicNameLightClientOptimisticUpdate,
+	CodecStr: SSZSnappyCodec,
+}
+
 type GossipManager struct {
 	ch            chan *GossipMessage
 	subscriptions map[string]*GossipSubscription
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/persistence/beacon_indicies"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication/ssz_snappy"
+	"github.com/libp2p/go-libp2p/core/network"
+)
+
+const blobIdentifierSSZSize = 40
+
+func (c *ConsensusHandlers) blobsSidecarsByRangeHandler(s network.Stream) error {
+	peerId := s.Conn().RemotePeer().String()
+	if err := c.checkRateLimit(peerId, "blobSidecarsByRange", rateLimits.blobSidecarsByRangeLimit); err != nil {
+		ssz_snappy.EncodeAndWrite(s, &emptyString{}, RateLimitedPrefix)
+		return err
+	}
+
+	req := &cltypes.BlobsByRangeRequest{}
+	if err := ssz_snappy.DecodeAndReadNoForkDigest(s, req, clparams.DenebVersion); err != nil {
+		return err
+	}
+	// Limit the number of blocks so that we never send more than MAX_REQUEST_BLOB_SIDECARS sidecars.
+	maxBlocks := c.beaconConfig.MaxRequestBlobSidecars / c.beaconConfig.MaxBlobsPerBlock
+	if req.Count > maxBlocks {
+		req.Count = maxBlocks
+	}
+
+	tx, err := c.indiciesDB.BeginRo(c.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	beaconBlockRoots, slots, err := beacon_indicies.ReadBeaconBlockRootsInSlotRange(c.ctx, tx, req.StartSlot, req.Count)
+	if err != nil {
+		return err
+	}
+
+	written := 0
+	for i, slot := range slots {
+		if slot >= req.StartSlot+req.Count {
+			break
+		}
+		blobSidecars, _, err := c.blobStorage.ReadBlobSidecars(c.ctx, slot, beaconBlockRoots[i])
+		if err != nil {
+			return err
+		}
+		for _, blobSidecar := range blobSidecars {
+			if err := c.writeVersionedObject(s, blobSidecar, clparams.DenebVersion); err != nil {
+				return err
+			}
+			written++
+		}
+	}
+	if written == 0 {
+		return ssz_snappy.EncodeAndWrite(s, &emptyString{}, ResourceUnavaiablePrefix)
+	}
+	return nil
+}
+
+func (c *ConsensusHandlers) blobsSidecarsByIdsHandler(s network.Stream) error {
+	peerId := s.Conn().RemotePeer().String()
+	if err := c.checkRateLimit(peerId, "blobSidecarsByRoot", rateLimits.blobSidecarsByRootLimit); err != nil {
+		ssz_snappy.EncodeAndWrite(s, &emptyString{}, RateLimitedPrefix)
+		return err
+	}
+
+	req := solid.NewStaticListSSZ[*cltypes.BlobIdentifier](int(c.beaconConfig.MaxRequestBlobSidecars), blobIdentifierSSZSize)
+	if err := ssz_snappy.DecodeAndReadNoForkDigest(s, req, clparams.DenebVersion); err != nil {
+		return err
+	}
+
+	tx, err := c.indiciesDB.BeginRo(c.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	written := 0
+	for i := 0; i < req.Len(); i++ {
+		id := req.Get(i)
+		slot, err := beacon_indicies.ReadBlockSlotByBlockRoot(tx, id.BlockRoot)
+		if err != nil {
+			return err
+		}
+		if slot == nil {
+			continue
+		}
+		blobSidecars, _, err := c.blobStorage.ReadBlobSidecars(c.ctx, *slot, id.BlockRoot)
+		if err != nil {
+			return err
+		}
+		for _, blobSidecar := range blobSidecars {
+			if blobSidecar.Index != id.Index {
+				continue
+			}
+			if err := c.writeVersionedObject(s, blobSidecar, clparams.DenebVersion); err != nil {
+				return err
+			}
+			written++
+		}
+	}
+	if written == 0 {
+		return ssz_snappy.EncodeAndWrite(s, &emptyString{}, ResourceUnavaiablePrefix)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/golang/snappy"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/fork"
+	"github.com/ledgerwatch/erigon/cl/persistence/beacon_indicies"
+	"github.com/ledgerwatch/erigon/cl/persistence/blob_storage"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication/ssz_snappy"
+	"github.com/ledgerwatch/erigon/cl/sentinel/peers"
+	"github.com/ledgerwatch/erigon/cl/utils"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobsByIdentifiersHandler(t *testing.T) {
+	ctx := context.Background()
+
+	listenAddrHost := "/ip4/127.0.0.1/tcp/6008"
+	host, err := libp2p.New(libp2p.ListenAddrStrings(listenAddrHost))
+	require.NoError(t, err)
+
+	listenAddrHost1 := "/ip4/127.0.0.1/tcp/6009"
+	host1, err := libp2p.New(libp2p.ListenAddrStrings(listenAddrHost1))
+	require.NoError(t, err)
+
+	err = host.Connect(ctx, peer.AddrInfo{
+		ID:    host1.ID(),
+		Addrs: host1.Addrs(),
+	})
+	require.NoError(t, err)
+
+	peersPool := peers.NewPool()
+	beaconDB, indiciesDB := setupStore(t)
+	defer indiciesDB.Close()
+	genesisCfg, _, beaconCfg := clparams.GetConfigsByNetwork(1)
+	blobStorage := blob_storage.NewBlobStore(memdb.NewTestDB(t), afero.NewMemMapFs(), math.MaxUint64, beaconCfg)
+
+	header := &cltypes.SignedBeaconBlockHeader{Header: &cltypes.BeaconBlockHeader{Slot: 100}}
+	blockRoot, err := header.Header.HashSSZ()
+	require.NoError(t, err)
+	tx, err := indiciesDB.BeginRw(ctx)
+	require.NoError(t, err)
+	require.NoError(t, beacon_indicies.WriteBeaconBlockHeaderAndIndicies(ctx, tx, header, true))
+	require.NoError(t, tx.Commit())
+
+	expSidecars := []*cltypes.BlobSidecar{}
+	for i := uint64(0); i < 2; i++ {
+		expSidecars = append(expSidecars, cltypes.NewBlobSidecar(i, &cltypes.Blob{byte(i + 1)}, cltypes.KZGCommitment{}, cltypes.KZGProof{}, header,
+			solid.NewHashVector(cltypes.KzgCommitmentInclusionProofDepth)))
+	}
+	require.NoError(t, blobStorage.WriteBlobSidecars(ctx, blockRoot, expSidecars))
+
+	c := NewConsensusHandlers(
+		ctx,
+		beaconDB,
+		indiciesDB,
+		blobStorage,
+		host,
+		peersPool,
+		beaconCfg,
+		genesisCfg,
+		&cltypes.Metadata{}, true,
+	)
+	c.Start()
+
+	req := solid.NewStaticListSSZ[*cltypes.BlobIdentifier](int(beaconCfg.MaxRequestBlobSidecars), blobIdentifierSSZSize)
+	req.Append(&cltypes.BlobIdentifier{BlockRoot: blockRoot, Index: 1})
+	req.Append(&cltypes.BlobIdentifier{BlockRoot: libcommon.Hash{1}, Index: 0})
+	var reqBuf bytes.Buffer
+	require.NoError(t, ssz_snappy.EncodeAndWrite(&reqBuf, req))
+
+	stream, err := host1.NewStream(ctx, host.ID(), protocol.ID(communication.BlobSidecarByRootProtocolV1))
+	require.NoError(t, err)
+	_, err = stream.Write(reqBuf.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseWrite())
+
+	firstByte := make([]byte, 1)
+	_, err = stream.Read(firstByte)
+	require.NoError(t, err)
+	require.Equal(t, byte(SuccessfulResponsePrefix), firstByte[0])
+
+	forkDigest := make([]byte, 4)
+	_, err = stream.Read(forkDigest)
+	require.NoError(t, err)
+	version, err := fork.ForkDigestVersion(utils.Uint32ToBytes4(binary.BigEndian.Uint32(forkDigest)), beaconCfg, genesisCfg.GenesisValidatorRoot)
+	require.NoError(t, err)
+	require.Equal(t, clparams.DenebVersion, version)
+
+	encodedLn, _, err := ssz_snappy.ReadUvarint(stream)
+	require.NoError(t, err)
+	raw := make([]byte, encodedLn)
+	sr := snappy.NewReader(stream)
+	bytesRead := 0
+	for bytesRead < int(encodedLn) {
+		n, err := sr.Read(raw[bytesRead:])
+		require.NoError(t, err)
+		bytesRead += n
+	}
+
+	sidecar := &cltypes.BlobSidecar{}
+	require.NoError(t, sidecar.DecodeSSZ(raw, int(version)))
+	require.Equal(t, expSidecars[1].Index, sidecar.Index)
+	require.Equal(t, expSidecars[1].Blob, sidecar.Blob)
+}
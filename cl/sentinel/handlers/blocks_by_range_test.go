@@ -59,6 +59,7 @@ func TestBlocksByRootHandler(t *testing.T) {
 		ctx,
 		beaconDB,
 		indiciesDB,
+		nil,
 		host,
 		peersPool,
 		beaconCfg,
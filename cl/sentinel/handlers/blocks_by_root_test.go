@@ -62,6 +62,7 @@ func TestBlocksByRangeHandler(t *testing.T) {
 		ctx,
 		beaconDB,
 		indiciesDB,
+		nil,
 		host,
 		peersPool,
 		beaconCfg,
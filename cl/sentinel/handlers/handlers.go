@@ -30,6 +30,7 @@ import (
 	"github.com/ledgerwatch/erigon/cl/clparams"
 	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/cl/persistence"
+	"github.com/ledgerwatch/erigon/cl/persistence/blob_storage"
 	"github.com/ledgerwatch/log/v3"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
@@ -44,6 +45,14 @@ type RateLimits struct {
 	statusLimit              int
 	beaconBlocksByRangeLimit int
 	beaconBlocksByRootLimit  int
+
+	lightClientBootstrapLimit        int
+	lightClientUpdatesByRangeLimit   int
+	lightClientFinalityUpdateLimit   int
+	lightClientOptimisticUpdateLimit int
+
+	blobSidecarsByRangeLimit int
+	blobSidecarsByRootLimit  int
 }
 
 const punishmentPeriod = time.Minute
@@ -58,6 +67,14 @@ var rateLimits = RateLimits{
 	statusLimit:              defaultRateLimit,
 	beaconBlocksByRangeLimit: defaultBlockHandlerRateLimit,
 	beaconBlocksByRootLimit:  defaultBlockHandlerRateLimit,
+
+	lightClientBootstrapLimit:        defaultBlockHandlerRateLimit,
+	lightClientUpdatesByRangeLimit:   defaultBlockHandlerRateLimit,
+	lightClientFinalityUpdateLimit:   defaultBlockHandlerRateLimit,
+	lightClientOptimisticUpdateLimit: defaultBlockHandlerRateLimit,
+
+	blobSidecarsByRangeLimit: defaultBlockHandlerRateLimit,
+	blobSidecarsByRootLimit:  defaultBlockHandlerRateLimit,
 }
 
 type ConsensusHandlers struct {
@@ -69,6 +86,7 @@ type ConsensusHandlers struct {
 	ctx                context.Context
 	beaconDB           persistence.RawBeaconBlockChain
 	indiciesDB         kv.RoDB
+	blobStorage        blob_storage.BlobStorage
 	peerRateLimits     sync.Map
 	punishmentEndTimes sync.Map
 
@@ -81,13 +99,14 @@ const (
 	ResourceUnavaiablePrefix = 0x02
 )
 
-func NewConsensusHandlers(ctx context.Context, db persistence.RawBeaconBlockChain, indiciesDB kv.RoDB, host host.Host,
+func NewConsensusHandlers(ctx context.Context, db persistence.RawBeaconBlockChain, indiciesDB kv.RoDB, blobStorage blob_storage.BlobStorage, host host.Host,
 	peers *peers.Pool, beaconConfig *clparams.BeaconChainConfig, genesisConfig *clparams.GenesisConfig, metadata *cltypes.Metadata, enabledBlocks bool) *ConsensusHandlers {
 	c := &ConsensusHandlers{
 		host:               host,
 		metadata:           metadata,
 		beaconDB:           db,
 		indiciesDB:         indiciesDB,
+		blobStorage:        blobStorage,
 		genesisConfig:      genesisConfig,
 		beaconConfig:       beaconConfig,
 		ctx:                ctx,
@@ -107,6 +126,12 @@ func NewConsensusHandlers(ctx context.Context, db persistence.RawBeaconBlockChai
 	if c.enableBlocks {
 		hm[communication.BeaconBlocksByRangeProtocolV2] = c.beaconBlocksByRangeHandler
 		hm[communication.BeaconBlocksByRootProtocolV2] = c.beaconBlocksByRootHandler
+		hm[communication.LightClientBootstrapProtocolV1] = c.lightClientBootstrapHandler
+		hm[communication.LightClientUpdatesByRangeProtocolV1] = c.lightClientUpdatesByRangeHandler
+		hm[communication.LightClientFinalityUpdateProtocolV1] = c.lightClientFinalityUpdateHandler
+		hm[communication.LightClientOptimisticUpdateProtocolV1] = c.lightClientOptimisticUpdateHandler
+		hm[communication.BlobSidecarByRangeProtocolV1] = c.blobsSidecarsByRangeHandler
+		hm[communication.BlobSidecarByRootProtocolV1] = c.blobsSidecarsByIdsHandler
 	}
 
 	c.handlers = map[protocol.ID]network.StreamHandler{}
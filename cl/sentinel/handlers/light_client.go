@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"github.com/ledgerwatch/erigon-lib/types/ssz"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/cltypes/solid"
+	"github.com/ledgerwatch/erigon/cl/fork"
+	"github.com/ledgerwatch/erigon/cl/persistence/beacon_indicies"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication/ssz_snappy"
+	"github.com/ledgerwatch/erigon/cl/utils"
+	"github.com/libp2p/go-libp2p/core/network"
+)
+
+func (c *ConsensusHandlers) lightClientBootstrapHandler(s network.Stream) error {
+	peerId := s.Conn().RemotePeer().String()
+	if err := c.checkRateLimit(peerId, "lightClientBootstrap", rateLimits.lightClientBootstrapLimit); err != nil {
+		ssz_snappy.EncodeAndWrite(s, &emptyString{}, RateLimitedPrefix)
+		return err
+	}
+
+	var req solid.HashVectorSSZ = solid.NewHashVector(1)
+	if err := ssz_snappy.DecodeAndReadNoForkDigest(s, req, clparams.Phase0Version); err != nil {
+		return err
+	}
+
+	tx, err := c.indiciesDB.BeginRo(c.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	bootstrap, err := beacon_indicies.ReadLightClientBootstrap(tx, req.Get(0))
+	if err != nil {
+		return err
+	}
+	if bootstrap == nil {
+		return ssz_snappy.EncodeAndWrite(s, &emptyString{}, ResourceUnavaiablePrefix)
+	}
+	return c.writeVersionedObject(s, bootstrap, bootstrap.Version())
+}
+
+func (c *ConsensusHandlers) lightClientUpdatesByRangeHandler(s network.Stream) error {
+	peerId := s.Conn().RemotePeer().String()
+	if err := c.checkRateLimit(peerId, "lightClientUpdatesByRange", rateLimits.lightClientUpdatesByRangeLimit); err != nil {
+		ssz_snappy.EncodeAndWrite(s, &emptyString{}, RateLimitedPrefix)
+		return err
+	}
+
+	req := &cltypes.LightClientUpdatesByRangeRequest{}
+	if err := ssz_snappy.DecodeAndReadNoForkDigest(s, req, clparams.Phase0Version); err != nil {
+		return err
+	}
+	// Limit the number of updates to the count specified in the request.
+	if req.Count > communication.MaximumRequestClientUpdates {
+		req.Count = communication.MaximumRequestClientUpdates
+	}
+
+	tx, err := c.indiciesDB.BeginRo(c.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	written := 0
+	for period := req.StartPeriod; period < req.StartPeriod+req.Count; period++ {
+		update, err := beacon_indicies.ReadLightClientUpdate(tx, period)
+		if err != nil {
+			return err
+		}
+		// the updates must be consecutive, so we stop at the first missing period.
+		if update == nil {
+			break
+		}
+		if err := c.writeVersionedObject(s, update, update.Version()); err != nil {
+			return err
+		}
+		written++
+	}
+	if written == 0 {
+		return ssz_snappy.EncodeAndWrite(s, &emptyString{}, ResourceUnavaiablePrefix)
+	}
+	return nil
+}
+
+func (c *ConsensusHandlers) lightClientFinalityUpdateHandler(s network.Stream) error {
+	peerId := s.Conn().RemotePeer().String()
+	if err := c.checkRateLimit(peerId, "lightClientFinalityUpdate", rateLimits.lightClientFinalityUpdateLimit); err != nil {
+		ssz_snappy.EncodeAndWrite(s, &emptyString{}, RateLimitedPrefix)
+		return err
+	}
+
+	tx, err := c.indiciesDB.BeginRo(c.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	update, err := beacon_indicies.ReadLightClientFinalityUpdate(tx)
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		return ssz_snappy.EncodeAndWrite(s, &emptyString{}, ResourceUnavaiablePrefix)
+	}
+	return c.writeVersionedObject(s, update, update.Version())
+}
+
+func (c *ConsensusHandlers) lightClientOptimisticUpdateHandler(s network.Stream) error {
+	peerId := s.Conn().RemotePeer().String()
+	if err := c.checkRateLimit(peerId, "lightClientOptimisticUpdate", rateLimits.lightClientOptimisticUpdateLimit); err != nil {
+		ssz_snappy.EncodeAndWrite(s, &emptyString{}, RateLimitedPrefix)
+		return err
+	}
+
+	tx, err := c.indiciesDB.BeginRo(c.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	update, err := beacon_indicies.ReadLightClientOptimisticUpdate(tx)
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		return ssz_snappy.EncodeAndWrite(s, &emptyString{}, ResourceUnavaiablePrefix)
+	}
+	return c.writeVersionedObject(s, update, update.Version())
+}
+
+// writeVersionedObject writes a successful response chunk, its context is the fork digest of the given version.
+func (c *ConsensusHandlers) writeVersionedObject(s network.Stream, obj ssz.Marshaler, version clparams.StateVersion) error {
+	forkDigest, err := fork.ComputeForkDigestForVersion(
+		utils.Uint32ToBytes4(c.beaconConfig.GetForkVersionByVersion(version)),
+		c.genesisConfig.GenesisValidatorRoot,
+	)
+	if err != nil {
+		return err
+	}
+	if _, err := s.Write([]byte{SuccessfulResponsePrefix}); err != nil {
+		return err
+	}
+	if _, err := s.Write(forkDigest[:]); err != nil {
+		return err
+	}
+	return ssz_snappy.EncodeAndWrite(s, obj)
+}
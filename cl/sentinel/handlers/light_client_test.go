@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/ledgerwatch/erigon/cl/clparams"
+	"github.com/ledgerwatch/erigon/cl/cltypes"
+	"github.com/ledgerwatch/erigon/cl/fork"
+	"github.com/ledgerwatch/erigon/cl/persistence/beacon_indicies"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication"
+	"github.com/ledgerwatch/erigon/cl/sentinel/communication/ssz_snappy"
+	"github.com/ledgerwatch/erigon/cl/sentinel/peers"
+	"github.com/ledgerwatch/erigon/cl/utils"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightClientFinalityUpdateHandler(t *testing.T) {
+	ctx := context.Background()
+
+	listenAddrHost := "/ip4/127.0.0.1/tcp/6004"
+	host, err := libp2p.New(libp2p.ListenAddrStrings(listenAddrHost))
+	require.NoError(t, err)
+
+	listenAddrHost1 := "/ip4/127.0.0.1/tcp/6005"
+	host1, err := libp2p.New(libp2p.ListenAddrStrings(listenAddrHost1))
+	require.NoError(t, err)
+
+	err = host.Connect(ctx, peer.AddrInfo{
+		ID:    host1.ID(),
+		Addrs: host1.Addrs(),
+	})
+	require.NoError(t, err)
+
+	peersPool := peers.NewPool()
+	beaconDB, indiciesDB := setupStore(t)
+	defer indiciesDB.Close()
+
+	expUpdate := cltypes.NewLightClientFinalityUpdate(clparams.CapellaVersion)
+	expUpdate.AttestedHeader.Beacon.Slot = 1234
+	expUpdate.SignatureSlot = 1235
+	tx, err := indiciesDB.BeginRw(ctx)
+	require.NoError(t, err)
+	require.NoError(t, beacon_indicies.WriteLightClientFinalityUpdate(tx, expUpdate))
+	require.NoError(t, tx.Commit())
+
+	genesisCfg, _, beaconCfg := clparams.GetConfigsByNetwork(1)
+	c := NewConsensusHandlers(
+		ctx,
+		beaconDB,
+		indiciesDB,
+		nil,
+		host,
+		peersPool,
+		beaconCfg,
+		genesisCfg,
+		&cltypes.Metadata{}, true,
+	)
+	c.Start()
+
+	stream, err := host1.NewStream(ctx, host.ID(), protocol.ID(communication.LightClientFinalityUpdateProtocolV1))
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseWrite())
+
+	firstByte := make([]byte, 1)
+	_, err = stream.Read(firstByte)
+	require.NoError(t, err)
+	require.Equal(t, byte(SuccessfulResponsePrefix), firstByte[0])
+
+	forkDigest := make([]byte, 4)
+	_, err = stream.Read(forkDigest)
+	require.NoError(t, err)
+	version, err := fork.ForkDigestVersion(utils.Uint32ToBytes4(binary.BigEndian.Uint32(forkDigest)), beaconCfg, genesisCfg.GenesisValidatorRoot)
+	require.NoError(t, err)
+	require.Equal(t, clparams.CapellaVersion, version)
+
+	encodedLn, _, err := ssz_snappy.ReadUvarint(stream)
+	require.NoError(t, err)
+	raw := make([]byte, encodedLn)
+	sr := snappy.NewReader(stream)
+	bytesRead := 0
+	for bytesRead < int(encodedLn) {
+		n, err := sr.Read(raw[bytesRead:])
+		require.NoError(t, err)
+		bytesRead += n
+	}
+
+	update := &cltypes.LightClientFinalityUpdate{}
+	require.NoError(t, update.DecodeSSZ(raw, int(version)))
+	require.Equal(t, expUpdate.AttestedHeader.Beacon.Slot, update.AttestedHeader.Beacon.Slot)
+	require.Equal(t, expUpdate.SignatureSlot, update.SignatureSlot)
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/ledgerwatch/erigon/cl/cltypes"
 	"github.com/ledgerwatch/erigon/cl/persistence"
+	"github.com/ledgerwatch/erigon/cl/persistence/blob_storage"
 	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/p2p/discover"
 	"github.com/ledgerwatch/erigon/p2p/enode"
@@ -75,8 +76,9 @@ type Sentinel struct {
 	metadataV2 *cltypes.Metadata
 	handshaker *handshake.HandShaker
 
-	db         persistence.RawBeaconBlockChain
-	indiciesDB kv.RoDB
+	db          persistence.RawBeaconBlockChain
+	indiciesDB  kv.RoDB
+	blobStorage blob_storage.BlobStorage
 
 	discoverConfig       discover.Config
 	pubsub               *pubsub.PubSub
@@ -168,7 +170,7 @@ func (s *Sentinel) createListener() (*discover.UDPv5, error) {
 	}
 
 	// Start stream handlers
-	handlers.NewConsensusHandlers(s.ctx, s.db, s.indiciesDB, s.host, s.peers, s.cfg.BeaconConfig, s.cfg.GenesisConfig, s.metadataV2, s.cfg.EnableBlocks).Start()
+	handlers.NewConsensusHandlers(s.ctx, s.db, s.indiciesDB, s.blobStorage, s.host, s.peers, s.cfg.BeaconConfig, s.cfg.GenesisConfig, s.metadataV2, s.cfg.EnableBlocks).Start()
 
 	net, err := discover.ListenV5(s.ctx, "any", conn, localNode, discCfg)
 	if err != nil {
@@ -183,15 +185,17 @@ func New(
 	cfg *SentinelConfig,
 	db persistence.RawBeaconBlockChain,
 	indiciesDB kv.RoDB,
+	blobStorage blob_storage.BlobStorage,
 	logger log.Logger,
 ) (*Sentinel, error) {
 	s := &Sentinel{
-		ctx:        ctx,
-		cfg:        cfg,
-		db:         db,
-		indiciesDB: indiciesDB,
-		metrics:    true,
-		logger:     logger,
+		ctx:         ctx,
+		cfg:         cfg,
+		db:          db,
+		indiciesDB:  indiciesDB,
+		blobStorage: blobStorage,
+		metrics:     true,
+		logger:      logger,
 	}
 
 	// Setup discovery
@@ -54,7 +54,7 @@ func NewSentinelServer(ctx context.Context, sentinel *sentinel.Sentinel, logger
 func extractBlobSideCarIndex(topic string) int {
 	// compute the index prefixless
 	startIndex := strings.Index(topic, gossip.TopicNamePrefixBlobSidecar) + len(gossip.TopicNamePrefixBlobSidecar)
-	endIndex := strings.Index(topic[:startIndex], "/")
+	endIndex := startIndex + strings.Index(topic[startIndex:], "/")
 	blobIndex, err := strconv.Atoi(topic[startIndex:endIndex])
 	if err != nil {
 		panic(fmt.Sprintf("should not be substribed to %s", topic))
@@ -97,10 +97,30 @@ func (s *SentinelServer) PublishGossip(_ context.Context, msg *sentinelrpc.Gossi
 		subscription = manager.GetMatchingSubscription(msg.Name)
 	case gossip.TopicNameAttesterSlashing:
 		subscription = manager.GetMatchingSubscription(msg.Name)
+	case gossip.TopicNameSyncCommitteeContributionAndProof:
+		subscription = manager.GetMatchingSubscription(msg.Name)
+	case gossip.TopicNameLightClientFinalityUpdate:
+		subscription = manager.GetMatchingSubscription(msg.Name)
+	case gossip.TopicNameLightClientOptimisticUpdate:
+		subscription = manager.GetMatchingSubscription(msg.Name)
 	default:
 		switch {
 		case gossip.IsTopicBlobSidecar(msg.Name):
 			subscription = manager.GetMatchingSubscription(msg.Name)
+		case gossip.IsTopicBeaconAttestation(msg.Name), gossip.IsTopicSyncCommittee(msg.Name):
+			// subnets are matched by their full name (beacon_attestation_1 is a prefix of beacon_attestation_10) and we only
+			// join them once we have something to publish.
+			subscription = manager.GetMatchingSubscription("/" + msg.Name + "/")
+			if subscription == nil {
+				var err error
+				subscription, err = s.sentinel.SubscribeGossip(sentinel.GossipTopic{
+					Name:     msg.Name,
+					CodecStr: sentinel.SSZSnappyCodec,
+				})
+				if err != nil {
+					return nil, err
+				}
+			}
 		default:
 			return &sentinelrpc.EmptyMessage{}, nil
 		}
@@ -324,6 +344,12 @@ func (s *SentinelServer) handleGossipPacket(pkt *sentinel.GossipMessage) error {
 		s.gossipNotifier.notify(gossip.TopicNameAttesterSlashing, data, string(textPid))
 	} else if strings.Contains(topic, string(gossip.TopicNameBlsToExecutionChange)) {
 		s.gossipNotifier.notify(gossip.TopicNameBlsToExecutionChange, data, string(textPid))
+	} else if strings.Contains(topic, string(gossip.TopicNameSyncCommitteeContributionAndProof)) {
+		s.gossipNotifier.notify(gossip.TopicNameSyncCommitteeContributionAndProof, data, string(textPid))
+	} else if strings.Contains(topic, string(gossip.TopicNameLightClientFinalityUpdate)) {
+		s.gossipNotifier.notify(gossip.TopicNameLightClientFinalityUpdate, data, string(textPid))
+	} else if strings.Contains(topic, string(gossip.TopicNameLightClientOptimisticUpdate)) {
+		s.gossipNotifier.notify(gossip.TopicNameLightClientOptimisticUpdate, data, string(textPid))
 	} else if gossip.IsTopicBlobSidecar(topic) {
 		// extract the index
 		s.gossipNotifier.notifyBlob(data, string(textPid), extractBlobSideCarIndex(topic))
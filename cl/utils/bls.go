@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"errors"
+
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// AggregateSignatures merges compressed BLS signatures into a single compressed signature.
+func AggregateSignatures(signatures [][]byte) ([96]byte, error) {
+	var out [96]byte
+	if len(signatures) == 0 {
+		return out, errors.New("no signatures to aggregate")
+	}
+	agg := new(blst.P2Aggregate)
+	if !agg.AggregateCompressed(signatures, true) {
+		return out, ErrInvalidSignature
+	}
+	copy(out[:], agg.ToAffine().Compress())
+	return out, nil
+}
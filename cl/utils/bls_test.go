@@ -0,0 +1,41 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/Giulio2002/bls"
+	"github.com/ledgerwatch/erigon/cl/utils"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+func TestAggregateSignatures(t *testing.T) {
+	dst := []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	msg := []byte("caplin")
+	var signatures, publicKeys [][]byte
+	for i := byte(1); i <= 3; i++ {
+		ikm := make([]byte, 32)
+		ikm[0] = i
+		sk := blst.KeyGen(ikm)
+		signatures = append(signatures, new(blst.P2Affine).Sign(sk, msg, dst).Compress())
+		publicKeys = append(publicKeys, new(blst.P1Affine).From(sk).Compress())
+	}
+
+	aggregate, err := utils.AggregateSignatures(signatures)
+	require.NoError(t, err)
+	valid, err := bls.VerifyAggregate(aggregate[:], msg, publicKeys)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// the aggregate of a subset does not verify against all the keys
+	partial, err := utils.AggregateSignatures(signatures[:2])
+	require.NoError(t, err)
+	valid, err = bls.VerifyAggregate(partial[:], msg, publicKeys)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	_, err = utils.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = utils.AggregateSignatures([][]byte{{1, 2, 3}})
+	require.ErrorIs(t, err, utils.ErrInvalidSignature)
+}
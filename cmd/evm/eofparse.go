@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ledgerwatch/erigon/common"
+	"github.com/ledgerwatch/erigon/core/vm"
+)
+
+var InitcodeFlag = cli.BoolFlag{
+	Name:  "initcode",
+	Usage: "validate the containers as initcode instead of deployed code",
+}
+
+var eofParseCommand = cli.Command{
+	Action:    eofParseCmd,
+	Name:      "eofparse",
+	Usage:     "parses and validates EOF containers, one hex encoded container per line",
+	ArgsUsage: "<file>",
+	Flags: []cli.Flag{
+		&InitcodeFlag,
+	},
+}
+
+func eofParseCmd(ctx *cli.Context) error {
+	var in io.Reader
+	switch {
+	case len(ctx.Args().First()) > 0:
+		f, err := os.Open(ctx.Args().First())
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	case ctx.IsSet(InputFlag.Name):
+		in = strings.NewReader(ctx.String(InputFlag.Name))
+	default:
+		return errors.New("missing filename or --input value")
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 1024*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		c, err := vm.ParseAndValidateContainer(common.FromHex(line), ctx.Bool(InitcodeFlag.Name))
+		if err != nil {
+			fmt.Printf("err: %v\n", err)
+			continue
+		}
+		sections := make([]string, c.NumCodeSections())
+		for i := range sections {
+			sections[i] = fmt.Sprintf("%x", c.CodeSection(i))
+		}
+		fmt.Printf("OK %s\n", strings.Join(sections, ","))
+	}
+	return scanner.Err()
+}
@@ -270,7 +270,10 @@ func Main(ctx *cli.Context) error {
 	}
 
 	// manufacture block from above inputs
-	header := NewHeader(prestate.Env)
+	header, err := NewHeader(prestate.Env, chainConfig.IsPrague(prestate.Env.Timestamp))
+	if err != nil {
+		return NewError(ErrorMissingBlockhash, err)
+	}
 
 	var ommerHeaders = make([]*types.Header, len(prestate.Env.Ommers))
 	header.Number.Add(header.Number, big.NewInt(int64(len(prestate.Env.Ommers))))
@@ -587,7 +590,9 @@ func dispatchOutput(ctx *cli.Context, baseDir string, result *core.EphemeralExec
 	return nil
 }
 
-func NewHeader(env stEnv) *types.Header {
+// NewHeader returns the header of the block described by env. Its parent hash, which EIP-2935 stores in the history
+// storage contract, is taken from the block hashes of env, and required if storeParentHash is set.
+func NewHeader(env stEnv, storeParentHash bool) (*types.Header, error) {
 	var header types.Header
 	header.Coinbase = env.Coinbase
 	header.Difficulty = env.Difficulty
@@ -596,11 +601,18 @@ func NewHeader(env stEnv) *types.Header {
 	header.Time = env.Timestamp
 	header.BaseFee = env.BaseFee
 	header.MixDigest = env.MixDigest
+	if env.Number > 0 {
+		parentHash, ok := env.BlockHashes[math.HexOrDecimal64(env.Number-1)]
+		if !ok && storeParentHash {
+			return nil, fmt.Errorf("blockhash of the parent block %d not provided, needed by EIP-2935", env.Number-1)
+		}
+		header.ParentHash = parentHash
+	}
 
 	header.UncleHash = env.UncleHash
 	header.WithdrawalsHash = env.WithdrawalsHash
 
-	return &header
+	return &header, nil
 }
 
 func CalculateStateRoot(tx kv.RwTx) (*libcommon.Hash, error) {
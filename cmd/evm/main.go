@@ -183,6 +183,7 @@ func init() {
 	app.Commands = []*cli.Command{
 		&compileCommand,
 		&disasmCommand,
+		&eofParseCommand,
 		&runCommand,
 		&stateTestCommand,
 		&stateTransitionCommand,
@@ -82,7 +82,8 @@ var rootCmd = &cobra.Command{
 }
 
 var (
-	stateCacheStr string
+	stateCacheStr         string
+	tracerJsHeapBudgetStr string
 )
 
 func RootCommand() (*cobra.Command, *httpcfg.HttpCfg) {
@@ -96,6 +97,7 @@ func RootCommand() (*cobra.Command, *httpcfg.HttpCfg) {
 	rootCmd.PersistentFlags().Uint64Var(&cfg.MaxTraces, "trace.maxtraces", 200, "Sets a limit on traces that can be returned in trace_filter")
 
 	rootCmd.PersistentFlags().StringVar(&cfg.RpcAllowListFilePath, utils.RpcAccessListFlag.Name, "", "Specify granular (method-by-method) API allowlist")
+	rootCmd.PersistentFlags().StringVar(&cfg.RpcRateLimitFilePath, utils.RpcRateLimitFlag.Name, "", utils.RpcRateLimitFlag.Usage)
 	rootCmd.PersistentFlags().UintVar(&cfg.RpcBatchConcurrency, utils.RpcBatchConcurrencyFlag.Name, 2, utils.RpcBatchConcurrencyFlag.Usage)
 	rootCmd.PersistentFlags().BoolVar(&cfg.RpcStreamingDisable, utils.RpcStreamingDisableFlag.Name, false, utils.RpcStreamingDisableFlag.Usage)
 	rootCmd.PersistentFlags().IntVar(&cfg.DBReadConcurrency, utils.DBReadConcurrencyFlag.Name, utils.DBReadConcurrencyFlag.Value, utils.DBReadConcurrencyFlag.Usage)
@@ -144,12 +146,21 @@ func RootCommand() (*cobra.Command, *httpcfg.HttpCfg) {
 	rootCmd.PersistentFlags().IntVar(&cfg.ReturnDataLimit, utils.RpcReturnDataLimit.Name, utils.RpcReturnDataLimit.Value, utils.RpcReturnDataLimit.Usage)
 	rootCmd.PersistentFlags().BoolVar(&cfg.AllowUnprotectedTxs, utils.AllowUnprotectedTxs.Name, utils.AllowUnprotectedTxs.Value, utils.AllowUnprotectedTxs.Usage)
 	rootCmd.PersistentFlags().IntVar(&cfg.MaxGetProofRewindBlockCount, utils.RpcMaxGetProofRewindBlockCount.Name, utils.RpcMaxGetProofRewindBlockCount.Value, utils.RpcMaxGetProofRewindBlockCount.Usage)
+	rootCmd.PersistentFlags().Uint64Var(&cfg.LogsMaxBlockRange, utils.RpcLogsMaxBlockRange.Name, utils.RpcLogsMaxBlockRange.Value, utils.RpcLogsMaxBlockRange.Usage)
+	rootCmd.PersistentFlags().IntVar(&cfg.LogsMaxResults, utils.RpcLogsMaxResults.Name, utils.RpcLogsMaxResults.Value, utils.RpcLogsMaxResults.Usage)
+	rootCmd.PersistentFlags().IntVar(&cfg.TraceWorkers, utils.RpcTraceWorkers.Name, utils.RpcTraceWorkers.Value, utils.RpcTraceWorkers.Usage)
+	rootCmd.PersistentFlags().DurationVar(&cfg.TracerJsTimeBudget, utils.RpcTracerJsTimeBudget.Name, utils.RpcTracerJsTimeBudget.Value, utils.RpcTracerJsTimeBudget.Usage)
+	rootCmd.PersistentFlags().Uint64Var(&cfg.TracerJsStepBudget, utils.RpcTracerJsStepBudget.Name, utils.RpcTracerJsStepBudget.Value, utils.RpcTracerJsStepBudget.Usage)
+	rootCmd.PersistentFlags().StringVar(&tracerJsHeapBudgetStr, utils.RpcTracerJsHeapBudget.Name, utils.RpcTracerJsHeapBudget.Value, utils.RpcTracerJsHeapBudget.Usage)
 	rootCmd.PersistentFlags().Uint64Var(&cfg.OtsMaxPageSize, utils.OtsSearchMaxCapFlag.Name, utils.OtsSearchMaxCapFlag.Value, utils.OtsSearchMaxCapFlag.Usage)
 	rootCmd.PersistentFlags().DurationVar(&cfg.RPCSlowLogThreshold, utils.RPCSlowFlag.Name, utils.RPCSlowFlag.Value, utils.RPCSlowFlag.Usage)
 
 	if err := rootCmd.MarkPersistentFlagFilename("rpc.accessList", "json"); err != nil {
 		panic(err)
 	}
+	if err := rootCmd.MarkPersistentFlagFilename("rpc.rateLimit", "json"); err != nil {
+		panic(err)
+	}
 	if err := rootCmd.MarkPersistentFlagDirname("datadir"); err != nil {
 		panic(err)
 	}
@@ -166,6 +177,11 @@ func RootCommand() (*cobra.Command, *httpcfg.HttpCfg) {
 			return fmt.Errorf("state.cache value of %v is not valid", stateCacheStr)
 		}
 
+		err = cfg.TracerJsHeapBudget.UnmarshalText([]byte(tracerJsHeapBudgetStr))
+		if err != nil {
+			return fmt.Errorf("%s value of %v is not valid", utils.RpcTracerJsHeapBudget.Name, tracerJsHeapBudgetStr)
+		}
+
 		cfg.WithDatadir = cfg.DataDir != ""
 		if cfg.WithDatadir {
 			if cfg.DataDir == "" {
@@ -576,6 +592,12 @@ func startRegularRpcServer(ctx context.Context, cfg *httpcfg.HttpCfg, rpcAPI []r
 	}
 	srv.SetAllowList(allowListForRPC)
 
+	rateLimiter, rateLimitJwtSecret, err := parseRateLimitsForRPC(cfg.RpcRateLimitFilePath)
+	if err != nil {
+		return err
+	}
+	srv.SetRateLimiter(rateLimiter)
+
 	srv.SetBatchLimit(cfg.BatchLimit)
 
 	defer srv.Stop()
@@ -627,6 +649,9 @@ func startRegularRpcServer(ctx context.Context, cfg *httpcfg.HttpCfg, rpcAPI []r
 	}
 
 	httpHandler := node.NewHTTPHandlerStack(srv, cfg.HttpCORSDomain, cfg.HttpVirtualHost, cfg.HttpCompression)
+	if rateLimitJwtSecret != nil {
+		httpHandler = rpc.OptionalJwtHandler(httpHandler, rateLimitJwtSecret)
+	}
 	var wsHandler http.Handler
 	if cfg.WebsocketEnabled {
 		wsHandler = srv.WebsocketHandler([]string{"*"}, nil, cfg.WebsocketCompression, logger)
@@ -828,8 +853,11 @@ func createHandler(cfg *httpcfg.HttpCfg, apiList []rpc.API, httpHandler http.Han
 			return
 		}
 
-		if jwtSecret != nil && !rpc.CheckJwtSecret(w, r, jwtSecret) {
-			return
+		if jwtSecret != nil {
+			var ok bool
+			if r, ok = rpc.AuthenticateJwt(w, r, jwtSecret); !ok {
+				return
+			}
 		}
 
 		httpHandler.ServeHTTP(w, r)
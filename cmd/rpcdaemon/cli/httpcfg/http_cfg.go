@@ -3,6 +3,8 @@ package httpcfg
 import (
 	"time"
 
+	"github.com/c2h5oh/datasize"
+
 	"github.com/ledgerwatch/erigon-lib/common/datadir"
 	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
 	"github.com/ledgerwatch/erigon/eth/ethconfig"
@@ -47,6 +49,7 @@ type HttpCfg struct {
 	WebsocketEnabled     bool
 	WebsocketCompression bool
 	RpcAllowListFilePath string
+	RpcRateLimitFilePath string
 	RpcBatchConcurrency  uint
 	RpcStreamingDisable  bool
 	DBReadConcurrency    int
@@ -74,10 +77,17 @@ type HttpCfg struct {
 	LogDirVerbosity string
 	LogDirPath      string
 
-	BatchLimit                  int  // Maximum number of requests in a batch
-	ReturnDataLimit             int  // Maximum number of bytes returned from calls (like eth_call)
-	AllowUnprotectedTxs         bool // Whether to allow non EIP-155 protected transactions  txs over RPC
-	MaxGetProofRewindBlockCount int  //Max GetProof rewind block count
+	BatchLimit                  int           // Maximum number of requests in a batch
+	ReturnDataLimit             int           // Maximum number of bytes returned from calls (like eth_call)
+	AllowUnprotectedTxs         bool          // Whether to allow non EIP-155 protected transactions  txs over RPC
+	MaxGetProofRewindBlockCount int           //Max GetProof rewind block count
+	LogsMaxBlockRange           uint64        // Maximum number of blocks of a logs query, 0 means no limit
+	LogsMaxResults              int           // Maximum number of logs of a logs query, 0 means no limit
+	TraceWorkers                int           // Number of workers tracing the transactions of a block in parallel, 1 traces them sequentially
+	TracerJsTimeBudget          time.Duration // Maximum time a JS tracer may run while tracing a transaction, 0 means no limit
+
+	TracerJsStepBudget uint64            // Maximum number of calls into a JS tracer while tracing a transaction, 0 means no limit
+	TracerJsHeapBudget datasize.ByteSize // Maximum size of the state of a JS tracer while tracing a transaction, 0 means no limit
 	// Ots API
 	OtsMaxPageSize uint64
 
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ledgerwatch/erigon/common"
+	"github.com/ledgerwatch/erigon/rpc"
+)
+
+type rateLimitFile struct {
+	// JwtSecretPath is the secret of the JWTs identifying the clients, the clients without a token are identified by
+	// their IP address.
+	JwtSecretPath string              `json:"jwt_secret_path"`
+	Limits        []rpc.RateLimitRule `json:"limits"`
+}
+
+func parseRateLimitsForRPC(path string) (*rpc.RateLimiter, []byte, error) {
+	path = strings.TrimSpace(path)
+	if path == "" { // no file is provided
+		return nil, nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		file.Close() //nolint: errcheck
+	}()
+
+	fileContents, err := io.ReadAll(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rateLimitFileObj rateLimitFile
+	if err = json.Unmarshal(fileContents, &rateLimitFileObj); err != nil {
+		return nil, nil, err
+	}
+
+	rateLimiter, err := rpc.NewRateLimiter(rateLimitFileObj.Limits)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rateLimitFileObj.JwtSecretPath == "" {
+		return rateLimiter, nil, nil
+	}
+	data, err := os.ReadFile(rateLimitFileObj.JwtSecretPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	jwtSecret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(jwtSecret) != 32 {
+		return nil, nil, fmt.Errorf("invalid JWT secret in %s, length %d", rateLimitFileObj.JwtSecretPath, len(jwtSecret))
+	}
+	return rateLimiter, jwtSecret, nil
+}
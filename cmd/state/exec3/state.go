@@ -20,6 +20,7 @@ import (
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm"
 	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
+	"github.com/ledgerwatch/erigon/eth/tracers/live"
 	"github.com/ledgerwatch/erigon/rlp"
 	"github.com/ledgerwatch/erigon/turbo/services"
 )
@@ -44,6 +45,7 @@ type Worker struct {
 	chain    ChainReader
 
 	callTracer  *CallTracer
+	liveTracer  live.Tracer
 	taskGasPool *core.GasPool
 
 	evm *vm.EVM
@@ -98,6 +100,10 @@ func (rw *Worker) ResetTx(chainTx kv.Tx) {
 	}
 }
 
+// SetLiveTracer makes the worker run the live tracer on the transactions it executes,
+// the caller is responsible for the block notifications.
+func (rw *Worker) SetLiveTracer(tracer live.Tracer) { rw.liveTracer = tracer }
+
 func (rw *Worker) Run() error {
 	for txTask, ok := rw.in.Next(rw.ctx); ok; txTask, ok = rw.in.Next(rw.ctx) {
 		rw.RunTxTask(txTask)
@@ -188,6 +194,10 @@ func (rw *Worker) RunTxTaskNoLock(txTask *exec22.TxTask) {
 		rw.callTracer.Reset()
 
 		vmConfig := vm.Config{Debug: true, Tracer: rw.callTracer, SkipAnalysis: txTask.SkipAnalysis}
+		if rw.liveTracer != nil {
+			rw.liveTracer.OnTxStart(txTask.TxIndex, txTask.Tx)
+			vmConfig.Tracer = live.NewMux(rw.callTracer, rw.liveTracer)
+		}
 		ibs.SetTxContext(txHash, txTask.BlockHash, txTask.TxIndex)
 		msg := txTask.TxAsMessage
 
@@ -57,6 +57,15 @@ var (
 
 	noTxGossip bool
 
+	ordering         string
+	prioritySenders  []string
+	maxBestPerSender int
+
+	privateTxLifetime uint64
+
+	journal          string
+	rebroadcastEvery time.Duration
+
 	commitEvery time.Duration
 )
 
@@ -84,6 +93,12 @@ func init() {
 	rootCmd.PersistentFlags().DurationVar(&commitEvery, utils.TxPoolCommitEveryFlag.Name, utils.TxPoolCommitEveryFlag.Value, utils.TxPoolCommitEveryFlag.Usage)
 	rootCmd.PersistentFlags().BoolVar(&noTxGossip, utils.TxPoolGossipDisableFlag.Name, utils.TxPoolGossipDisableFlag.Value, utils.TxPoolGossipDisableFlag.Usage)
 	rootCmd.Flags().StringSliceVar(&traceSenders, utils.TxPoolTraceSendersFlag.Name, []string{}, utils.TxPoolTraceSendersFlag.Usage)
+	rootCmd.PersistentFlags().StringVar(&ordering, utils.TxPoolOrderingFlag.Name, utils.TxPoolOrderingFlag.Value, utils.TxPoolOrderingFlag.Usage)
+	rootCmd.Flags().StringSliceVar(&prioritySenders, utils.TxPoolPrioritySendersFlag.Name, []string{}, utils.TxPoolPrioritySendersFlag.Usage)
+	rootCmd.PersistentFlags().IntVar(&maxBestPerSender, utils.TxPoolMaxBestPerSenderFlag.Name, utils.TxPoolMaxBestPerSenderFlag.Value, utils.TxPoolMaxBestPerSenderFlag.Usage)
+	rootCmd.PersistentFlags().Uint64Var(&privateTxLifetime, utils.TxPoolPrivateTxLifetimeFlag.Name, utils.TxPoolPrivateTxLifetimeFlag.Value, utils.TxPoolPrivateTxLifetimeFlag.Usage)
+	rootCmd.PersistentFlags().StringVar(&journal, utils.TxPoolJournalFlag.Name, utils.TxPoolJournalFlag.Value, utils.TxPoolJournalFlag.Usage)
+	rootCmd.PersistentFlags().DurationVar(&rebroadcastEvery, utils.TxPoolRebroadcastEveryFlag.Name, utils.TxPoolRebroadcastEveryFlag.Value, utils.TxPoolRebroadcastEveryFlag.Usage)
 }
 
 var rootCmd = &cobra.Command{
@@ -159,6 +174,19 @@ func doTxpool(ctx context.Context, logger log.Logger) error {
 		sender := common.HexToAddress(senderHex)
 		cfg.TracedSenders[i] = string(sender[:])
 	}
+	cfg.Ordering = txpoolcfg.Ordering(ordering)
+	cfg.PrioritySenders = make([]string, len(prioritySenders))
+	for i, senderHex := range prioritySenders {
+		sender := common.HexToAddress(senderHex)
+		cfg.PrioritySenders[i] = string(sender[:])
+	}
+	cfg.MaxBestPerSender = maxBestPerSender
+	cfg.PrivateTxLifetime = privateTxLifetime
+	cfg.Journal = journal
+	if journal != "" && !filepath.IsAbs(journal) {
+		cfg.Journal = filepath.Join(dirs.DataDir, journal)
+	}
+	cfg.RebroadcastEvery = rebroadcastEvery
 
 	newTxs := make(chan types.Announcements, 1024)
 	defer close(newTxs)
@@ -218,6 +218,36 @@ var (
 		Usage: "Comma separated list of addresses, whose transactions will traced in transaction pool with debug printing",
 		Value: "",
 	}
+	TxPoolOrderingFlag = cli.StringFlag{
+		Name:  "txpool.ordering",
+		Usage: "Order of the pending transactions offered for block building: 'price' (highest effective tip first) or 'arrival' (first come, first served)",
+		Value: string(txpoolcfg.DefaultConfig.Ordering),
+	}
+	TxPoolPrioritySendersFlag = cli.StringFlag{
+		Name:  "txpool.priority.senders",
+		Usage: "Comma separated list of addresses, whose transactions are offered for block building before the others",
+		Value: "",
+	}
+	TxPoolMaxBestPerSenderFlag = cli.IntFlag{
+		Name:  "txpool.sender.blocklimit",
+		Usage: "Maximum number of transactions of a sender offered for a block, 0 means no limit",
+		Value: 0,
+	}
+	TxPoolPrivateTxLifetimeFlag = cli.Uint64Flag{
+		Name:  "txpool.private.lifetime",
+		Usage: "Number of blocks after which a private transaction (never gossiped, only included in locally built blocks) is dropped if not included",
+		Value: txpoolcfg.DefaultConfig.PrivateTxLifetime,
+	}
+	TxPoolJournalFlag = cli.StringFlag{
+		Name:  "txpool.journal",
+		Usage: "File the local transactions are journaled to, to restore them if the txpool db is lost. Relative to the datadir, empty disables it",
+		Value: "txpool_locals.jsonl",
+	}
+	TxPoolRebroadcastEveryFlag = cli.DurationFlag{
+		Name:  "txpool.rebroadcast.every",
+		Usage: "First interval between rebroadcasts of a local transaction, doubled after each one",
+		Value: txpoolcfg.DefaultConfig.RebroadcastEvery,
+	}
 	TxPoolCommitEveryFlag = cli.DurationFlag{
 		Name:  "txpool.commit.every",
 		Usage: "How often transactions should be committed to the storage",
@@ -409,6 +439,11 @@ var (
 		Usage: "Specify granular (method-by-method) API allowlist",
 	}
 
+	RpcRateLimitFlag = cli.StringFlag{
+		Name:  "rpc.rateLimit",
+		Usage: "Specify per client rate limits of method groups, as a JSON file",
+	}
+
 	RpcGasCapFlag = cli.UintFlag{
 		Name:  "rpc.gascap",
 		Usage: "Sets a cap on gas that can be used in eth_call/estimateGas",
@@ -501,9 +536,39 @@ var (
 	// The current default has been chosen arbitrarily as 'useful' without likely being overly computationally intense.
 	RpcMaxGetProofRewindBlockCount = cli.IntFlag{
 		Name:  "rpc.maxgetproofrewindblockcount.limit",
-		Usage: "Max GetProof rewind block count",
+		Usage: "Max GetProof rewind block count, -1 means any block within the history retention window",
 		Value: 100_000,
 	}
+	RpcLogsMaxBlockRange = cli.Uint64Flag{
+		Name:  "rpc.logs.maxrange",
+		Usage: "Maximum number of blocks eth_getLogs and erigon_getLogs can query at once, 0 means no limit. erigon_getLogsPage returns pages of at most this many blocks",
+		Value: 0,
+	}
+	RpcLogsMaxResults = cli.IntFlag{
+		Name:  "rpc.logs.maxresults",
+		Usage: "Maximum number of logs eth_getLogs and erigon_getLogs can return, 0 means no limit. It also caps the page size of erigon_getLogsPage",
+		Value: 0,
+	}
+	RpcTraceWorkers = cli.IntFlag{
+		Name:  "rpc.trace.workers",
+		Usage: "Number of workers tracing the transactions of a block in parallel in debug_traceBlockByNumber and debug_traceBlockByHash, 1 traces them one after another. The workers are shared by all requests and each of them holds a database read transaction, keep it below --db.read.concurrency",
+		Value: 1,
+	}
+	RpcTracerJsTimeBudget = cli.DurationFlag{
+		Name:  "rpc.tracer.js.timebudget",
+		Usage: "Maximum time a JS tracer may spend running its code while tracing a transaction, 0 means no limit",
+		Value: 0,
+	}
+	RpcTracerJsStepBudget = cli.Uint64Flag{
+		Name:  "rpc.tracer.js.stepbudget",
+		Usage: "Maximum number of calls into a JS tracer, at most one per EVM step, while tracing a transaction, 0 means no limit",
+		Value: 0,
+	}
+	RpcTracerJsHeapBudget = cli.StringFlag{
+		Name:  "rpc.tracer.js.heapbudget",
+		Usage: "Maximum size of the state a JS tracer may hold in its object and globals while tracing a transaction, e.g. 64MB, 0 means no limit",
+		Value: "0",
+	}
 	StateCacheFlag = cli.StringFlag{
 		Name:  "state.cache",
 		Value: "0MB",
@@ -866,6 +931,20 @@ var (
 		Usage: "Enable embedded Silkworm Sentry service",
 	}
 
+	LiveTracerFlag = cli.StringFlag{
+		Name:  "tracer.live",
+		Usage: "Name of the tracer (e.g. callTracer, prestateTracer) to run on every executed block, disabled if empty",
+	}
+	LiveTracerConfigFlag = cli.StringFlag{
+		Name:  "tracer.live.config",
+		Usage: "JSON configuration of the live tracer, e.g. '{\"onlyTopCall\":true}'",
+	}
+	LiveTracerOutputFlag = cli.StringFlag{
+		Name:  "tracer.live.output",
+		Usage: "File the live traces are appended to as JSON lines, - for stdout",
+		Value: "-",
+	}
+
 	BeaconAPIFlag = cli.BoolFlag{
 		Name:  "beacon.api",
 		Usage: "Enable beacon API",
@@ -1409,6 +1488,32 @@ func setTxPool(ctx *cli.Context, fullCfg *ethconfig.Config) {
 	if ctx.IsSet(TxPoolBlobPriceBumpFlag.Name) {
 		fullCfg.TxPool.BlobPriceBump = ctx.Uint64(TxPoolBlobPriceBumpFlag.Name)
 	}
+	if ctx.IsSet(TxPoolOrderingFlag.Name) {
+		fullCfg.TxPool.Ordering = txpoolcfg.Ordering(ctx.String(TxPoolOrderingFlag.Name))
+	}
+	if ctx.IsSet(TxPoolPrioritySendersFlag.Name) {
+		senderHexes := libcommon.CliString2Array(ctx.String(TxPoolPrioritySendersFlag.Name))
+		fullCfg.TxPool.PrioritySenders = make([]string, len(senderHexes))
+		for i, senderHex := range senderHexes {
+			if !libcommon.IsHexAddress(senderHex) {
+				Fatalf("Invalid account in --%s: %s", TxPoolPrioritySendersFlag.Name, senderHex)
+			}
+			sender := libcommon.HexToAddress(senderHex)
+			fullCfg.TxPool.PrioritySenders[i] = string(sender[:])
+		}
+	}
+	if ctx.IsSet(TxPoolMaxBestPerSenderFlag.Name) {
+		fullCfg.TxPool.MaxBestPerSender = ctx.Int(TxPoolMaxBestPerSenderFlag.Name)
+	}
+	fullCfg.TxPool.PrivateTxLifetime = ctx.Uint64(TxPoolPrivateTxLifetimeFlag.Name)
+	if fullCfg.TxPool.PrivateTxLifetime == 0 {
+		Fatalf("--%s must be at least 1", TxPoolPrivateTxLifetimeFlag.Name)
+	}
+	fullCfg.TxPool.Journal = ctx.String(TxPoolJournalFlag.Name)
+	fullCfg.TxPool.RebroadcastEvery = ctx.Duration(TxPoolRebroadcastEveryFlag.Name)
+	if fullCfg.TxPool.RebroadcastEvery <= 0 {
+		Fatalf("--%s must be positive", TxPoolRebroadcastEveryFlag.Name)
+	}
 	cfg.CommitEvery = common2.RandomizeDuration(ctx.Duration(TxPoolCommitEveryFlag.Name))
 }
 
@@ -1574,6 +1679,12 @@ func setSilkworm(ctx *cli.Context, cfg *ethconfig.Config) {
 	cfg.SilkwormSentry = ctx.Bool(SilkwormSentryFlag.Name)
 }
 
+func setLiveTracer(ctx *cli.Context, cfg *ethconfig.Config) {
+	cfg.LiveTracer = ctx.String(LiveTracerFlag.Name)
+	cfg.LiveTracerConfig = ctx.String(LiveTracerConfigFlag.Name)
+	cfg.LiveTracerOutput = ctx.String(LiveTracerOutputFlag.Name)
+}
+
 // CheckExclusive verifies that only a single instance of the provided flags was
 // set by the user. Each flag might optionally be followed by a string type to
 // specialize it further.
@@ -1675,6 +1786,9 @@ func SetEthConfig(ctx *cli.Context, nodeConfig *nodecfg.Config, cfg *ethconfig.C
 	setTxPool(ctx, cfg)
 	cfg.TxPool = ethconfig.DefaultTxPool2Config(cfg)
 	cfg.TxPool.DBDir = nodeConfig.Dirs.TxPool
+	if cfg.TxPool.Journal != "" && !filepath.IsAbs(cfg.TxPool.Journal) {
+		cfg.TxPool.Journal = filepath.Join(nodeConfig.Dirs.DataDir, cfg.TxPool.Journal)
+	}
 
 	setEthash(ctx, nodeConfig.Dirs.DataDir, cfg)
 	setClique(ctx, &cfg.Clique, nodeConfig.Dirs.DataDir)
@@ -1682,6 +1796,7 @@ func SetEthConfig(ctx *cli.Context, nodeConfig *nodecfg.Config, cfg *ethconfig.C
 	setWhitelist(ctx, cfg)
 	setBorConfig(ctx, cfg)
 	setSilkworm(ctx, cfg)
+	setLiveTracer(ctx, cfg)
 	setBeaconAPI(ctx, cfg)
 	setCaplin(ctx, cfg)
 
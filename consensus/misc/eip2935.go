@@ -0,0 +1,23 @@
+package misc
+
+import (
+	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/log/v3"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon/consensus"
+	"github.com/ledgerwatch/erigon/params"
+)
+
+// ApplyParentBlockHashEip2935 stores the hash of the parent block in the history storage contract.
+func ApplyParentBlockHashEip2935(parentHash libcommon.Hash, syscall consensus.SystemCall) {
+	_, err := syscall(params.HistoryStorageAddress, parentHash.Bytes())
+	if err != nil {
+		log.Warn("Failed to call history storage contract", "err", err)
+	}
+}
+
+// BlockHashHistorySlot returns the storage slot of the history storage contract holding the hash of block number.
+func BlockHashHistorySlot(number uint64) libcommon.Hash {
+	return uint256.NewInt(number % params.BlockHashHistoryServeWindow).Bytes32()
+}
@@ -100,6 +100,10 @@ var (
 	// current network configuration.
 	ErrTxTypeNotSupported = types.ErrTxTypeNotSupported
 
+	// ErrEmptyAuthorizations is returned if a set code transaction does not
+	// carry any authorization.
+	ErrEmptyAuthorizations = types.ErrEmptyAuthorizations
+
 	// ErrFeeCapTooLow is returned if the transaction fee cap is less than the
 	// the base fee of the block.
 	ErrFeeCapTooLow = errors.New("fee cap less than block base fee")
@@ -27,6 +27,7 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	types2 "github.com/ledgerwatch/erigon-lib/types"
 	"github.com/ledgerwatch/erigon/common/u256"
+	"github.com/ledgerwatch/erigon/core/tracing"
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/types/accounts"
 	"github.com/ledgerwatch/erigon/crypto"
@@ -87,8 +88,10 @@ type IntraBlockState struct {
 	journal        *journal
 	validRevisions []revision
 	nextRevisionID int
+	keepJournal    bool // FinalizeTx keeps the journal, so that a snapshot can revert several transactions
 	trace          bool
 	balanceInc     map[libcommon.Address]*BalanceIncrease // Map of balance increases (without first reading the account)
+	hooks          tracing.StateHooks
 }
 
 // Create a new state from a given trie
@@ -141,12 +144,20 @@ func (sdb *IntraBlockState) Reset() {
 	sdb.logSize = 0
 }
 
+// SetHooks sets the tracer hooks notified of the state changes, nil disables them.
+func (sdb *IntraBlockState) SetHooks(hooks tracing.StateHooks) {
+	sdb.hooks = hooks
+}
+
 func (sdb *IntraBlockState) AddLog(log2 *types.Log) {
 	sdb.journal.append(addLogChange{txhash: sdb.thash})
 	log2.TxHash = sdb.thash
 	log2.BlockHash = sdb.bhash
 	log2.TxIndex = uint(sdb.txIndex)
 	log2.Index = sdb.logSize
+	if sdb.hooks != nil {
+		sdb.hooks.OnLog(log2)
+	}
 	sdb.logs[sdb.thash] = append(sdb.logs[sdb.thash], log2)
 	sdb.logSize++
 }
@@ -218,6 +229,11 @@ func (sdb *IntraBlockState) TxIndex() int {
 	return sdb.txIndex
 }
 
+// BlockHash returns the hash of the block set by SetTxContext.
+func (sdb *IntraBlockState) BlockHash() libcommon.Hash {
+	return sdb.bhash
+}
+
 // DESCRIBED: docs/programmers_guide/guide.md#address---identifier-of-an-account
 func (sdb *IntraBlockState) GetCode(addr libcommon.Address) []byte {
 	stateObject := sdb.getStateObject(addr)
@@ -300,7 +316,7 @@ func (sdb *IntraBlockState) HasSelfdestructed(addr libcommon.Address) bool {
 
 // AddBalance adds amount to the account associated with addr.
 // DESCRIBED: docs/programmers_guide/guide.md#address---identifier-of-an-account
-func (sdb *IntraBlockState) AddBalance(addr libcommon.Address, amount *uint256.Int) {
+func (sdb *IntraBlockState) AddBalance(addr libcommon.Address, amount *uint256.Int, reason tracing.BalanceChangeReason) {
 	if sdb.trace {
 		fmt.Printf("AddBalance %x, %d\n", addr, amount)
 	}
@@ -309,6 +325,10 @@ func (sdb *IntraBlockState) AddBalance(addr libcommon.Address, amount *uint256.I
 	if !needAccount && addr == ripemd && amount.IsZero() {
 		needAccount = true
 	}
+	// The hooks are given the previous balance, so the account has to be read
+	if !needAccount && sdb.hooks != nil && !amount.IsZero() {
+		needAccount = true
+	}
 	if !needAccount {
 		sdb.journal.append(balanceIncrease{
 			account:  &addr,
@@ -325,27 +345,27 @@ func (sdb *IntraBlockState) AddBalance(addr libcommon.Address, amount *uint256.I
 	}
 
 	stateObject := sdb.GetOrNewStateObject(addr)
-	stateObject.AddBalance(amount)
+	stateObject.AddBalance(amount, reason)
 }
 
 // SubBalance subtracts amount from the account associated with addr.
 // DESCRIBED: docs/programmers_guide/guide.md#address---identifier-of-an-account
-func (sdb *IntraBlockState) SubBalance(addr libcommon.Address, amount *uint256.Int) {
+func (sdb *IntraBlockState) SubBalance(addr libcommon.Address, amount *uint256.Int, reason tracing.BalanceChangeReason) {
 	if sdb.trace {
 		fmt.Printf("SubBalance %x, %d\n", addr, amount)
 	}
 
 	stateObject := sdb.GetOrNewStateObject(addr)
 	if stateObject != nil {
-		stateObject.SubBalance(amount)
+		stateObject.SubBalance(amount, reason)
 	}
 }
 
 // DESCRIBED: docs/programmers_guide/guide.md#address---identifier-of-an-account
-func (sdb *IntraBlockState) SetBalance(addr libcommon.Address, amount *uint256.Int) {
+func (sdb *IntraBlockState) SetBalance(addr libcommon.Address, amount *uint256.Int, reason tracing.BalanceChangeReason) {
 	stateObject := sdb.GetOrNewStateObject(addr)
 	if stateObject != nil {
-		stateObject.SetBalance(amount)
+		stateObject.SetBalance(amount, reason)
 	}
 }
 
@@ -409,6 +429,9 @@ func (sdb *IntraBlockState) Selfdestruct(addr libcommon.Address) bool {
 	if stateObject == nil || stateObject.deleted {
 		return false
 	}
+	if sdb.hooks != nil && !stateObject.Balance().IsZero() {
+		sdb.hooks.OnBalanceChange(addr, stateObject.Balance(), new(uint256.Int), tracing.BalanceDecreaseSelfdestruct)
+	}
 	sdb.journal.append(selfdestructChange{
 		account:     &addr,
 		prev:        stateObject.selfdestructed,
@@ -677,17 +700,48 @@ func (sdb *IntraBlockState) FinalizeTx(chainRules *chain.Rules, stateWriter Stat
 			continue
 		}
 
+		if sdb.keepJournal {
+			sdb.journalFinalizeTx(so)
+		}
 		if err := updateAccount(chainRules.IsSpuriousDragon, chainRules.IsAura, stateWriter, addr, so, true); err != nil {
 			return err
 		}
 		so.newlyCreated = false
 		sdb.stateObjectsDirty[addr] = struct{}{}
 	}
+	if sdb.keepJournal {
+		sdb.refund = 0
+		return nil
+	}
 	// Invalidate journal because reverting across transactions is not allowed.
 	sdb.clearJournalAndRefund()
 	return nil
 }
 
+// KeepJournal makes FinalizeTx keep the journal while keep is set, so that a snapshot taken before several
+// transactions can revert all of them, as the miner does to include a bundle atomically. What FinalizeTx
+// writes to its state writer is not reverted. The journal is cleared when it stops being kept.
+func (sdb *IntraBlockState) KeepJournal(keep bool) {
+	sdb.keepJournal = keep
+	if !keep {
+		sdb.clearJournalAndRefund()
+	}
+}
+
+// journalFinalizeTx journals what FinalizeTx changes in the state object
+func (sdb *IntraBlockState) journalFinalizeTx(so *stateObject) {
+	_, dirty := sdb.stateObjectsDirty[so.address]
+	ch := finalizeTxChange{object: so, dirty: dirty, deleted: so.deleted, newlyCreated: so.newlyCreated, origin: Storage{}}
+	for key := range so.dirtyStorage {
+		if value, ok := so.originStorage[key]; ok {
+			ch.origin[key] = value
+		} else {
+			ch.newOrigin = append(ch.newOrigin, key)
+		}
+	}
+	sdb.journal.append(ch)
+}
+
 // CommitBlock finalizes the state by removing the self destructed objects
 // and clears the journal as well as the refunds.
 func (sdb *IntraBlockState) CommitBlock(chainRules *chain.Rules, stateWriter StateWriter) error {
@@ -766,6 +820,9 @@ func (sdb *IntraBlockState) clearJournalAndRefund() {
 func (sdb *IntraBlockState) Prepare(rules *chain.Rules, sender, coinbase libcommon.Address, dst *libcommon.Address,
 	precompiles []libcommon.Address, list types2.AccessList,
 ) {
+	if sdb.keepJournal {
+		sdb.journal.append(prepareTxChange{accessList: sdb.accessList, transientStorage: sdb.transientStorage})
+	}
 	if rules.IsBerlin {
 		// Clear out any leftover from previous executions
 		al := newAccessList()
@@ -32,8 +32,10 @@ import (
 	"testing/quick"
 
 	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/erigon-lib/chain"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+	"github.com/ledgerwatch/erigon/core/tracing"
 	"github.com/ledgerwatch/erigon/core/types"
 )
 
@@ -80,14 +82,14 @@ func newTestAction(addr libcommon.Address, r *rand.Rand) testAction {
 		{
 			name: "SetBalance",
 			fn: func(a testAction, s *IntraBlockState) {
-				s.SetBalance(addr, uint256.NewInt(uint64(a.args[0])))
+				s.SetBalance(addr, uint256.NewInt(uint64(a.args[0])), tracing.BalanceChangeUnspecified)
 			},
 			args: make([]int64, 1),
 		},
 		{
 			name: "AddBalance",
 			fn: func(a testAction, s *IntraBlockState) {
-				s.AddBalance(addr, uint256.NewInt(uint64(a.args[0])))
+				s.AddBalance(addr, uint256.NewInt(uint64(a.args[0])), tracing.BalanceChangeUnspecified)
 			},
 			args: make([]int64, 1),
 		},
@@ -350,3 +352,54 @@ func TestTransientStorage(t *testing.T) {
 		t.Fatalf("transient storage mismatch: have %x, want %x", got, exp)
 	}
 }
+
+func TestRevertAcrossTransactions(t *testing.T) {
+	t.Parallel()
+	db := memdb.NewTestDB(t)
+	tx, err := db.BeginRw(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	rules := &chain.Rules{IsSpuriousDragon: true, IsBerlin: true}
+	addr, key := libcommon.Address{0x01}, libcommon.Hash{0x02}
+	state := New(NewPlainState(tx, 1, nil))
+	state.SetBalance(addr, uint256.NewInt(1), tracing.BalanceChangeUnspecified)
+	if err := state.FinalizeTx(rules, NewNoopWriter()); err != nil {
+		t.Fatal(err)
+	}
+
+	state.KeepJournal(true)
+	snapshot := state.Snapshot()
+	for i := uint64(1); i <= 2; i++ {
+		state.Prepare(rules, addr, libcommon.Address{}, nil, nil, nil)
+		state.SetState(addr, &key, *uint256.NewInt(i))
+		state.AddBalance(addr, uint256.NewInt(i), tracing.BalanceChangeUnspecified)
+		state.SetNonce(addr, i)
+		if err := state.FinalizeTx(rules, NewNoopWriter()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	state.RevertToSnapshot(snapshot)
+	state.KeepJournal(false)
+
+	var value uint256.Int
+	state.GetState(addr, &key, &value)
+	if !value.IsZero() {
+		t.Fatalf("storage mismatch: have %d, want 0", &value)
+	}
+	state.GetCommittedState(addr, &key, &value)
+	if !value.IsZero() {
+		t.Fatalf("committed storage mismatch: have %d, want 0", &value)
+	}
+	if balance := state.GetBalance(addr); !balance.Eq(uint256.NewInt(1)) {
+		t.Fatalf("balance mismatch: have %d, want 1", balance)
+	}
+	if nonce := state.GetNonce(addr); nonce != 0 {
+		t.Fatalf("nonce mismatch: have %d, want 0", nonce)
+	}
+	if state.AddressInAccessList(addr) {
+		t.Fatal("access list of a reverted transaction kept")
+	}
+}
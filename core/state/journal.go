@@ -155,6 +155,20 @@ type (
 		key      libcommon.Hash
 		prevalue uint256.Int
 	}
+
+	// Changes between transactions, only journaled while the journal is kept across them.
+	finalizeTxChange struct {
+		object       *stateObject
+		dirty        bool // whether the account was in stateObjectsDirty
+		deleted      bool
+		newlyCreated bool
+		origin       Storage          // committed storage values overwritten
+		newOrigin    []libcommon.Hash // committed storage values added
+	}
+	prepareTxChange struct {
+		accessList       *accessList
+		transientStorage transientStorage
+	}
 )
 
 func (ch createObjectChange) revert(s *IntraBlockState) {
@@ -310,3 +324,30 @@ func (ch accessListAddSlotChange) revert(s *IntraBlockState) {
 func (ch accessListAddSlotChange) dirtied() *libcommon.Address {
 	return nil
 }
+
+func (ch finalizeTxChange) revert(s *IntraBlockState) {
+	so := ch.object
+	so.deleted, so.newlyCreated = ch.deleted, ch.newlyCreated
+	for key, value := range ch.origin {
+		so.originStorage[key] = value
+	}
+	for _, key := range ch.newOrigin {
+		delete(so.originStorage, key)
+	}
+	if !ch.dirty {
+		delete(s.stateObjectsDirty, so.address)
+	}
+}
+
+func (ch finalizeTxChange) dirtied() *libcommon.Address {
+	return nil
+}
+
+func (ch prepareTxChange) revert(s *IntraBlockState) {
+	s.accessList = ch.accessList
+	s.transientStorage = ch.transientStorage
+}
+
+func (ch prepareTxChange) dirtied() *libcommon.Address {
+	return nil
+}
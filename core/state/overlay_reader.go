@@ -0,0 +1,140 @@
+package state
+
+import (
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/core/types/accounts"
+)
+
+var _ StateWriter = (*StateChanges)(nil)
+var _ StateReader = (*OverlayReader)(nil)
+
+// StateChanges records the state written by a transaction, i.e. the writes reported by
+// IntraBlockState.FinalizeTx, so that they can be applied to an OverlayReader later on.
+type StateChanges struct {
+	accounts map[libcommon.Address]*accounts.Account // nil for the deleted accounts
+	wiped    map[libcommon.Address]uint64            // accounts whose storage was cleared, with the incarnation deleted
+	storage  map[libcommon.Address]map[libcommon.Hash][]byte
+	code     map[libcommon.Hash][]byte
+}
+
+func NewStateChanges() *StateChanges {
+	return &StateChanges{
+		accounts: make(map[libcommon.Address]*accounts.Account),
+		wiped:    make(map[libcommon.Address]uint64),
+		storage:  make(map[libcommon.Address]map[libcommon.Hash][]byte),
+		code:     make(map[libcommon.Hash][]byte),
+	}
+}
+
+func (c *StateChanges) UpdateAccountData(address libcommon.Address, original, account *accounts.Account) error {
+	c.accounts[address] = account.SelfCopy()
+	return nil
+}
+
+func (c *StateChanges) UpdateAccountCode(address libcommon.Address, incarnation uint64, codeHash libcommon.Hash, code []byte) error {
+	c.code[codeHash] = libcommon.CopyBytes(code)
+	return nil
+}
+
+func (c *StateChanges) DeleteAccount(address libcommon.Address, original *accounts.Account) error {
+	c.accounts[address] = nil
+	c.wipe(address, original.Incarnation)
+	return nil
+}
+
+func (c *StateChanges) WriteAccountStorage(address libcommon.Address, incarnation uint64, key *libcommon.Hash, original, value *uint256.Int) error {
+	storage := c.storage[address]
+	if storage == nil {
+		storage = make(map[libcommon.Hash][]byte)
+		c.storage[address] = storage
+	}
+	storage[*key] = value.Bytes()
+	return nil
+}
+
+func (c *StateChanges) CreateContract(address libcommon.Address) error {
+	c.wipe(address, 0)
+	return nil
+}
+
+// wipe drops the storage written so far, which belongs to a previous incarnation.
+func (c *StateChanges) wipe(address libcommon.Address, incarnation uint64) {
+	delete(c.storage, address)
+	if incarnation < c.wiped[address] {
+		incarnation = c.wiped[address]
+	}
+	c.wiped[address] = incarnation
+}
+
+// OverlayReader reads the state from a StateReader, as modified by the StateChanges applied to it.
+// It is not safe for concurrent use.
+type OverlayReader struct {
+	r       StateReader
+	changes *StateChanges
+}
+
+func NewOverlayReader(r StateReader) *OverlayReader {
+	return &OverlayReader{r: r, changes: NewStateChanges()}
+}
+
+// Apply applies the changes of a transaction on top of the ones applied before.
+func (o *OverlayReader) Apply(changes *StateChanges) {
+	for address, incarnation := range changes.wiped {
+		o.changes.wipe(address, incarnation)
+	}
+	for address, account := range changes.accounts {
+		o.changes.accounts[address] = account
+	}
+	for address, storage := range changes.storage {
+		for key, value := range storage {
+			_ = o.changes.WriteAccountStorage(address, 0, &key, nil, new(uint256.Int).SetBytes(value))
+		}
+	}
+	for codeHash, code := range changes.code {
+		o.changes.code[codeHash] = code
+	}
+}
+
+func (o *OverlayReader) ReadAccountData(address libcommon.Address) (*accounts.Account, error) {
+	if account, ok := o.changes.accounts[address]; ok {
+		if account == nil {
+			return nil, nil
+		}
+		return account.SelfCopy(), nil
+	}
+	return o.r.ReadAccountData(address)
+}
+
+func (o *OverlayReader) ReadAccountStorage(address libcommon.Address, incarnation uint64, key *libcommon.Hash) ([]byte, error) {
+	if value, ok := o.changes.storage[address][*key]; ok {
+		return value, nil
+	}
+	if _, ok := o.changes.wiped[address]; ok {
+		return nil, nil
+	}
+	return o.r.ReadAccountStorage(address, incarnation, key)
+}
+
+func (o *OverlayReader) ReadAccountCode(address libcommon.Address, incarnation uint64, codeHash libcommon.Hash) ([]byte, error) {
+	if code, ok := o.changes.code[codeHash]; ok {
+		return code, nil
+	}
+	return o.r.ReadAccountCode(address, incarnation, codeHash)
+}
+
+func (o *OverlayReader) ReadAccountCodeSize(address libcommon.Address, incarnation uint64, codeHash libcommon.Hash) (int, error) {
+	if code, ok := o.changes.code[codeHash]; ok {
+		return len(code), nil
+	}
+	return o.r.ReadAccountCodeSize(address, incarnation, codeHash)
+}
+
+func (o *OverlayReader) ReadAccountIncarnation(address libcommon.Address) (uint64, error) {
+	if incarnation, ok := o.changes.wiped[address]; ok && incarnation > 0 {
+		return incarnation, nil
+	}
+	return o.r.ReadAccountIncarnation(address)
+}
@@ -0,0 +1,70 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+
+	"github.com/ledgerwatch/erigon/core/tracing"
+)
+
+func TestOverlayReader(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	rules := &chain.Rules{}
+	contract := libcommon.HexToAddress("0x71dd1027069078091B3ca48093B00E4735B20624")
+	key1, key2 := libcommon.HexToHash("0x01"), libcommon.HexToHash("0x02")
+
+	base := New(NewPlainStateReader(tx))
+	base.CreateAccount(contract, true)
+	base.SetCode(contract, []byte{0x60, 0x00})
+	base.SetState(contract, &key1, *uint256.NewInt(1))
+	require.NoError(t, base.FinalizeTx(rules, NewPlainStateWriterNoHistory(tx)))
+	require.NoError(t, base.CommitBlock(rules, NewPlainStateWriterNoHistory(tx)))
+
+	// the changes of two transactions, applied to the same state as the one read by the overlay
+	ibs := New(NewPlainStateReader(tx))
+	ibs.AddBalance(contract, uint256.NewInt(100), tracing.BalanceChangeUnspecified)
+	ibs.SetState(contract, &key2, *uint256.NewInt(2))
+	changes1 := NewStateChanges()
+	require.NoError(t, ibs.FinalizeTx(rules, changes1))
+	ibs.Selfdestruct(contract)
+	changes2 := NewStateChanges()
+	require.NoError(t, ibs.FinalizeTx(rules, changes2))
+
+	overlay := NewOverlayReader(NewPlainStateReader(tx))
+	account, err := overlay.ReadAccountData(contract)
+	require.NoError(t, err)
+	require.True(t, account.Balance.IsZero())
+	incarnation := account.Incarnation
+
+	overlay.Apply(changes1)
+	account, err = overlay.ReadAccountData(contract)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), account.Balance.Uint64())
+	value, err := overlay.ReadAccountStorage(contract, incarnation, &key1)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, value)
+	value, err = overlay.ReadAccountStorage(contract, incarnation, &key2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, value)
+	code, err := overlay.ReadAccountCode(contract, incarnation, account.CodeHash)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x60, 0x00}, code)
+
+	overlay.Apply(changes2)
+	account, err = overlay.ReadAccountData(contract)
+	require.NoError(t, err)
+	require.Nil(t, account)
+	value, err = overlay.ReadAccountStorage(contract, incarnation, &key1)
+	require.NoError(t, err)
+	require.Empty(t, value)
+	prevIncarnation, err := overlay.ReadAccountIncarnation(contract)
+	require.NoError(t, err)
+	require.Equal(t, incarnation, prevIncarnation)
+}
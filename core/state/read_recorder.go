@@ -0,0 +1,115 @@
+package state
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/types/accounts"
+)
+
+var _ StateReader = (*ReadRecorder)(nil)
+
+// ReadRecorder is a StateReader recording the accounts and the storage slots read through it.
+// An IntraBlockState reads every account and slot once, so the reads of a transaction are the
+// ones of a new IntraBlockState executing it.
+type ReadRecorder struct {
+	r        StateReader
+	accounts map[libcommon.Address]struct{}
+	storage  map[libcommon.Address]map[libcommon.Hash]struct{}
+}
+
+func NewReadRecorder(r StateReader) *ReadRecorder {
+	return &ReadRecorder{
+		r:        r,
+		accounts: make(map[libcommon.Address]struct{}),
+		storage:  make(map[libcommon.Address]map[libcommon.Hash]struct{}),
+	}
+}
+
+func (rr *ReadRecorder) ReadAccountData(address libcommon.Address) (*accounts.Account, error) {
+	rr.accounts[address] = struct{}{}
+	return rr.r.ReadAccountData(address)
+}
+
+func (rr *ReadRecorder) ReadAccountStorage(address libcommon.Address, incarnation uint64, key *libcommon.Hash) ([]byte, error) {
+	rr.accounts[address] = struct{}{}
+	slots := rr.storage[address]
+	if slots == nil {
+		slots = make(map[libcommon.Hash]struct{})
+		rr.storage[address] = slots
+	}
+	slots[*key] = struct{}{}
+	return rr.r.ReadAccountStorage(address, incarnation, key)
+}
+
+func (rr *ReadRecorder) ReadAccountCode(address libcommon.Address, incarnation uint64, codeHash libcommon.Hash) ([]byte, error) {
+	rr.accounts[address] = struct{}{}
+	return rr.r.ReadAccountCode(address, incarnation, codeHash)
+}
+
+func (rr *ReadRecorder) ReadAccountCodeSize(address libcommon.Address, incarnation uint64, codeHash libcommon.Hash) (int, error) {
+	rr.accounts[address] = struct{}{}
+	return rr.r.ReadAccountCodeSize(address, incarnation, codeHash)
+}
+
+func (rr *ReadRecorder) ReadAccountIncarnation(address libcommon.Address) (uint64, error) {
+	rr.accounts[address] = struct{}{}
+	return rr.r.ReadAccountIncarnation(address)
+}
+
+// AccountAccesses returns the accounts accessed by a transaction, given the reads recorded while
+// executing it and the changes it wrote, sorted by address.
+func AccountAccesses(reads *ReadRecorder, writes *StateChanges) []types.AccountAccess {
+	addresses := make(map[libcommon.Address]struct{}, len(reads.accounts)+len(writes.accounts))
+	for address := range reads.accounts {
+		addresses[address] = struct{}{}
+	}
+	for address := range writes.accounts {
+		addresses[address] = struct{}{}
+	}
+	for address := range writes.storage {
+		addresses[address] = struct{}{}
+	}
+
+	result := make([]types.AccountAccess, 0, len(addresses))
+	for address := range addresses {
+		access := types.AccountAccess{Address: address}
+		written := writes.storage[address]
+		for slot := range reads.storage[address] {
+			if _, ok := written[slot]; !ok {
+				access.StorageReads = append(access.StorageReads, slot)
+			}
+		}
+		sort.Slice(access.StorageReads, func(i, j int) bool {
+			return bytes.Compare(access.StorageReads[i][:], access.StorageReads[j][:]) < 0
+		})
+		for slot, value := range written {
+			access.StorageWrites = append(access.StorageWrites, types.StorageWrite{Slot: slot, Value: new(uint256.Int).SetBytes(value).Bytes32()})
+		}
+		sort.Slice(access.StorageWrites, func(i, j int) bool {
+			return bytes.Compare(access.StorageWrites[i].Slot[:], access.StorageWrites[j].Slot[:]) < 0
+		})
+		if account, ok := writes.accounts[address]; ok {
+			if account == nil {
+				access.Deleted = true
+			} else {
+				nonce := hexutil.Uint64(account.Nonce)
+				access.Balance, access.Nonce = (*hexutil.Big)(account.Balance.ToBig()), &nonce
+				if code, ok := writes.code[account.CodeHash]; ok {
+					access.Code = code
+				}
+			}
+		}
+		result = append(result, access)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].Address[:], result[j].Address[:]) < 0
+	})
+	return result
+}
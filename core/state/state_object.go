@@ -25,6 +25,7 @@ import (
 	"github.com/holiman/uint256"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
+	"github.com/ledgerwatch/erigon/core/tracing"
 	"github.com/ledgerwatch/erigon/core/types/accounts"
 	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/rlp"
@@ -218,6 +219,9 @@ func (so *stateObject) SetState(key *libcommon.Hash, value uint256.Int) {
 	if prev == value {
 		return
 	}
+	if so.db.hooks != nil {
+		so.db.hooks.OnStorageChange(so.address, key, prev, value)
+	}
 	// New value is different, update and journal the change
 	so.db.journal.append(storageChange{
 		account:  &so.address,
@@ -269,7 +273,7 @@ func (so *stateObject) printTrie() {
 
 // AddBalance adds amount to so's balance.
 // It is used to add funds to the destination account of a transfer.
-func (so *stateObject) AddBalance(amount *uint256.Int) {
+func (so *stateObject) AddBalance(amount *uint256.Int, reason tracing.BalanceChangeReason) {
 	// EIP161: We must check emptiness for the objects such that the account
 	// clearing (0,0,0 objects) can take effect.
 	if amount.IsZero() {
@@ -280,19 +284,22 @@ func (so *stateObject) AddBalance(amount *uint256.Int) {
 		return
 	}
 
-	so.SetBalance(new(uint256.Int).Add(so.Balance(), amount))
+	so.SetBalance(new(uint256.Int).Add(so.Balance(), amount), reason)
 }
 
 // SubBalance removes amount from so's balance.
 // It is used to remove funds from the origin account of a transfer.
-func (so *stateObject) SubBalance(amount *uint256.Int) {
+func (so *stateObject) SubBalance(amount *uint256.Int, reason tracing.BalanceChangeReason) {
 	if amount.IsZero() {
 		return
 	}
-	so.SetBalance(new(uint256.Int).Sub(so.Balance(), amount))
+	so.SetBalance(new(uint256.Int).Sub(so.Balance(), amount), reason)
 }
 
-func (so *stateObject) SetBalance(amount *uint256.Int) {
+func (so *stateObject) SetBalance(amount *uint256.Int, reason tracing.BalanceChangeReason) {
+	if so.db.hooks != nil {
+		so.db.hooks.OnBalanceChange(so.address, so.Balance(), amount, reason)
+	}
 	so.db.journal.append(balanceChange{
 		account: &so.address,
 		prev:    so.data.Balance,
@@ -339,6 +346,9 @@ func (so *stateObject) Code() []byte {
 
 func (so *stateObject) SetCode(codeHash libcommon.Hash, code []byte) {
 	prevcode := so.Code()
+	if so.db.hooks != nil {
+		so.db.hooks.OnCodeChange(so.address, so.data.CodeHash, prevcode, codeHash, code)
+	}
 	so.db.journal.append(codeChange{
 		account:  &so.address,
 		prevhash: so.data.CodeHash,
@@ -354,6 +364,9 @@ func (so *stateObject) setCode(codeHash libcommon.Hash, code []byte) {
 }
 
 func (so *stateObject) SetNonce(nonce uint64) {
+	if so.db.hooks != nil {
+		so.db.hooks.OnNonceChange(so.address, so.data.Nonce, nonce)
+	}
 	so.db.journal.append(nonceChange{
 		account: &so.address,
 		prev:    so.data.Nonce,
@@ -19,6 +19,7 @@ package state
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/holiman/uint256"
@@ -27,8 +28,11 @@ import (
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/kvcfg"
 	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+	"github.com/stretchr/testify/require"
 	checker "gopkg.in/check.v1"
 
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/types/accounts"
 	"github.com/ledgerwatch/erigon/crypto"
 )
@@ -48,11 +52,11 @@ var _ = checker.Suite(&StateSuite{})
 func (s *StateSuite) TestDump(c *checker.C) {
 	// generate a few entries
 	obj1 := s.state.GetOrNewStateObject(toAddr([]byte{0x01}))
-	obj1.AddBalance(uint256.NewInt(22))
+	obj1.AddBalance(uint256.NewInt(22), tracing.BalanceChangeUnspecified)
 	obj2 := s.state.GetOrNewStateObject(toAddr([]byte{0x01, 0x02}))
 	obj2.SetCode(crypto.Keccak256Hash([]byte{3, 3, 3, 3, 3, 3, 3}), []byte{3, 3, 3, 3, 3, 3, 3})
 	obj3 := s.state.GetOrNewStateObject(toAddr([]byte{0x02}))
-	obj3.SetBalance(uint256.NewInt(44))
+	obj3.SetBalance(uint256.NewInt(44), tracing.BalanceChangeUnspecified)
 
 	// write some of them to the trie
 	err := s.w.UpdateAccountData(obj1.address, &obj1.data, new(accounts.Account))
@@ -160,7 +164,7 @@ func (s *StateSuite) TestTouchDelete(c *checker.C) {
 	s.state.Reset()
 
 	snapshot := s.state.Snapshot()
-	s.state.AddBalance(common.Address{}, new(uint256.Int))
+	s.state.AddBalance(common.Address{}, new(uint256.Int), tracing.BalanceChangeUnspecified)
 
 	if len(s.state.journal.dirties) != 1 {
 		c.Fatal("expected one dirty state object")
@@ -226,7 +230,7 @@ func TestSnapshot2(t *testing.T) {
 
 	// db, trie are already non-empty values
 	so0 := state.getStateObject(stateobjaddr0)
-	so0.SetBalance(uint256.NewInt(42))
+	so0.SetBalance(uint256.NewInt(42), tracing.BalanceChangeUnspecified)
 	so0.SetNonce(43)
 	so0.SetCode(crypto.Keccak256Hash([]byte{'c', 'a', 'f', 'e'}), []byte{'c', 'a', 'f', 'e'})
 	so0.selfdestructed = false
@@ -246,7 +250,7 @@ func TestSnapshot2(t *testing.T) {
 
 	// and one with deleted == true
 	so1 := state.getStateObject(stateobjaddr1)
-	so1.SetBalance(uint256.NewInt(52))
+	so1.SetBalance(uint256.NewInt(52), tracing.BalanceChangeUnspecified)
 	so1.SetNonce(53)
 	so1.SetCode(crypto.Keccak256Hash([]byte{'c', 'a', 'f', 'e', '2'}), []byte{'c', 'a', 'f', 'e', '2'})
 	so1.selfdestructed = true
@@ -332,12 +336,12 @@ func TestDump(t *testing.T) {
 
 	// generate a few entries
 	obj1 := state.GetOrNewStateObject(toAddr([]byte{0x01}))
-	obj1.AddBalance(uint256.NewInt(22))
+	obj1.AddBalance(uint256.NewInt(22), tracing.BalanceChangeUnspecified)
 	obj2 := state.GetOrNewStateObject(toAddr([]byte{0x01, 0x02}))
 	obj2.SetCode(crypto.Keccak256Hash([]byte{3, 3, 3, 3, 3, 3, 3}), []byte{3, 3, 3, 3, 3, 3, 3})
 	obj2.setIncarnation(1)
 	obj3 := state.GetOrNewStateObject(toAddr([]byte{0x02}))
-	obj3.SetBalance(uint256.NewInt(44))
+	obj3.SetBalance(uint256.NewInt(44), tracing.BalanceChangeUnspecified)
 
 	// write some of them to the trie
 	err := w.UpdateAccountData(obj1.address, &obj1.data, new(accounts.Account))
@@ -402,3 +406,62 @@ func TestDump(t *testing.T) {
 		t.Fatalf("dump mismatch:\ngot: %s\nwant: %s\n", got, want)
 	}
 }
+
+type stateHooksRecorder struct {
+	events []string
+}
+
+func (r *stateHooksRecorder) OnBalanceChange(addr common.Address, prev, new *uint256.Int, reason tracing.BalanceChangeReason) {
+	r.events = append(r.events, fmt.Sprintf("balance %x %d->%d reason %d", addr, prev, new, reason))
+}
+
+func (r *stateHooksRecorder) OnNonceChange(addr common.Address, prev, new uint64) {
+	r.events = append(r.events, fmt.Sprintf("nonce %x %d->%d", addr, prev, new))
+}
+
+func (r *stateHooksRecorder) OnCodeChange(addr common.Address, prevCodeHash common.Hash, prevCode []byte, codeHash common.Hash, code []byte) {
+	r.events = append(r.events, fmt.Sprintf("code %x %x->%x", addr, prevCode, code))
+}
+
+func (r *stateHooksRecorder) OnStorageChange(addr common.Address, slot *common.Hash, prev, new uint256.Int) {
+	r.events = append(r.events, fmt.Sprintf("storage %x %x %d->%d", addr, *slot, &prev, &new))
+}
+
+func (r *stateHooksRecorder) OnLog(log *types.Log) {
+	r.events = append(r.events, fmt.Sprintf("log %x %d", log.Address, log.Index))
+}
+
+func TestStateHooks(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	state := New(NewPlainStateReader(tx))
+	hooks := &stateHooksRecorder{}
+	state.SetHooks(hooks)
+
+	addr := toAddr([]byte{0x01})
+	slot := common.Hash{0x02}
+	state.AddBalance(addr, uint256.NewInt(10), tracing.BalanceChangeTransfer)
+	state.SubBalance(addr, uint256.NewInt(3), tracing.BalanceDecreaseGasBuy)
+	state.SubBalance(addr, uint256.NewInt(0), tracing.BalanceDecreaseGasBuy)
+	state.SetNonce(addr, 1)
+	state.SetCode(addr, []byte{0xfe})
+	state.SetState(addr, &slot, *uint256.NewInt(5))
+	state.SetState(addr, &slot, *uint256.NewInt(5))
+	state.AddLog(&types.Log{Address: addr})
+	state.Selfdestruct(addr)
+
+	require.Equal(t, []string{
+		fmt.Sprintf("balance 0000000000000000000000000000000000000001 0->10 reason %d", tracing.BalanceChangeTransfer),
+		fmt.Sprintf("balance 0000000000000000000000000000000000000001 10->7 reason %d", tracing.BalanceDecreaseGasBuy),
+		"nonce 0000000000000000000000000000000000000001 0->1",
+		"code 0000000000000000000000000000000000000001 ->fe",
+		"storage 0000000000000000000000000000000000000001 0200000000000000000000000000000000000000000000000000000000000000 0->5",
+		"log 0000000000000000000000000000000000000001 0",
+		fmt.Sprintf("balance 0000000000000000000000000000000000000001 7->0 reason %d", tracing.BalanceDecreaseSelfdestruct),
+	}, hooks.events)
+
+	// the changes are not reported once the hooks are removed
+	state.SetHooks(nil)
+	state.SetNonce(addr, 2)
+	require.Len(t, hooks.events, 7)
+}
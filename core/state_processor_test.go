@@ -0,0 +1,45 @@
+package core_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/state"
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/params"
+)
+
+func TestApplySetCodeTransactionWithoutAuthorizations(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	ibs := state.New(state.NewDbStateReader(tx))
+	key, _ := crypto.GenerateKey()
+	ibs.AddBalance(crypto.PubkeyToAddress(key.PublicKey), uint256.NewInt(1e18), tracing.BalanceChangeUnspecified)
+	config := *params.AllProtocolChanges
+	config.PragueTime = big.NewInt(0)
+	var excessBlobGas uint64
+	header := &types.Header{Number: big.NewInt(1), Time: 1, GasLimit: 1_000_000, BaseFee: big.NewInt(1), Difficulty: big.NewInt(0), ExcessBlobGas: &excessBlobGas}
+
+	apply := func(auths []types.Authorization) error {
+		unsigned := types.NewSetCodeTransaction(*uint256.MustFromBig(config.ChainID), 0, libcommon.HexToAddress("0xaa"), uint256.NewInt(0), 100_000, uint256.NewInt(1), uint256.NewInt(10), nil, auths)
+		txn, err := types.SignTx(unsigned, *types.LatestSignerForChainID(config.ChainID), key)
+		require.NoError(t, err)
+		var usedGas, usedBlobGas uint64
+		_, _, err = core.ApplyTransaction(&config, nil, nil, &libcommon.Address{}, new(core.GasPool).AddGas(header.GasLimit), ibs, state.NewNoopWriter(), header, txn, &usedGas, &usedBlobGas, vm.Config{})
+		return err
+	}
+	require.ErrorIs(t, apply(nil), core.ErrEmptyAuthorizations)
+	require.ErrorIs(t, apply([]types.Authorization{}), core.ErrEmptyAuthorizations)
+	// an authorization which does not verify is skipped, the transaction itself is valid
+	require.NoError(t, apply([]types.Authorization{{ChainID: *uint256.NewInt(1), Address: libcommon.HexToAddress("0xbb")}}))
+}
@@ -0,0 +1,66 @@
+// Package tracing defines the hooks through which tracers are notified of the
+// state changes made while executing blocks and transactions.
+package tracing
+
+import (
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/core/types"
+)
+
+// StateHooks is implemented by tracers which want to observe the state changes
+// directly instead of re-reading the state and guessing what changed.
+//
+// The hooks are invoked by state.IntraBlockState right before a change is applied,
+// so the state still holds the previous values at that point. Changes which are
+// later reverted are reported as well, tracers should rely on the call frame
+// errors to tell them apart.
+type StateHooks interface {
+	OnBalanceChange(addr libcommon.Address, prev, new *uint256.Int, reason BalanceChangeReason)
+	OnNonceChange(addr libcommon.Address, prev, new uint64)
+	OnCodeChange(addr libcommon.Address, prevCodeHash libcommon.Hash, prevCode []byte, codeHash libcommon.Hash, code []byte)
+	OnStorageChange(addr libcommon.Address, slot *libcommon.Hash, prev, new uint256.Int)
+	OnLog(log *types.Log)
+}
+
+// BalanceChangeReason tells why the balance of an account changed.
+type BalanceChangeReason byte
+
+const (
+	BalanceChangeUnspecified BalanceChangeReason = iota
+
+	// BalanceIncreaseRewardMineUncle is a reward for mining an uncle block.
+	BalanceIncreaseRewardMineUncle
+	// BalanceIncreaseRewardMineBlock is a reward for producing a block.
+	BalanceIncreaseRewardMineBlock
+	// BalanceIncreaseWithdrawal is ether withdrawn from the beacon chain.
+	BalanceIncreaseWithdrawal
+	// BalanceIncreaseGenesisBalance is the balance allocated in the genesis block.
+	BalanceIncreaseGenesisBalance
+
+	// BalanceIncreaseRewardTransactionFee is the transaction tip paid to the block producer.
+	BalanceIncreaseRewardTransactionFee
+	// BalanceIncreaseBurntContract is the base fee credited to the burnt contract of chains having one.
+	BalanceIncreaseBurntContract
+	// BalanceDecreaseGasBuy is the gas (and blob gas) bought by the sender up front.
+	BalanceDecreaseGasBuy
+	// BalanceIncreaseGasReturn is the unused gas refunded to the sender.
+	BalanceIncreaseGasReturn
+
+	// BalanceIncreaseDaoContract is ether sent to the DAO refund contract.
+	BalanceIncreaseDaoContract
+	// BalanceDecreaseDaoAccount is ether taken from a DAO account.
+	BalanceDecreaseDaoAccount
+
+	// BalanceChangeTransfer is a value transfer of a transaction or a call.
+	BalanceChangeTransfer
+	// BalanceChangeTouchAccount is a zero transfer touching an account, see EIP-161.
+	BalanceChangeTouchAccount
+
+	// BalanceIncreaseSelfdestruct is the balance sent to the beneficiary of a selfdestruct.
+	BalanceIncreaseSelfdestruct
+	// BalanceDecreaseSelfdestruct is the balance taken from a selfdestructed account.
+	BalanceDecreaseSelfdestruct
+)
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+)
+
+// BlockAccessList is the state accessed by the transactions of a block: for every transaction,
+// the accounts and storage slots it read and the values it wrote.
+type BlockAccessList struct {
+	BlockHash    libcommon.Hash `json:"blockHash"`
+	BlockNumber  hexutil.Uint64 `json:"blockNumber"`
+	Transactions []TxAccessList `json:"transactions"`
+}
+
+// TxAccessList is the state accessed by a transaction, the accounts are sorted by address.
+type TxAccessList struct {
+	TxIndex  hexutil.Uint64  `json:"txIndex"`
+	TxHash   libcommon.Hash  `json:"txHash"`
+	Accounts []AccountAccess `json:"accounts"`
+}
+
+// AccountAccess is an account accessed by a transaction. An account which was only read
+// has no balance and nonce, the storage slots are sorted.
+type AccountAccess struct {
+	Address       libcommon.Address `json:"address"`
+	StorageReads  []libcommon.Hash  `json:"storageReads,omitempty"`  // slots read and not written
+	StorageWrites []StorageWrite    `json:"storageWrites,omitempty"` // slots written, with their values after the transaction
+	Balance       *hexutil.Big      `json:"balance,omitempty"`       // balance after the transaction, if the account was written
+	Nonce         *hexutil.Uint64   `json:"nonce,omitempty"`         // nonce after the transaction, if the account was written
+	Code          hexutility.Bytes  `json:"code,omitempty"`          // code deployed by the transaction
+	Deleted       bool              `json:"deleted,omitempty"`       // whether the account was destructed or removed as empty
+}
+
+type StorageWrite struct {
+	Slot  libcommon.Hash `json:"slot"`
+	Value libcommon.Hash `json:"value"`
+}
+
+// VerifyBlockAccessList checks that the access list computed for a block matches the expected one,
+// and returns the first difference otherwise.
+func VerifyBlockAccessList(computed, expected *BlockAccessList) error {
+	if len(computed.Transactions) != len(expected.Transactions) {
+		return fmt.Errorf("access list of %d transactions, expected %d", len(computed.Transactions), len(expected.Transactions))
+	}
+	for i := range computed.Transactions {
+		have, want := &computed.Transactions[i], &expected.Transactions[i]
+		if have.TxHash != want.TxHash {
+			return fmt.Errorf("transaction %d: hash %x, expected %x", i, have.TxHash, want.TxHash)
+		}
+		if len(have.Accounts) != len(want.Accounts) {
+			return fmt.Errorf("transaction %d: %d accounts accessed, expected %d", i, len(have.Accounts), len(want.Accounts))
+		}
+		for j := range have.Accounts {
+			if err := verifyAccountAccess(&have.Accounts[j], &want.Accounts[j]); err != nil {
+				return fmt.Errorf("transaction %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func verifyAccountAccess(have, want *AccountAccess) error {
+	if have.Address != want.Address {
+		return fmt.Errorf("account %x accessed, expected %x", have.Address, want.Address)
+	}
+	if len(have.StorageReads) != len(want.StorageReads) {
+		return fmt.Errorf("account %x: %d storage slots read, expected %d", have.Address, len(have.StorageReads), len(want.StorageReads))
+	}
+	for i := range have.StorageReads {
+		if have.StorageReads[i] != want.StorageReads[i] {
+			return fmt.Errorf("account %x: storage slot %x read, expected %x", have.Address, have.StorageReads[i], want.StorageReads[i])
+		}
+	}
+	if len(have.StorageWrites) != len(want.StorageWrites) {
+		return fmt.Errorf("account %x: %d storage slots written, expected %d", have.Address, len(have.StorageWrites), len(want.StorageWrites))
+	}
+	for i := range have.StorageWrites {
+		if have.StorageWrites[i] != want.StorageWrites[i] {
+			return fmt.Errorf("account %x: storage slot %x set to %x, expected slot %x set to %x", have.Address,
+				have.StorageWrites[i].Slot, have.StorageWrites[i].Value, want.StorageWrites[i].Slot, want.StorageWrites[i].Value)
+		}
+	}
+	switch {
+	case (have.Balance == nil) != (want.Balance == nil) || have.Balance != nil && have.Balance.ToInt().Cmp(want.Balance.ToInt()) != 0:
+		return fmt.Errorf("account %x: balance %v, expected %v", have.Address, have.Balance, want.Balance)
+	case (have.Nonce == nil) != (want.Nonce == nil) || have.Nonce != nil && *have.Nonce != *want.Nonce:
+		return fmt.Errorf("account %x: nonce %v, expected %v", have.Address, have.Nonce, want.Nonce)
+	case !bytes.Equal(have.Code, want.Code):
+		return fmt.Errorf("account %x: code %x, expected %x", have.Address, have.Code, want.Code)
+	case have.Deleted != want.Deleted:
+		return fmt.Errorf("account %x: deleted %t, expected %t", have.Address, have.Deleted, want.Deleted)
+	}
+	return nil
+}
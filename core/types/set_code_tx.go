@@ -0,0 +1,548 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/secp256k1"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	rlp2 "github.com/ledgerwatch/erigon-lib/rlp"
+	types2 "github.com/ledgerwatch/erigon-lib/types"
+
+	"github.com/ledgerwatch/erigon/common/u256"
+	"github.com/ledgerwatch/erigon/rlp"
+)
+
+// AuthorizationMagic prefixes the RLP of an authorization tuple when it is signed (EIP-7702).
+const AuthorizationMagic byte = 0x05
+
+// DelegationPrefix is the code prefix of an account delegating its code to another address (EIP-7702).
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// AddressToDelegation returns the delegation designator pointing to addr.
+func AddressToDelegation(addr libcommon.Address) []byte {
+	return append(libcommon.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// ParseDelegation returns the address the code delegates to, if the code is a delegation designator.
+func ParseDelegation(code []byte) (libcommon.Address, bool) {
+	if len(code) != len(DelegationPrefix)+20 || !bytes.HasPrefix(code, DelegationPrefix) {
+		return libcommon.Address{}, false
+	}
+	return libcommon.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// Authorization is a signed tuple of a set-code transaction, it delegates the code of its signer (the authority)
+// to Address.
+type Authorization struct {
+	ChainID uint256.Int
+	Address libcommon.Address
+	Nonce   uint64
+	YParity uint8
+	R, S    uint256.Int
+}
+
+type authorizationJSON struct {
+	ChainID hexutil.Big       `json:"chainId"`
+	Address libcommon.Address `json:"address"`
+	Nonce   hexutil.Uint64    `json:"nonce"`
+	YParity hexutil.Uint64    `json:"yParity"`
+	R       hexutil.Big       `json:"r"`
+	S       hexutil.Big       `json:"s"`
+}
+
+func (a Authorization) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&authorizationJSON{
+		ChainID: hexutil.Big(*a.ChainID.ToBig()),
+		Address: a.Address,
+		Nonce:   hexutil.Uint64(a.Nonce),
+		YParity: hexutil.Uint64(a.YParity),
+		R:       hexutil.Big(*a.R.ToBig()),
+		S:       hexutil.Big(*a.S.ToBig()),
+	})
+}
+
+func (a *Authorization) UnmarshalJSON(input []byte) error {
+	var dec authorizationJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.YParity > 255 {
+		return fmt.Errorf("'yParity' in authorization does not fit in 8 bits")
+	}
+	if a.ChainID.SetFromBig(dec.ChainID.ToInt()) {
+		return errors.New("'chainId' in authorization does not fit in 256 bits")
+	}
+	if a.R.SetFromBig(dec.R.ToInt()) {
+		return errors.New("'r' in authorization does not fit in 256 bits")
+	}
+	if a.S.SetFromBig(dec.S.ToInt()) {
+		return errors.New("'s' in authorization does not fit in 256 bits")
+	}
+	a.Address = dec.Address
+	a.Nonce = uint64(dec.Nonce)
+	a.YParity = uint8(dec.YParity)
+	return nil
+}
+
+// SigningHash is the hash signed by the authority: keccak256(MAGIC || rlp([chain_id, address, nonce])).
+func (a *Authorization) SigningHash() libcommon.Hash {
+	return prefixedRlpHash(AuthorizationMagic, []interface{}{&a.ChainID, a.Address, a.Nonce})
+}
+
+// Authority recovers the address which signed the authorization.
+func (a *Authorization) Authority() (libcommon.Address, error) {
+	var v uint256.Int
+	v.SetUint64(uint64(a.YParity))
+	v.Add(&v, u256.Num27)
+	return recoverPlain(secp256k1.DefaultContext, a.SigningHash(), &a.R, &a.S, &v, true)
+}
+
+func (a *Authorization) payloadSize() int {
+	// size of ChainID
+	size := 1 + rlp.Uint256LenExcludingHead(&a.ChainID)
+	// size of Address
+	size += 21
+	// size of Nonce
+	size += 1 + rlp.IntLenExcludingHead(a.Nonce)
+	// size of YParity
+	size += 1 + rlp.IntLenExcludingHead(uint64(a.YParity))
+	// size of R and S
+	size += 1 + rlp.Uint256LenExcludingHead(&a.R)
+	size += 1 + rlp.Uint256LenExcludingHead(&a.S)
+	return size
+}
+
+func (a Authorization) EncodeRLP(w io.Writer) error {
+	var b [33]byte
+	return a.encode(w, b[:])
+}
+
+func (a *Authorization) encode(w io.Writer, b []byte) error {
+	if err := EncodeStructSizePrefix(a.payloadSize(), w, b); err != nil {
+		return err
+	}
+	if err := a.ChainID.EncodeRLP(w); err != nil {
+		return err
+	}
+	b[0] = 128 + 20
+	if _, err := w.Write(b[:1]); err != nil {
+		return err
+	}
+	if _, err := w.Write(a.Address.Bytes()); err != nil {
+		return err
+	}
+	if err := rlp.EncodeInt(a.Nonce, w, b); err != nil {
+		return err
+	}
+	if err := rlp.EncodeInt(uint64(a.YParity), w, b); err != nil {
+		return err
+	}
+	if err := a.R.EncodeRLP(w); err != nil {
+		return err
+	}
+	return a.S.EncodeRLP(w)
+}
+
+func authorizationsSize(authorizations []Authorization) int {
+	var size int
+	for i := range authorizations {
+		payloadSize := authorizations[i].payloadSize()
+		size += rlp2.ListPrefixLen(payloadSize) + payloadSize
+	}
+	return size
+}
+
+func encodeAuthorizations(authorizations []Authorization, w io.Writer, b []byte) error {
+	for i := range authorizations {
+		if err := authorizations[i].encode(w, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeAuthorizations(authorizations *[]Authorization, s *rlp.Stream) error {
+	_, err := s.List()
+	if err != nil {
+		return fmt.Errorf("open authorizations: %w", err)
+	}
+	var b []byte
+	for _, err = s.List(); err == nil; _, err = s.List() {
+		var a Authorization
+		if b, err = s.Uint256Bytes(); err != nil {
+			return fmt.Errorf("read ChainID: %w", err)
+		}
+		a.ChainID.SetBytes(b)
+		if b, err = s.Bytes(); err != nil {
+			return fmt.Errorf("read Address: %w", err)
+		}
+		if len(b) != 20 {
+			return fmt.Errorf("wrong size for authorization address: %d", len(b))
+		}
+		copy(a.Address[:], b)
+		if a.Nonce, err = s.Uint(); err != nil {
+			return fmt.Errorf("read Nonce: %w", err)
+		}
+		var yParity uint64
+		if yParity, err = s.Uint(); err != nil {
+			return fmt.Errorf("read YParity: %w", err)
+		}
+		if yParity > 255 {
+			return fmt.Errorf("wrong size for authorization y parity: %d", yParity)
+		}
+		a.YParity = uint8(yParity)
+		if b, err = s.Uint256Bytes(); err != nil {
+			return fmt.Errorf("read R: %w", err)
+		}
+		a.R.SetBytes(b)
+		if b, err = s.Uint256Bytes(); err != nil {
+			return fmt.Errorf("read S: %w", err)
+		}
+		a.S.SetBytes(b)
+		if err = s.ListEnd(); err != nil {
+			return fmt.Errorf("close authorization: %w", err)
+		}
+		*authorizations = append(*authorizations, a)
+	}
+	if !errors.Is(err, rlp.EOL) {
+		return fmt.Errorf("open authorization: %w", err)
+	}
+	if err = s.ListEnd(); err != nil {
+		return fmt.Errorf("close authorizations: %w", err)
+	}
+	return nil
+}
+
+// SetCodeTransaction is an EIP-7702 transaction, it sets the code of the signers of its authorizations
+// to delegation designators before executing as a dynamic fee transaction.
+type SetCodeTransaction struct {
+	DynamicFeeTransaction
+	Authorizations []Authorization
+}
+
+// copy creates a deep copy of the transaction data and initializes all fields.
+func (tx SetCodeTransaction) copy() *SetCodeTransaction {
+	cpy := &SetCodeTransaction{
+		DynamicFeeTransaction: *tx.DynamicFeeTransaction.copy(),
+		Authorizations:        make([]Authorization, len(tx.Authorizations)),
+	}
+	copy(cpy.Authorizations, tx.Authorizations)
+	return cpy
+}
+
+func (tx SetCodeTransaction) Type() byte { return SetCodeTxType }
+
+func (tx *SetCodeTransaction) Unwrap() Transaction {
+	return tx
+}
+
+func (tx SetCodeTransaction) GetAuthorizations() []Authorization {
+	return tx.Authorizations
+}
+
+func (tx SetCodeTransaction) AsMessage(s Signer, baseFee *big.Int, rules *chain.Rules) (Message, error) {
+	msg := Message{
+		nonce:          tx.Nonce,
+		gasLimit:       tx.Gas,
+		gasPrice:       *tx.FeeCap,
+		tip:            *tx.Tip,
+		feeCap:         *tx.FeeCap,
+		to:             tx.To,
+		amount:         *tx.Value,
+		data:           tx.Data,
+		accessList:     tx.AccessList,
+		authorizations: tx.Authorizations,
+		checkNonce:     true,
+	}
+	if !rules.IsPrague {
+		return msg, errors.New("set code transactions require Prague")
+	}
+	if len(tx.Authorizations) == 0 {
+		return msg, ErrEmptyAuthorizations
+	}
+	if baseFee != nil {
+		overflow := msg.gasPrice.SetFromBig(baseFee)
+		if overflow {
+			return msg, fmt.Errorf("gasPrice higher than 2^256-1")
+		}
+	}
+	msg.gasPrice.Add(&msg.gasPrice, tx.Tip)
+	if msg.gasPrice.Gt(tx.FeeCap) {
+		msg.gasPrice.Set(tx.FeeCap)
+	}
+
+	var err error
+	msg.from, err = tx.Sender(s)
+	return msg, err
+}
+
+func (tx *SetCodeTransaction) WithSignature(signer Signer, sig []byte) (Transaction, error) {
+	cpy := tx.copy()
+	r, s, v, err := signer.SignatureValues(tx, sig)
+	if err != nil {
+		return nil, err
+	}
+	cpy.R.Set(r)
+	cpy.S.Set(s)
+	cpy.V.Set(v)
+	cpy.ChainID = signer.ChainID()
+	return cpy, nil
+}
+
+func (tx *SetCodeTransaction) FakeSign(address libcommon.Address) (Transaction, error) {
+	cpy := tx.copy()
+	cpy.R.Set(u256.Num1)
+	cpy.S.Set(u256.Num1)
+	cpy.V.Set(u256.Num4)
+	cpy.from.Store(address)
+	return cpy, nil
+}
+
+func (tx *SetCodeTransaction) Sender(signer Signer) (libcommon.Address, error) {
+	if sc := tx.from.Load(); sc != nil {
+		return sc.(libcommon.Address), nil
+	}
+	addr, err := signer.Sender(tx)
+	if err != nil {
+		return libcommon.Address{}, err
+	}
+	tx.from.Store(addr)
+	return addr, nil
+}
+
+func (tx *SetCodeTransaction) Hash() libcommon.Hash {
+	if hash := tx.hash.Load(); hash != nil {
+		return *hash.(*libcommon.Hash)
+	}
+	hash := prefixedRlpHash(SetCodeTxType, []interface{}{
+		tx.ChainID,
+		tx.Nonce,
+		tx.Tip,
+		tx.FeeCap,
+		tx.Gas,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.AccessList,
+		tx.Authorizations,
+		tx.V, tx.R, tx.S,
+	})
+	tx.hash.Store(&hash)
+	return hash
+}
+
+func (tx SetCodeTransaction) SigningHash(chainID *big.Int) libcommon.Hash {
+	return prefixedRlpHash(
+		SetCodeTxType,
+		[]interface{}{
+			chainID,
+			tx.Nonce,
+			tx.Tip,
+			tx.FeeCap,
+			tx.Gas,
+			tx.To,
+			tx.Value,
+			tx.Data,
+			tx.AccessList,
+			tx.Authorizations,
+		})
+}
+
+func (tx SetCodeTransaction) EncodingSize() int {
+	payloadSize, _, _, _, _ := tx.payloadSize()
+	// Add envelope size and type size
+	return 1 + rlp2.ListPrefixLen(payloadSize) + payloadSize
+}
+
+func (tx SetCodeTransaction) payloadSize() (payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen int) {
+	payloadSize, nonceLen, gasLen, accessListLen = tx.DynamicFeeTransaction.payloadSize()
+	// size of Authorizations
+	authorizationsLen = authorizationsSize(tx.Authorizations)
+	payloadSize += rlp2.ListPrefixLen(authorizationsLen) + authorizationsLen
+	return
+}
+
+func (tx SetCodeTransaction) encodePayload(w io.Writer, b []byte, payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen int) error {
+	// prefix
+	if err := EncodeStructSizePrefix(payloadSize, w, b); err != nil {
+		return err
+	}
+	// encode ChainID
+	if err := tx.ChainID.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode Nonce
+	if err := rlp.EncodeInt(tx.Nonce, w, b); err != nil {
+		return err
+	}
+	// encode MaxPriorityFeePerGas
+	if err := tx.Tip.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode MaxFeePerGas
+	if err := tx.FeeCap.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode Gas
+	if err := rlp.EncodeInt(tx.Gas, w, b); err != nil {
+		return err
+	}
+	// encode To
+	if tx.To == nil {
+		return errors.New("set code transaction must have a destination")
+	}
+	b[0] = 128 + 20
+	if _, err := w.Write(b[:1]); err != nil {
+		return err
+	}
+	if _, err := w.Write(tx.To.Bytes()); err != nil {
+		return err
+	}
+	// encode Value
+	if err := tx.Value.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode Data
+	if err := rlp.EncodeString(tx.Data, w, b); err != nil {
+		return err
+	}
+	// prefix
+	if err := EncodeStructSizePrefix(accessListLen, w, b); err != nil {
+		return err
+	}
+	// encode AccessList
+	if err := encodeAccessList(tx.AccessList, w, b); err != nil {
+		return err
+	}
+	// prefix
+	if err := EncodeStructSizePrefix(authorizationsLen, w, b); err != nil {
+		return err
+	}
+	// encode Authorizations
+	if err := encodeAuthorizations(tx.Authorizations, w, b); err != nil {
+		return err
+	}
+	// encode y_parity
+	if err := tx.V.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode R
+	if err := tx.R.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode S
+	if err := tx.S.EncodeRLP(w); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx SetCodeTransaction) EncodeRLP(w io.Writer) error {
+	payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen := tx.payloadSize()
+	// size of struct prefix and TxType
+	envelopeSize := 1 + rlp2.ListPrefixLen(payloadSize) + payloadSize
+	var b [33]byte
+	// envelope
+	if err := rlp.EncodeStringSizePrefix(envelopeSize, w, b[:]); err != nil {
+		return err
+	}
+	// encode TxType
+	b[0] = SetCodeTxType
+	if _, err := w.Write(b[:1]); err != nil {
+		return err
+	}
+	return tx.encodePayload(w, b[:], payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen)
+}
+
+func (tx SetCodeTransaction) MarshalBinary(w io.Writer) error {
+	payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen := tx.payloadSize()
+	var b [33]byte
+	// encode TxType
+	b[0] = SetCodeTxType
+	if _, err := w.Write(b[:1]); err != nil {
+		return err
+	}
+	return tx.encodePayload(w, b[:], payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen)
+}
+
+func (tx *SetCodeTransaction) DecodeRLP(s *rlp.Stream) error {
+	_, err := s.List()
+	if err != nil {
+		return err
+	}
+	var b []byte
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.ChainID = new(uint256.Int).SetBytes(b)
+	if tx.Nonce, err = s.Uint(); err != nil {
+		return err
+	}
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.Tip = new(uint256.Int).SetBytes(b)
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.FeeCap = new(uint256.Int).SetBytes(b)
+	if tx.Gas, err = s.Uint(); err != nil {
+		return err
+	}
+	if b, err = s.Bytes(); err != nil {
+		return err
+	}
+	if len(b) != 20 {
+		return fmt.Errorf("wrong size for To: %d", len(b))
+	}
+	tx.To = &libcommon.Address{}
+	copy((*tx.To)[:], b)
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.Value = new(uint256.Int).SetBytes(b)
+	if tx.Data, err = s.Bytes(); err != nil {
+		return err
+	}
+	// decode AccessList
+	tx.AccessList = types2.AccessList{}
+	if err = decodeAccessList(&tx.AccessList, s); err != nil {
+		return err
+	}
+	// decode Authorizations
+	tx.Authorizations = []Authorization{}
+	if err = decodeAuthorizations(&tx.Authorizations, s); err != nil {
+		return err
+	}
+	// decode V
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.V.SetBytes(b)
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.R.SetBytes(b)
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.S.SetBytes(b)
+	return s.ListEnd()
+}
+
+// NewSetCodeTransaction creates an unsigned set code transaction.
+func NewSetCodeTransaction(chainID uint256.Int, nonce uint64, to libcommon.Address, amount *uint256.Int, gasLimit uint64, gasTip *uint256.Int, gasFeeCap *uint256.Int, data []byte, authorizations []Authorization) *SetCodeTransaction {
+	return &SetCodeTransaction{
+		DynamicFeeTransaction: *NewEIP1559Transaction(chainID, nonce, to, amount, gasLimit, nil, gasTip, gasFeeCap, data),
+		Authorizations:        authorizations,
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/crypto"
+)
+
+func signAuthorization(t *testing.T, auth Authorization, key []byte) Authorization {
+	t.Helper()
+	prv, err := crypto.ToECDSA(key)
+	require.NoError(t, err)
+	hash := auth.SigningHash()
+	sig, err := crypto.Sign(hash[:], prv)
+	require.NoError(t, err)
+	auth.R.SetBytes(sig[:32])
+	auth.S.SetBytes(sig[32:64])
+	auth.YParity = sig[64]
+	return auth
+}
+
+func TestSetCodeTxEncodeDecode(t *testing.T) {
+	authorityKey, _ := crypto.GenerateKey()
+	senderKey, _ := crypto.GenerateKey()
+	target := libcommon.HexToAddress("0x000000000000000000000000000000000000aaaa")
+
+	auth := signAuthorization(t, Authorization{ChainID: *uint256.NewInt(1), Address: target, Nonce: 3}, crypto.FromECDSA(authorityKey))
+	authority, err := auth.Authority()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(authorityKey.PublicKey), authority)
+
+	to := libcommon.HexToAddress("0x000000000000000000000000000000000000bbbb")
+	unsigned := NewSetCodeTransaction(*uint256.NewInt(1), 7, to, uint256.NewInt(10), 100_000, uint256.NewInt(1), uint256.NewInt(2), []byte{1, 2, 3}, []Authorization{auth})
+	signer := LatestSignerForChainID(big.NewInt(1))
+	txn, err := SignTx(unsigned, *signer, senderKey)
+	require.NoError(t, err)
+	sender, err := txn.Sender(*signer)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(senderKey.PublicKey), sender)
+
+	for _, codec := range []func(Transaction) (Transaction, error){encodeDecodeBinary, encodeDecodeJSON} {
+		decoded, err := codec(txn)
+		require.NoError(t, err)
+		require.NoError(t, assertEqual(txn, decoded))
+		require.Equal(t, txn.(*SetCodeTransaction).Authorizations, decoded.(*SetCodeTransaction).Authorizations)
+	}
+
+	// a set code transaction cannot create a contract
+	var buf bytes.Buffer
+	txn.(*SetCodeTransaction).To = nil
+	require.Error(t, txn.MarshalBinary(&buf))
+}
+
+func TestParseDelegation(t *testing.T) {
+	addr := libcommon.HexToAddress("0x000000000000000000000000000000000000aaaa")
+	parsed, ok := ParseDelegation(AddressToDelegation(addr))
+	require.True(t, ok)
+	require.Equal(t, addr, parsed)
+
+	_, ok = ParseDelegation(append(AddressToDelegation(addr), 0))
+	require.False(t, ok)
+	_, ok = ParseDelegation([]byte{0xef, 0x00, 0x00})
+	require.False(t, ok)
+}
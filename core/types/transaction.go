@@ -45,6 +45,7 @@ var (
 	ErrUnexpectedProtection = errors.New("transaction type does not supported EIP-155 protected signatures")
 	ErrInvalidTxType        = errors.New("transaction type not valid in this context")
 	ErrTxTypeNotSupported   = errors.New("transaction type not supported")
+	ErrEmptyAuthorizations  = errors.New("set code transaction with an empty authorization list")
 )
 
 // Transaction types.
@@ -53,6 +54,7 @@ const (
 	AccessListTxType
 	DynamicFeeTxType
 	BlobTxType
+	SetCodeTxType
 )
 
 // Transaction is an Ethereum transaction.
@@ -206,6 +208,13 @@ func UnmarshalTransactionFromBinary(data []byte) (Transaction, error) {
 			return nil, err
 		}
 		return t, nil
+	case SetCodeTxType:
+		s := rlp.NewStream(bytes.NewReader(data[1:]), uint64(len(data)-1))
+		t := &SetCodeTransaction{}
+		if err := t.DecodeRLP(s); err != nil {
+			return nil, err
+		}
+		return t, nil
 	default:
 		if data[0] >= 0x80 {
 			// Tx is type legacy which is RLP encoded
@@ -527,6 +536,7 @@ type Message struct {
 	checkNonce       bool
 	isFree           bool
 	blobHashes       []libcommon.Hash
+	authorizations   []Authorization
 }
 
 func NewMessage(from libcommon.Address, to *libcommon.Address, nonce uint64, amount *uint256.Int, gasLimit uint64,
@@ -570,6 +580,9 @@ func (m Message) Nonce() uint64                 { return m.nonce }
 func (m Message) Data() []byte                  { return m.data }
 func (m Message) AccessList() types2.AccessList { return m.accessList }
 func (m Message) CheckNonce() bool              { return m.checkNonce }
+func (m *Message) SetNonce(nonce uint64) {
+	m.nonce = nonce
+}
 func (m *Message) SetCheckNonce(checkNonce bool) {
 	m.checkNonce = checkNonce
 }
@@ -602,6 +615,8 @@ func (m Message) MaxFeePerBlobGas() *uint256.Int {
 
 func (m Message) BlobHashes() []libcommon.Hash { return m.blobHashes }
 
+func (m Message) Authorizations() []Authorization { return m.authorizations }
+
 func DecodeSSZ(data []byte, dest codec.Deserializable) error {
 	err := dest.Deserialize(codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data))))
 	return err
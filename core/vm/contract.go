@@ -58,6 +58,17 @@ type Contract struct {
 
 	Gas   uint64
 	value *uint256.Int
+
+	// EOF code, Code is the code section being executed
+	container   *Container
+	codeSection int
+	returnStack []returnContext
+}
+
+// returnContext is pushed to the return stack by CALLF and popped by RETF.
+type returnContext struct {
+	section int
+	pc      uint64
 }
 
 // NewContract returns a new contract environment for the execution of EVM.
@@ -192,6 +203,24 @@ func (c *Contract) SetCallCode(addr *libcommon.Address, hash libcommon.Hash, cod
 	c.CodeAddr = addr
 }
 
+// setContainer sets the EOF container of the contract, the execution starts
+// at the first code section
+func (c *Contract) setContainer(container *Container) {
+	c.container = container
+	c.setCodeSection(0)
+}
+
+// setCodeSection switches the execution to the given code section of the EOF container
+func (c *Contract) setCodeSection(section int) {
+	c.codeSection = section
+	c.Code = c.container.codeSections[section]
+}
+
+// IsEOF returns whether the contract runs EOF code
+func (c *Contract) IsEOF() bool {
+	return c.container != nil
+}
+
 // SetCodeOptionalHash can be used to provide code, but it's optional to provide hash.
 // In case hash is not provided, the jumpdest analysis will not be saved to the parent context
 func (c *Contract) SetCodeOptionalHash(addr *libcommon.Address, codeAndHash *codeAndHash) {
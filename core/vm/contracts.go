@@ -168,6 +168,29 @@ func ActivePrecompiles(rules *chain.Rules) []libcommon.Address {
 	}
 }
 
+// ActivePrecompiledContracts returns a copy of the precompiled contracts enabled with the current configuration.
+// The returned map may be modified by the caller, e.g. to relocate precompiles for call simulation.
+func ActivePrecompiledContracts(rules *chain.Rules) map[libcommon.Address]PrecompiledContract {
+	var precompiles map[libcommon.Address]PrecompiledContract
+	switch {
+	case rules.IsCancun:
+		precompiles = PrecompiledContractsCancun
+	case rules.IsBerlin:
+		precompiles = PrecompiledContractsBerlin
+	case rules.IsIstanbul:
+		precompiles = PrecompiledContractsIstanbul
+	case rules.IsByzantium:
+		precompiles = PrecompiledContractsByzantium
+	default:
+		precompiles = PrecompiledContractsHomestead
+	}
+	cpy := make(map[libcommon.Address]PrecompiledContract, len(precompiles))
+	for addr, p := range precompiles {
+		cpy[addr] = p
+	}
+	return cpy
+}
+
 // RunPrecompiledContract runs and evaluates the output of a precompiled contract.
 // It returns
 // - the returned bytes,
@@ -25,10 +25,13 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
 	"github.com/ledgerwatch/erigon/consensus/misc"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/params"
 )
 
 var activators = map[int]func(*JumpTable){
+	7702: enable7702,
 	7516: enable7516,
 	6780: enable6780,
 	5656: enable5656,
@@ -327,3 +330,67 @@ func enable7516(jt *JumpTable) {
 		numPush:     1,
 	}
 }
+
+// enable7702 applies EIP-7702 (Set EOA account code)
+// - The EXTCODE* opcodes and the calls follow the delegation designator of an account
+// - The calls charge the access of the delegation target
+func enable7702(jt *JumpTable) {
+	jt[EXTCODESIZE].execute = opExtCodeSize7702
+	jt[EXTCODECOPY].execute = opExtCodeCopy7702
+	jt[EXTCODEHASH].execute = opExtCodeHash7702
+
+	jt[CALL].dynamicGas = gasCallEIP7702
+	jt[CALLCODE].dynamicGas = gasCallCodeEIP7702
+	jt[STATICCALL].dynamicGas = gasStaticCallEIP7702
+	jt[DELEGATECALL].dynamicGas = gasDelegateCallEIP7702
+}
+
+func opExtCodeSize7702(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	slot := scope.Stack.Peek()
+	addr := libcommon.Address(slot.Bytes20())
+	_, code := interpreter.evm.resolveDelegation(addr, interpreter.evm.IntraBlockState().GetCode(addr))
+	if interpreter.evm.chainRules.IsOsaka && HasEOFMagic(code) {
+		// Legacy code sees the code of EOF contracts as the magic only
+		code = eofMagic
+	}
+	slot.SetUint64(uint64(len(code)))
+	return nil, nil
+}
+
+func opExtCodeCopy7702(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		stack      = scope.Stack
+		a          = stack.Pop()
+		memOffset  = stack.Pop()
+		codeOffset = stack.Pop()
+		length     = stack.Pop()
+	)
+	addr := libcommon.Address(a.Bytes20())
+	_, code := interpreter.evm.resolveDelegation(addr, interpreter.evm.IntraBlockState().GetCode(addr))
+	if interpreter.evm.chainRules.IsOsaka && HasEOFMagic(code) {
+		code = eofMagic
+	}
+	len64 := length.Uint64()
+	codeCopy := getDataBig(code, &codeOffset, len64)
+	scope.Memory.Set(memOffset.Uint64(), len64, codeCopy)
+	return nil, nil
+}
+
+func opExtCodeHash7702(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	slot := scope.Stack.Peek()
+	address := libcommon.Address(slot.Bytes20())
+	ibs := interpreter.evm.IntraBlockState()
+	if ibs.Empty(address) {
+		slot.Clear()
+		return nil, nil
+	}
+	if target, ok := types.ParseDelegation(ibs.GetCode(address)); ok {
+		address = target
+	}
+	if interpreter.evm.chainRules.IsOsaka && HasEOFMagic(ibs.GetCode(address)) {
+		slot.SetBytes(crypto.Keccak256(eofMagic))
+		return nil, nil
+	}
+	slot.SetBytes(ibs.GetCodeHash(address).Bytes())
+	return nil, nil
+}
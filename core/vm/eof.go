@@ -0,0 +1,379 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// EOF container layout, see EIP-3540 (container), EIP-4750 (types section)
+// and EIP-7620 (container sections):
+//
+//	magic version
+//	kind_types types_size
+//	kind_code num_code_sections code_size+
+//	[kind_container num_container_sections container_size+]
+//	kind_data data_size
+//	terminator
+//	types code+ container* data
+const (
+	eofFormatByte = 0xef
+	eof1Version   = 1
+
+	kindTypes     = 1
+	kindCode      = 2
+	kindContainer = 3
+	kindData      = 0xff
+
+	sectionTerminator = 0
+
+	typeSectionEntrySize = 4
+	nonReturningFunction = 0x80
+
+	maxInputItems        = 127
+	maxOutputItems       = 128
+	maxStackHeight       = 1023
+	maxCodeSections      = 1024
+	maxContainerSections = 256
+	maxDataSize          = 0xffff
+)
+
+var eofMagic = []byte{eofFormatByte, 0x00}
+
+var (
+	ErrIncompleteEOF           = errors.New("incomplete EOF code")
+	ErrInvalidMagic            = errors.New("invalid magic")
+	ErrInvalidVersion          = errors.New("invalid version")
+	ErrMissingTypeHeader       = errors.New("missing type header")
+	ErrInvalidTypeSize         = errors.New("invalid type section size")
+	ErrMissingCodeHeader       = errors.New("missing code header")
+	ErrInvalidCodeHeader       = errors.New("invalid code header")
+	ErrInvalidCodeSize         = errors.New("invalid code size")
+	ErrInvalidContainerHeader  = errors.New("invalid container section header")
+	ErrMissingDataHeader       = errors.New("missing data header")
+	ErrMissingTerminator       = errors.New("missing header terminator")
+	ErrTooManyInputs           = errors.New("invalid type content, too many inputs")
+	ErrTooManyOutputs          = errors.New("invalid type content, too many outputs")
+	ErrInvalidFirstSectionType = errors.New("invalid section 0 type, input and output should be zero and non-returning (0x80)")
+	ErrTooLargeMaxStackHeight  = errors.New("invalid type content, max stack height exceeds limit")
+	ErrInvalidContainerSize    = errors.New("invalid container size")
+)
+
+// HasEOFMagic returns whether code starts with the EOF magic.
+func HasEOFMagic(code []byte) bool {
+	return len(code) >= len(eofMagic) && bytes.Equal(eofMagic, code[:len(eofMagic)])
+}
+
+// functionMetadata is an entry of the types section, it describes the stack
+// of a code section.
+type functionMetadata struct {
+	inputs         uint8
+	outputs        uint8
+	maxStackHeight uint16
+}
+
+// Container is an EOF container object.
+type Container struct {
+	types         []functionMetadata
+	codeSections  [][]byte
+	subContainers []*Container
+	// rawSubContainers keeps the encoding of the subcontainers, it is the initcode of EOFCREATE.
+	rawSubContainers [][]byte
+	data             []byte
+	// dataSize is the size of the data section declared in the header. Before the deployment
+	// the data can be shorter, the rest is appended by RETURNCONTRACT.
+	dataSize int
+}
+
+// NumCodeSections returns the number of code sections of the container.
+func (c *Container) NumCodeSections() int {
+	return len(c.codeSections)
+}
+
+// NumSubContainers returns the number of subcontainers of the container.
+func (c *Container) NumSubContainers() int {
+	return len(c.subContainers)
+}
+
+// CodeSection returns the code of the i'th section.
+func (c *Container) CodeSection(i int) []byte {
+	return c.codeSections[i]
+}
+
+// Data returns the data section of the container.
+func (c *Container) Data() []byte {
+	return c.data
+}
+
+// isTruncated returns whether the data section is shorter than declared in the header.
+func (c *Container) isTruncated() bool {
+	return len(c.data) < c.dataSize
+}
+
+// ParseContainer decodes an EOF container. The data section of the container is
+// allowed to be truncated, Container.Validate checks where this is permitted.
+func ParseContainer(b []byte) (*Container, error) {
+	c := &Container{}
+	if err := c.unmarshal(b, false /* allowTrailing */); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ParseInitcodeContainer decodes the EOF initcode of a creation transaction, it
+// returns the container and the calldata which follows it.
+func ParseInitcodeContainer(b []byte) (*Container, []byte, error) {
+	c := &Container{}
+	if err := c.unmarshal(b, true /* allowTrailing */); err != nil {
+		return nil, nil, err
+	}
+	if c.isTruncated() {
+		return nil, nil, ErrInvalidContainerSize
+	}
+	return c, b[c.size():], nil
+}
+
+// size returns the length of the encoding of the container.
+func (c *Container) size() int {
+	n := c.headerSize() + len(c.types)*typeSectionEntrySize + len(c.data)
+	for _, code := range c.codeSections {
+		n += len(code)
+	}
+	for _, sub := range c.rawSubContainers {
+		n += len(sub)
+	}
+	return n
+}
+
+func (c *Container) headerSize() int {
+	n := len(eofMagic) + 1 + 3 + 3 + 2*len(c.codeSections) + 3 + 1
+	if len(c.subContainers) > 0 {
+		n += 3 + 2*len(c.subContainers)
+	}
+	return n
+}
+
+// MarshalBinary encodes the container.
+func (c *Container) MarshalBinary() []byte {
+	b := make([]byte, 0, c.size())
+	b = append(b, eofMagic...)
+	b = append(b, eof1Version)
+	b = append(b, kindTypes)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(c.types)*typeSectionEntrySize))
+	b = append(b, kindCode)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(c.codeSections)))
+	for _, code := range c.codeSections {
+		b = binary.BigEndian.AppendUint16(b, uint16(len(code)))
+	}
+	if len(c.subContainers) > 0 {
+		b = append(b, kindContainer)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(c.subContainers)))
+		for _, sub := range c.rawSubContainers {
+			b = binary.BigEndian.AppendUint16(b, uint16(len(sub)))
+		}
+	}
+	b = append(b, kindData)
+	b = binary.BigEndian.AppendUint16(b, uint16(c.dataSize))
+	b = append(b, sectionTerminator)
+	for _, typ := range c.types {
+		b = append(b, typ.inputs, typ.outputs)
+		b = binary.BigEndian.AppendUint16(b, typ.maxStackHeight)
+	}
+	for _, code := range c.codeSections {
+		b = append(b, code...)
+	}
+	for _, sub := range c.rawSubContainers {
+		b = append(b, sub...)
+	}
+	return append(b, c.data...)
+}
+
+// withAuxData returns a copy of the container with aux appended to its data section.
+func (c *Container) withAuxData(aux []byte) (*Container, error) {
+	data := make([]byte, 0, len(c.data)+len(aux))
+	data = append(append(data, c.data...), aux...)
+	if len(data) > maxDataSize {
+		return nil, ErrInvalidContainerSize
+	}
+	if len(data) < c.dataSize {
+		return nil, ErrInvalidContainerSize
+	}
+	cpy := *c
+	cpy.data = data
+	cpy.dataSize = len(data)
+	return &cpy, nil
+}
+
+func (c *Container) unmarshal(b []byte, allowTrailing bool) error {
+	if !HasEOFMagic(b) {
+		return ErrInvalidMagic
+	}
+	if len(b) < len(eofMagic)+1 {
+		return ErrIncompleteEOF
+	}
+	if b[len(eofMagic)] != eof1Version {
+		return ErrInvalidVersion
+	}
+	offset := len(eofMagic) + 1
+
+	// Parse the types section header.
+	kind, typesSize, err := parseSection(b, offset)
+	if err != nil {
+		return err
+	}
+	if kind != kindTypes {
+		return ErrMissingTypeHeader
+	}
+	if typesSize < typeSectionEntrySize || typesSize%typeSectionEntrySize != 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidTypeSize, typesSize)
+	}
+	offset += 3
+
+	// Parse the code section header.
+	kind, codeSizes, err := parseSectionList(b, offset)
+	if err != nil {
+		return err
+	}
+	if kind != kindCode {
+		return ErrMissingCodeHeader
+	}
+	if len(codeSizes) == 0 || len(codeSizes) > maxCodeSections {
+		return fmt.Errorf("%w: %d code sections", ErrInvalidCodeHeader, len(codeSizes))
+	}
+	if len(codeSizes) != typesSize/typeSectionEntrySize {
+		return fmt.Errorf("%w: %d code sections but %d types", ErrInvalidCodeHeader, len(codeSizes), typesSize/typeSectionEntrySize)
+	}
+	offset += 3 + 2*len(codeSizes)
+
+	// Parse the optional container section header.
+	var containerSizes []int
+	if offset < len(b) && b[offset] == kindContainer {
+		_, containerSizes, err = parseSectionList(b, offset)
+		if err != nil {
+			return err
+		}
+		if len(containerSizes) == 0 || len(containerSizes) > maxContainerSections {
+			return fmt.Errorf("%w: %d container sections", ErrInvalidContainerHeader, len(containerSizes))
+		}
+		offset += 3 + 2*len(containerSizes)
+	}
+
+	// Parse the data section header.
+	kind, dataSize, err := parseSection(b, offset)
+	if err != nil {
+		return err
+	}
+	if kind != kindData {
+		return ErrMissingDataHeader
+	}
+	offset += 3
+
+	if offset >= len(b) {
+		return ErrIncompleteEOF
+	}
+	if b[offset] != sectionTerminator {
+		return ErrMissingTerminator
+	}
+	offset++
+
+	// Check the size of the body, only the data section may be truncated.
+	bodySize := typesSize + dataSize
+	for _, size := range codeSizes {
+		bodySize += size
+	}
+	for _, size := range containerSizes {
+		bodySize += size
+	}
+	if len(b)-offset < bodySize-dataSize {
+		return fmt.Errorf("%w: body is shorter than declared in the header", ErrInvalidContainerSize)
+	}
+	if !allowTrailing && len(b)-offset > bodySize {
+		return fmt.Errorf("%w: trailing bytes after the data section", ErrInvalidContainerSize)
+	}
+
+	// Parse the types section.
+	types := make([]functionMetadata, 0, typesSize/typeSectionEntrySize)
+	for i := 0; i < typesSize/typeSectionEntrySize; i++ {
+		typ := functionMetadata{
+			inputs:         b[offset],
+			outputs:        b[offset+1],
+			maxStackHeight: binary.BigEndian.Uint16(b[offset+2:]),
+		}
+		if typ.inputs > maxInputItems {
+			return fmt.Errorf("%w: section %d has %d inputs", ErrTooManyInputs, i, typ.inputs)
+		}
+		if typ.outputs > maxOutputItems {
+			return fmt.Errorf("%w: section %d has %d outputs", ErrTooManyOutputs, i, typ.outputs)
+		}
+		if typ.maxStackHeight > maxStackHeight {
+			return fmt.Errorf("%w: section %d has %d", ErrTooLargeMaxStackHeight, i, typ.maxStackHeight)
+		}
+		types = append(types, typ)
+		offset += typeSectionEntrySize
+	}
+	if types[0].inputs != 0 || types[0].outputs != nonReturningFunction {
+		return ErrInvalidFirstSectionType
+	}
+	c.types = types
+
+	// Parse the code sections.
+	c.codeSections = make([][]byte, len(codeSizes))
+	for i, size := range codeSizes {
+		c.codeSections[i] = b[offset : offset+size]
+		offset += size
+	}
+
+	// Parse the subcontainers.
+	if len(containerSizes) > 0 {
+		c.subContainers = make([]*Container, len(containerSizes))
+		c.rawSubContainers = make([][]byte, len(containerSizes))
+		for i, size := range containerSizes {
+			sub := &Container{}
+			if err := sub.unmarshal(b[offset:offset+size], false /* allowTrailing */); err != nil {
+				return fmt.Errorf("subcontainer %d: %w", i, err)
+			}
+			c.subContainers[i] = sub
+			c.rawSubContainers[i] = b[offset : offset+size]
+			offset += size
+		}
+	}
+
+	// Parse the data section.
+	end := offset + dataSize
+	if end > len(b) {
+		end = len(b)
+	}
+	c.data = b[offset:end]
+	c.dataSize = dataSize
+	return nil
+}
+
+// parseSection decodes a section header of the form kind size.
+func parseSection(b []byte, offset int) (kind byte, size int, err error) {
+	if offset+3 > len(b) {
+		return 0, 0, ErrIncompleteEOF
+	}
+	return b[offset], int(binary.BigEndian.Uint16(b[offset+1:])), nil
+}
+
+// parseSectionList decodes a section header of the form kind count size+.
+func parseSectionList(b []byte, offset int) (kind byte, sizes []int, err error) {
+	kind, count, err := parseSection(b, offset)
+	if err != nil {
+		return 0, nil, err
+	}
+	offset += 3
+	if offset+2*count > len(b) {
+		return 0, nil, ErrIncompleteEOF
+	}
+	sizes = make([]int, count)
+	for i := range sizes {
+		size := int(binary.BigEndian.Uint16(b[offset+2*i:]))
+		if size == 0 {
+			return 0, nil, fmt.Errorf("%w: section %d is empty", ErrInvalidCodeSize, i)
+		}
+		sizes[i] = size
+	}
+	return kind, sizes, nil
+}
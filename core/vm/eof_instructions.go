@@ -0,0 +1,476 @@
+package vm
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/holiman/uint256"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/math"
+
+	"github.com/ledgerwatch/erigon/core/vm/stack"
+	"github.com/ledgerwatch/erigon/params"
+)
+
+// ErrInvalidExtCallTarget is returned when the address of EXTCALL, EXTDELEGATECALL
+// or EXTSTATICCALL has non-zero high bytes.
+var ErrInvalidExtCallTarget = errors.New("invalid EXTCALL target address")
+
+// enableEOF turns the instruction set into the one of EOF code:
+// - Removes the legacy jumps, calls, creations, code introspection, GAS and SELFDESTRUCT
+// - Adds RJUMP, RJUMPI and RJUMPV (EIP-4200)
+// - Adds CALLF and RETF (EIP-4750) and JUMPF (EIP-6206)
+// - Adds DUPN, SWAPN and EXCHANGE (EIP-663)
+// - Adds DATALOAD, DATALOADN, DATASIZE and DATACOPY (EIP-7480)
+// - Adds RETURNDATALOAD, EXTCALL, EXTDELEGATECALL and EXTSTATICCALL (EIP-7069)
+// - Adds EOFCREATE and RETURNCONTRACT (EIP-7620)
+func enableEOF(jt *JumpTable) {
+	for _, op := range []OpCode{
+		CALL, CALLCODE, DELEGATECALL, STATICCALL, SELFDESTRUCT,
+		JUMP, JUMPI, PC, CREATE, CREATE2,
+		CODESIZE, CODECOPY, EXTCODESIZE, EXTCODECOPY, EXTCODEHASH, GAS,
+	} {
+		jt[op] = &operation{execute: opUndefined, undefined: true}
+	}
+	// INVALID is a valid instruction of EOF code which aborts the execution
+	jt[INVALID] = &operation{execute: opUndefined}
+
+	jt[RJUMP] = &operation{
+		execute:     opRjump,
+		constantGas: GasQuickStep,
+	}
+	jt[RJUMPI] = &operation{
+		execute:     opRjumpi,
+		constantGas: GasFastishStep,
+		numPop:      1,
+	}
+	jt[RJUMPV] = &operation{
+		execute:     opRjumpv,
+		constantGas: GasFastishStep,
+		numPop:      1,
+	}
+	jt[CALLF] = &operation{
+		execute:     opCallf,
+		constantGas: GasFastStep,
+	}
+	jt[RETF] = &operation{
+		execute:     opRetf,
+		constantGas: GasFastestStep,
+	}
+	jt[JUMPF] = &operation{
+		execute:     opJumpf,
+		constantGas: GasFastStep,
+	}
+	jt[DUPN] = &operation{
+		execute:     opDupN,
+		constantGas: GasFastestStep,
+		numPush:     1,
+	}
+	jt[SWAPN] = &operation{
+		execute:     opSwapN,
+		constantGas: GasFastestStep,
+	}
+	jt[EXCHANGE] = &operation{
+		execute:     opExchange,
+		constantGas: GasFastestStep,
+	}
+	jt[DATALOAD] = &operation{
+		execute:     opDataLoad,
+		constantGas: GasFastishStep,
+		numPop:      1,
+		numPush:     1,
+	}
+	jt[DATALOADN] = &operation{
+		execute:     opDataLoadN,
+		constantGas: GasFastestStep,
+		numPush:     1,
+	}
+	jt[DATASIZE] = &operation{
+		execute:     opDataSize,
+		constantGas: GasQuickStep,
+		numPush:     1,
+	}
+	jt[DATACOPY] = &operation{
+		execute:     opDataCopy,
+		constantGas: GasFastestStep,
+		dynamicGas:  gasCallDataCopy,
+		numPop:      3,
+		memorySize:  memoryCallDataCopy,
+	}
+	jt[RETURNDATALOAD] = &operation{
+		execute:     opReturnDataLoad,
+		constantGas: GasFastestStep,
+		numPop:      1,
+		numPush:     1,
+	}
+	jt[RETURNDATACOPY] = &operation{
+		execute:     opReturnDataCopyEOF,
+		constantGas: GasFastestStep,
+		dynamicGas:  gasReturnDataCopy,
+		numPop:      3,
+		memorySize:  memoryReturnDataCopy,
+	}
+	jt[EXTCALL] = &operation{
+		execute:     opExtCall,
+		constantGas: params.WarmStorageReadCostEIP2929,
+		dynamicGas:  gasExtCall,
+		numPop:      4,
+		numPush:     1,
+		memorySize:  memoryExtCall,
+	}
+	jt[EXTDELEGATECALL] = &operation{
+		execute:     opExtDelegateCall,
+		constantGas: params.WarmStorageReadCostEIP2929,
+		dynamicGas:  gasExtDelegateCall,
+		numPop:      3,
+		numPush:     1,
+		memorySize:  memoryExtCall,
+	}
+	jt[EXTSTATICCALL] = &operation{
+		execute:     opExtStaticCall,
+		constantGas: params.WarmStorageReadCostEIP2929,
+		dynamicGas:  gasExtDelegateCall,
+		numPop:      3,
+		numPush:     1,
+		memorySize:  memoryExtCall,
+	}
+	jt[EOFCREATE] = &operation{
+		execute:     opEOFCreate,
+		constantGas: params.Create2Gas,
+		dynamicGas:  gasCreate,
+		numPop:      4,
+		numPush:     1,
+		memorySize:  memoryEOFCreate,
+	}
+	jt[RETURNCONTRACT] = &operation{
+		execute:    opReturnContract,
+		dynamicGas: gasReturn,
+		numPop:     2,
+		memorySize: memoryReturn,
+	}
+}
+
+// jumpTo moves the execution to dest of the current code section, the interpreter
+// loop increments pc after the instruction.
+func jumpTo(pc *uint64, dest uint64) {
+	*pc = dest - 1
+}
+
+func opRjump(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	offset := int16(binary.BigEndian.Uint16(scope.Contract.Code[*pc+1:]))
+	jumpTo(pc, uint64(int64(*pc)+3+int64(offset)))
+	return nil, nil
+}
+
+func opRjumpi(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	cond := scope.Stack.Pop()
+	if cond.IsZero() {
+		*pc += 2
+		return nil, nil
+	}
+	return opRjump(pc, interpreter, scope)
+}
+
+func opRjumpv(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		code  = scope.Contract.Code
+		count = uint64(code[*pc+1]) + 1
+		next  = *pc + 2 + 2*count
+		idx   = scope.Stack.Pop()
+	)
+	if !idx.IsUint64() || idx.Uint64() >= count {
+		jumpTo(pc, next)
+		return nil, nil
+	}
+	offset := int16(binary.BigEndian.Uint16(code[*pc+2+2*idx.Uint64():]))
+	jumpTo(pc, uint64(int64(next)+int64(offset)))
+	return nil, nil
+}
+
+func opCallf(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	section := int(binary.BigEndian.Uint16(scope.Contract.Code[*pc+1:]))
+	typ := scope.Contract.container.types[section]
+	if limit := int(params.StackLimit) - int(typ.maxStackHeight) + int(typ.inputs); scope.Stack.Len() > limit {
+		return nil, &ErrStackOverflow{stackLen: scope.Stack.Len(), limit: limit}
+	}
+	if len(scope.Contract.returnStack) >= int(params.StackLimit) {
+		return nil, ErrReturnStackExceeded
+	}
+	scope.Contract.returnStack = append(scope.Contract.returnStack, returnContext{
+		section: scope.Contract.codeSection,
+		pc:      *pc + 3,
+	})
+	scope.Contract.setCodeSection(section)
+	jumpTo(pc, 0)
+	return nil, nil
+}
+
+func opRetf(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	rs := scope.Contract.returnStack
+	ret := rs[len(rs)-1]
+	scope.Contract.returnStack = rs[:len(rs)-1]
+	scope.Contract.setCodeSection(ret.section)
+	jumpTo(pc, ret.pc)
+	return nil, nil
+}
+
+func opJumpf(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	section := int(binary.BigEndian.Uint16(scope.Contract.Code[*pc+1:]))
+	typ := scope.Contract.container.types[section]
+	if limit := int(params.StackLimit) - int(typ.maxStackHeight) + int(typ.inputs); scope.Stack.Len() > limit {
+		return nil, &ErrStackOverflow{stackLen: scope.Stack.Len(), limit: limit}
+	}
+	scope.Contract.setCodeSection(section)
+	jumpTo(pc, 0)
+	return nil, nil
+}
+
+func opDupN(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	n := int(scope.Contract.Code[*pc+1]) + 1
+	scope.Stack.Dup(n)
+	*pc += 1
+	return nil, nil
+}
+
+func opSwapN(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	n := int(scope.Contract.Code[*pc+1]) + 1
+	scope.Stack.Swap(n + 1)
+	*pc += 1
+	return nil, nil
+}
+
+func opExchange(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	imm := scope.Contract.Code[*pc+1]
+	n, m := int(imm>>4)+1, int(imm&0x0f)+1
+	a, b := scope.Stack.Back(n), scope.Stack.Back(n+m)
+	*a, *b = *b, *a
+	*pc += 1
+	return nil, nil
+}
+
+func opDataLoad(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	offset := scope.Stack.Peek()
+	offset.SetBytes(getDataBig(scope.Contract.container.data, offset, 32))
+	return nil, nil
+}
+
+func opDataLoadN(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	offset := uint64(binary.BigEndian.Uint16(scope.Contract.Code[*pc+1:]))
+	scope.Stack.Push(new(uint256.Int).SetBytes(getData(scope.Contract.container.data, offset, 32)))
+	*pc += 2
+	return nil, nil
+}
+
+func opDataSize(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	scope.Stack.Push(new(uint256.Int).SetUint64(uint64(len(scope.Contract.container.data))))
+	return nil, nil
+}
+
+func opDataCopy(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		memOffset = scope.Stack.Pop()
+		offset    = scope.Stack.Pop()
+		size      = scope.Stack.Pop()
+	)
+	scope.Memory.Set(memOffset.Uint64(), size.Uint64(), getDataBig(scope.Contract.container.data, &offset, size.Uint64()))
+	return nil, nil
+}
+
+func opReturnDataLoad(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	offset := scope.Stack.Peek()
+	offset.SetBytes(getDataBig(interpreter.returnData, offset, 32))
+	return nil, nil
+}
+
+// opReturnDataCopyEOF is RETURNDATACOPY of EOF code, which pads the data with zeros
+// instead of failing on out of bounds reads.
+func opReturnDataCopyEOF(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		memOffset  = scope.Stack.Pop()
+		dataOffset = scope.Stack.Pop()
+		length     = scope.Stack.Pop()
+	)
+	scope.Memory.Set(memOffset.Uint64(), length.Uint64(), getDataBig(interpreter.returnData, &dataOffset, length.Uint64()))
+	return nil, nil
+}
+
+func opExtCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	stack := scope.Stack
+	addr, inOffset, inSize, value := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
+	if interpreter.readOnly && !value.IsZero() {
+		return nil, ErrWriteProtection
+	}
+	args := scope.Memory.GetPtr(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+	return extCall(EXTCALL, interpreter, scope, libcommon.Address(addr.Bytes20()), args, &value)
+}
+
+func opExtDelegateCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	stack := scope.Stack
+	addr, inOffset, inSize := stack.Pop(), stack.Pop(), stack.Pop()
+	args := scope.Memory.GetPtr(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+	return extCall(EXTDELEGATECALL, interpreter, scope, libcommon.Address(addr.Bytes20()), args, new(uint256.Int))
+}
+
+func opExtStaticCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	stack := scope.Stack
+	addr, inOffset, inSize := stack.Pop(), stack.Pop(), stack.Pop()
+	args := scope.Memory.GetPtr(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+	return extCall(EXTSTATICCALL, interpreter, scope, libcommon.Address(addr.Bytes20()), args, new(uint256.Int))
+}
+
+// extCall runs EXTCALL, EXTDELEGATECALL and EXTSTATICCALL. It pushes 0 on success,
+// 1 on revert or when the call fails without execution and 2 on failure.
+func extCall(typ OpCode, interpreter *EVMInterpreter, scope *ScopeContext, addr libcommon.Address, args []byte, value *uint256.Int) ([]byte, error) {
+	var (
+		evm      = interpreter.evm
+		ibs      = evm.IntraBlockState()
+		retained = scope.Contract.Gas / 64
+		gas      uint64
+		status   uint256.Int
+	)
+	if retained < params.ExtCallMinRetainedGas {
+		retained = params.ExtCallMinRetainedGas
+	}
+	if scope.Contract.Gas > retained {
+		gas = scope.Contract.Gas - retained
+	}
+	if gas < params.ExtCallMinCalleeGas ||
+		interpreter.depth > int(params.CallCreateDepth) ||
+		(!value.IsZero() && !evm.Context.CanTransfer(ibs, scope.Contract.Address(), value)) ||
+		(typ == EXTDELEGATECALL && !HasEOFMagic(ibs.GetCode(addr))) {
+		interpreter.returnData = nil
+		scope.Stack.Push(status.SetOne())
+		return nil, nil
+	}
+	scope.Contract.UseGas(gas)
+
+	var (
+		ret       []byte
+		returnGas uint64
+		err       error
+	)
+	switch typ {
+	case EXTCALL:
+		ret, returnGas, err = evm.Call(scope.Contract, addr, args, gas, value, false /* bailout */)
+	case EXTDELEGATECALL:
+		ret, returnGas, err = evm.DelegateCall(scope.Contract, addr, args, gas)
+	default:
+		ret, returnGas, err = evm.StaticCall(scope.Contract, addr, args, gas)
+	}
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrExecutionReverted):
+		status.SetOne()
+	default:
+		status.SetUint64(2)
+	}
+	scope.Stack.Push(&status)
+	scope.Contract.Gas += returnGas
+
+	interpreter.returnData = ret
+	return ret, nil
+}
+
+func opEOFCreate(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	if interpreter.readOnly {
+		return nil, ErrWriteProtection
+	}
+	var (
+		initcode     = scope.Contract.container.rawSubContainers[scope.Contract.Code[*pc+1]]
+		endowment    = scope.Stack.Pop()
+		salt         = scope.Stack.Pop()
+		offset, size = scope.Stack.Pop(), scope.Stack.Pop()
+		input        = scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
+	)
+	*pc += 1
+	// The initcontainer is hashed to derive the address
+	if !scope.Contract.UseGas(ToWordSize(uint64(len(initcode))) * params.Keccak256WordGas) {
+		return nil, ErrOutOfGas
+	}
+
+	// Apply EIP150
+	gas := scope.Contract.Gas
+	gas -= gas / 64
+	scope.Contract.UseGas(gas)
+	// reuse size int for stackvalue
+	stackValue := size
+	res, addr, returnGas, suberr := interpreter.evm.EOFCreate(scope.Contract, initcode, input, gas, &endowment, &salt)
+
+	// Push item on the stack based on the returned error.
+	if suberr != nil {
+		stackValue.Clear()
+	} else {
+		stackValue.SetBytes(addr.Bytes())
+	}
+
+	scope.Stack.Push(&stackValue)
+	scope.Contract.Gas += returnGas
+
+	if suberr == ErrExecutionReverted {
+		interpreter.returnData = res // set REVERT data to return data buffer
+		return res, nil
+	}
+	interpreter.returnData = nil // clear dirty return data buffer
+	return nil, nil
+}
+
+func opReturnContract(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		idx          = scope.Contract.Code[*pc+1]
+		offset, size = scope.Stack.Pop(), scope.Stack.Pop()
+		aux          = scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
+	)
+	deploy, err := scope.Contract.container.subContainers[idx].withAuxData(aux)
+	if err != nil {
+		return nil, err
+	}
+	return deploy.MarshalBinary(), errStopToken
+}
+
+func memoryExtCall(stack *stack.Stack) (uint64, bool) {
+	return calcMemSize64(stack.Back(1), stack.Back(2))
+}
+
+func memoryEOFCreate(stack *stack.Stack) (uint64, bool) {
+	return calcMemSize64(stack.Back(2), stack.Back(3))
+}
+
+// gasExtCall charges the memory expansion, the cold access of the target and the
+// value transfer of EXTCALL.
+func gasExtCall(evm *EVM, contract *Contract, stack *stack.Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	gas, err := gasExtDelegateCall(evm, contract, stack, mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	if stack.Back(3).IsZero() {
+		return gas, nil
+	}
+	transferGas := params.CallValueTransferGas
+	if evm.IntraBlockState().Empty(stack.Back(0).Bytes20()) {
+		transferGas += params.CallNewAccountGas
+	}
+	var overflow bool
+	if gas, overflow = math.SafeAdd(gas, transferGas); overflow {
+		return 0, ErrGasUintOverflow
+	}
+	return gas, nil
+}
+
+// gasExtDelegateCall charges the memory expansion and the cold access of the target
+// of EXTDELEGATECALL and EXTSTATICCALL.
+func gasExtDelegateCall(evm *EVM, contract *Contract, stack *stack.Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	if stack.Back(0).BitLen() > 160 {
+		return 0, ErrInvalidExtCallTarget
+	}
+	gas, err := memoryGasCost(mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	if evm.IntraBlockState().AddAddressToAccessList(stack.Back(0).Bytes20()) {
+		var overflow bool
+		if gas, overflow = math.SafeAdd(gas, params.ColdAccountAccessCostEIP2929-params.WarmStorageReadCostEIP2929); overflow {
+			return 0, ErrGasUintOverflow
+		}
+	}
+	return gas, nil
+}
@@ -0,0 +1,239 @@
+package vm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var nonReturning = functionMetadata{outputs: nonReturningFunction}
+
+func newTestContainer(t *testing.T, types []functionMetadata, code [][]byte, subContainers [][]byte, data []byte) *Container {
+	t.Helper()
+	c := &Container{types: types, codeSections: code, data: data, dataSize: len(data)}
+	for _, raw := range subContainers {
+		sub, err := ParseContainer(raw)
+		require.NoError(t, err)
+		c.subContainers = append(c.subContainers, sub)
+		c.rawSubContainers = append(c.rawSubContainers, raw)
+	}
+	return c
+}
+
+func TestEOFMarshaling(t *testing.T) {
+	sub := newTestContainer(t, []functionMetadata{{outputs: nonReturningFunction}}, [][]byte{{byte(INVALID)}}, nil, []byte{1, 2})
+	c := newTestContainer(t,
+		[]functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 1}, {inputs: 1, outputs: 1, maxStackHeight: 1}},
+		[][]byte{
+			{byte(PUSH0), byte(CALLF), 0, 1, byte(POP), byte(STOP)},
+			{byte(RETF)},
+		},
+		[][]byte{sub.MarshalBinary()},
+		[]byte{0xaa, 0xbb},
+	)
+	b := c.MarshalBinary()
+	require.Equal(t, len(b), c.size())
+
+	parsed, err := ParseContainer(b)
+	require.NoError(t, err)
+	require.Equal(t, c, parsed)
+	require.Equal(t, b, parsed.MarshalBinary())
+
+	// the calldata follows the initcode of a creation transaction
+	parsed, input, err := ParseInitcodeContainer(append(b, 1, 2, 3))
+	require.NoError(t, err)
+	require.Equal(t, c, parsed)
+	require.Equal(t, []byte{1, 2, 3}, input)
+
+	_, err = ParseContainer(append(b, 0))
+	require.ErrorIs(t, err, ErrInvalidContainerSize)
+}
+
+func TestEOFParseErrors(t *testing.T) {
+	valid := newTestContainer(t, []functionMetadata{nonReturning}, [][]byte{{byte(STOP)}}, nil, nil).MarshalBinary()
+	for _, tt := range []struct {
+		name string
+		code []byte
+		err  error
+	}{
+		{"magic", []byte{0xef, 0x01, 0x01}, ErrInvalidMagic},
+		{"version", []byte{0xef, 0x00, 0x02}, ErrInvalidVersion},
+		{"incomplete", valid[:5], ErrIncompleteEOF},
+		{"types header", append([]byte{0xef, 0x00, 0x01, kindCode}, valid[4:]...), ErrMissingTypeHeader},
+		{"types size", append([]byte{0xef, 0x00, 0x01, kindTypes, 0x00, 0x03}, valid[6:]...), ErrInvalidTypeSize},
+		{"truncated body", valid[:len(valid)-1], ErrInvalidContainerSize},
+		{"first section type", newTestContainer(t, []functionMetadata{{}}, [][]byte{{byte(STOP)}}, nil, nil).MarshalBinary(), ErrInvalidFirstSectionType},
+		{"inputs", newTestContainer(t, []functionMetadata{nonReturning, {inputs: 128}}, [][]byte{{byte(STOP)}, {byte(RETF)}}, nil, nil).MarshalBinary(), ErrTooManyInputs},
+		{"max stack height", newTestContainer(t, []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 1024}}, [][]byte{{byte(STOP)}}, nil, nil).MarshalBinary(), ErrTooLargeMaxStackHeight},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseContainer(tt.code)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestEOFValidation(t *testing.T) {
+	runtime := newTestContainer(t, []functionMetadata{nonReturning}, [][]byte{{byte(INVALID)}}, nil, nil).MarshalBinary()
+	for _, tt := range []struct {
+		name          string
+		types         []functionMetadata
+		code          [][]byte
+		subContainers [][]byte
+		data          []byte
+		isInitcode    bool
+		err           error
+	}{
+		{
+			name:  "valid",
+			types: []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 2}, {inputs: 2, outputs: 1, maxStackHeight: 2}},
+			code: [][]byte{
+				{byte(PUSH1), 1, byte(DATALOADN), 0, 0, byte(CALLF), 0, 1, byte(RJUMPI), 0, 1, byte(STOP), byte(INVALID)},
+				{byte(ADD), byte(RETF)},
+			},
+			data: make([]byte, 32),
+		},
+		{
+			name:  "loop",
+			types: []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 1}},
+			code:  [][]byte{{byte(PUSH0), byte(RJUMPI), 0xff, 0xfc, byte(STOP)}},
+		},
+		{
+			name:  "forward merge",
+			types: []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 2}},
+			code:  [][]byte{{byte(PUSH0), byte(RJUMPI), 0, 1, byte(PUSH0), byte(PUSH0), byte(STOP)}},
+		},
+		{
+			name:  "undefined instruction",
+			types: []functionMetadata{nonReturning},
+			code:  [][]byte{{byte(PC), byte(STOP)}},
+			err:   ErrUndefinedInstruction,
+		},
+		{
+			name:  "truncated immediate",
+			types: []functionMetadata{nonReturning},
+			code:  [][]byte{{byte(STOP), byte(PUSH2), 0}},
+			err:   ErrTruncatedImmediate,
+		},
+		{
+			name:  "jump into immediate",
+			types: []functionMetadata{nonReturning},
+			code:  [][]byte{{byte(RJUMP), 0, 1, byte(PUSH1), 0, byte(STOP)}},
+			err:   ErrInvalidJumpDest,
+		},
+		{
+			name:  "jump out of section",
+			types: []functionMetadata{nonReturning},
+			code:  [][]byte{{byte(RJUMP), 0, 1, byte(STOP)}},
+			err:   ErrInvalidJumpDest,
+		},
+		{
+			name:  "unreachable code",
+			types: []functionMetadata{nonReturning},
+			code:  [][]byte{{byte(STOP), byte(STOP)}},
+			err:   ErrUnreachableCode,
+		},
+		{
+			name:  "no termination",
+			types: []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 1}},
+			code:  [][]byte{{byte(PUSH0)}},
+			err:   ErrInvalidCodeTermination,
+		},
+		{
+			name:  "stack underflow",
+			types: []functionMetadata{nonReturning},
+			code:  [][]byte{{byte(POP), byte(STOP)}},
+			err:   ErrEOFStackUnderflow,
+		},
+		{
+			name:  "max stack height",
+			types: []functionMetadata{nonReturning},
+			code:  [][]byte{{byte(PUSH0), byte(POP), byte(STOP)}},
+			err:   ErrInvalidMaxStackHeight,
+		},
+		{
+			name:  "backward jump changes stack",
+			types: []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 1}},
+			code:  [][]byte{{byte(PUSH0), byte(RJUMP), 0xff, 0xfc}},
+			err:   ErrInvalidBackwardJump,
+		},
+		{
+			name:  "unreachable section",
+			types: []functionMetadata{nonReturning, {}},
+			code:  [][]byte{{byte(STOP)}, {byte(RETF)}},
+			err:   ErrUnreachableCodeSections,
+		},
+		{
+			name:  "callf non-returning",
+			types: []functionMetadata{nonReturning, nonReturning},
+			code:  [][]byte{{byte(CALLF), 0, 1, byte(STOP)}, {byte(STOP)}},
+			err:   ErrInvalidCallArgument,
+		},
+		{
+			name:  "returning section without retf",
+			types: []functionMetadata{nonReturning, {}},
+			code:  [][]byte{{byte(CALLF), 0, 1, byte(STOP)}, {byte(STOP)}},
+			err:   ErrInvalidNonReturningFlag,
+		},
+		{
+			name:  "retf outputs",
+			types: []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 1}, {outputs: 1}},
+			code:  [][]byte{{byte(CALLF), 0, 1, byte(POP), byte(STOP)}, {byte(RETF)}},
+			err:   ErrInvalidOutputs,
+		},
+		{
+			name:  "dataloadn out of bounds",
+			types: []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 1}},
+			code:  [][]byte{{byte(DATALOADN), 0, 1, byte(STOP)}},
+			data:  make([]byte, 32),
+			err:   ErrInvalidDataloadNArgument,
+		},
+		{
+			name:          "orphaned subcontainer",
+			types:         []functionMetadata{nonReturning},
+			code:          [][]byte{{byte(STOP)}},
+			subContainers: [][]byte{runtime},
+			err:           ErrOrphanedSubcontainer,
+		},
+		{
+			name:          "returncontract in runtime code",
+			types:         []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 2}},
+			code:          [][]byte{{byte(PUSH0), byte(PUSH0), byte(RETURNCONTRACT), 0}},
+			subContainers: [][]byte{runtime},
+			err:           ErrIncompatibleContainerKind,
+		},
+		{
+			name:          "returncontract in initcode",
+			types:         []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 2}},
+			code:          [][]byte{{byte(PUSH0), byte(PUSH0), byte(RETURNCONTRACT), 0}},
+			subContainers: [][]byte{runtime},
+			isInitcode:    true,
+		},
+		{
+			name:       "stop in initcode",
+			types:      []functionMetadata{nonReturning},
+			code:       [][]byte{{byte(STOP)}},
+			isInitcode: true,
+			err:        ErrIncompatibleContainerKind,
+		},
+		{
+			name:          "eofcreate of runtime code",
+			types:         []functionMetadata{{outputs: nonReturningFunction, maxStackHeight: 4}},
+			code:          [][]byte{{byte(PUSH0), byte(PUSH0), byte(PUSH0), byte(PUSH0), byte(EOFCREATE), 0, byte(STOP)}},
+			subContainers: [][]byte{newTestContainer(t, []functionMetadata{nonReturning}, [][]byte{{byte(STOP)}}, nil, nil).MarshalBinary()},
+			err:           ErrIncompatibleContainerKind,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseContainer(newTestContainer(t, tt.types, tt.code, tt.subContainers, tt.data).MarshalBinary())
+			require.NoError(t, err)
+			err = c.Validate(tt.isInitcode)
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
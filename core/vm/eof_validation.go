@@ -0,0 +1,356 @@
+package vm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ledgerwatch/erigon/params"
+)
+
+var (
+	ErrUndefinedInstruction          = errors.New("undefined instruction")
+	ErrTruncatedImmediate            = errors.New("truncated immediate")
+	ErrInvalidSectionArgument        = errors.New("invalid section argument")
+	ErrInvalidCallArgument           = errors.New("callf into non-returning section")
+	ErrInvalidDataloadNArgument      = errors.New("invalid dataloadN argument")
+	ErrInvalidContainerArgument      = errors.New("invalid container argument")
+	ErrInvalidJumpDest               = errors.New("invalid jump destination")
+	ErrInvalidBackwardJump           = errors.New("invalid backward jump")
+	ErrInvalidOutputs                = errors.New("invalid number of outputs")
+	ErrInvalidMaxStackHeight         = errors.New("invalid max stack height")
+	ErrInvalidCodeTermination        = errors.New("invalid code termination")
+	ErrInvalidNonReturningFlag       = errors.New("invalid non-returning flag")
+	ErrUnreachableCode               = errors.New("unreachable code")
+	ErrUnreachableCodeSections       = errors.New("unreachable code sections")
+	ErrEOFStackUnderflow             = errors.New("stack underflow")
+	ErrEOFStackOverflow              = errors.New("stack overflow")
+	ErrEOFCreateWithTruncatedSection = errors.New("eofcreate with truncated section")
+	ErrOrphanedSubcontainer          = errors.New("subcontainer not referenced at all")
+	ErrIncompatibleContainerKind     = errors.New("incompatible container kind")
+	ErrTruncatedTopLevelContainer    = errors.New("truncated top level container")
+)
+
+// subContainerRef records how a subcontainer is used by the code of its parent.
+type subContainerRef uint8
+
+const (
+	refEOFCreate subContainerRef = 1 << iota
+	refReturnContract
+)
+
+// ParseAndValidateContainer decodes and validates an EOF container, see Container.Validate.
+func ParseAndValidateContainer(b []byte, isInitcode bool) (*Container, error) {
+	c, err := ParseContainer(b)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Validate(isInitcode); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate checks the code of the container and of its subcontainers, see EIP-3670,
+// EIP-4200, EIP-4750, EIP-5450 and EIP-7620. The container is validated as deployed
+// code, or as initcode of EOFCREATE and creation transactions if isInitcode is set.
+func (c *Container) Validate(isInitcode bool) error {
+	if c.isTruncated() {
+		return ErrTruncatedTopLevelContainer
+	}
+	return c.validate(&eofInstructionSet, isInitcode)
+}
+
+func (c *Container) validate(jt *JumpTable, isInitcode bool) error {
+	var (
+		refs    = make([]subContainerRef, len(c.subContainers))
+		visited = make([]bool, len(c.codeSections))
+		queue   = []int{0}
+	)
+	// Validate the code sections reachable from the first one.
+	visited[0] = true
+	for len(queue) > 0 {
+		section := queue[0]
+		queue = queue[1:]
+		callees, err := validateCode(c, section, jt, isInitcode, refs)
+		if err != nil {
+			return fmt.Errorf("section %d: %w", section, err)
+		}
+		for _, callee := range callees {
+			if !visited[callee] {
+				visited[callee] = true
+				queue = append(queue, callee)
+			}
+		}
+	}
+	for section, ok := range visited {
+		if !ok {
+			return fmt.Errorf("%w: section %d", ErrUnreachableCodeSections, section)
+		}
+	}
+
+	// A subcontainer is either the initcode of EOFCREATE or the code deployed by RETURNCONTRACT.
+	for i, sub := range c.subContainers {
+		var err error
+		switch refs[i] {
+		case refEOFCreate:
+			if sub.isTruncated() {
+				return fmt.Errorf("subcontainer %d: %w", i, ErrEOFCreateWithTruncatedSection)
+			}
+			err = sub.validate(jt, true /* isInitcode */)
+		case refReturnContract:
+			err = sub.validate(jt, false /* isInitcode */)
+		case 0:
+			err = ErrOrphanedSubcontainer
+		default:
+			err = ErrIncompatibleContainerKind
+		}
+		if err != nil {
+			return fmt.Errorf("subcontainer %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateCode checks the instructions and the stack of a code section, it returns
+// the sections called by the code and records the use of the subcontainers in refs.
+func validateCode(c *Container, section int, jt *JumpTable, isInitcode bool, refs []subContainerRef) ([]int, error) {
+	var (
+		code       = c.codeSections[section]
+		immediates = make([]bool, len(code))
+		jumps      []int
+		callees    []int
+		returning  bool
+	)
+	for pos := 0; pos < len(code); {
+		op := OpCode(code[pos])
+		if jt[op].undefined {
+			return nil, fmt.Errorf("%w: %v at %d", ErrUndefinedInstruction, op, pos)
+		}
+		size := immediateSize(code, pos)
+		if pos+1+size > len(code) {
+			return nil, fmt.Errorf("%w: %v at %d", ErrTruncatedImmediate, op, pos)
+		}
+		arg := code[pos+1 : pos+1+size]
+		switch op {
+		case RJUMP, RJUMPI, RJUMPV:
+			jumps = append(jumps, pos)
+		case CALLF, JUMPF:
+			idx := int(binary.BigEndian.Uint16(arg))
+			if idx >= len(c.types) {
+				return nil, fmt.Errorf("%w: %v to section %d at %d", ErrInvalidSectionArgument, op, idx, pos)
+			}
+			if c.types[idx].outputs == nonReturningFunction {
+				if op == CALLF {
+					return nil, fmt.Errorf("%w: section %d at %d", ErrInvalidCallArgument, idx, pos)
+				}
+			} else if op == JUMPF {
+				returning = true
+			}
+			callees = append(callees, idx)
+		case RETF:
+			returning = true
+		case DATALOADN:
+			if offset := int(binary.BigEndian.Uint16(arg)); offset+32 > c.dataSize {
+				return nil, fmt.Errorf("%w: offset %d at %d", ErrInvalidDataloadNArgument, offset, pos)
+			}
+		case EOFCREATE, RETURNCONTRACT:
+			idx := int(arg[0])
+			if idx >= len(c.subContainers) {
+				return nil, fmt.Errorf("%w: %v to container %d at %d", ErrInvalidContainerArgument, op, idx, pos)
+			}
+			if op == EOFCREATE {
+				refs[idx] |= refEOFCreate
+			} else {
+				if !isInitcode {
+					return nil, fmt.Errorf("%w: %v in runtime code at %d", ErrIncompatibleContainerKind, op, pos)
+				}
+				refs[idx] |= refReturnContract
+			}
+		case STOP, RETURN:
+			if isInitcode {
+				return nil, fmt.Errorf("%w: %v in initcode at %d", ErrIncompatibleContainerKind, op, pos)
+			}
+		}
+		for i := pos + 1; i < pos+1+size; i++ {
+			immediates[i] = true
+		}
+		pos += 1 + size
+	}
+	if returning != (c.types[section].outputs != nonReturningFunction) {
+		return nil, ErrInvalidNonReturningFlag
+	}
+	// The relative jumps must land on an instruction.
+	for _, pos := range jumps {
+		for _, target := range jumpTargets(code, pos) {
+			if target < 0 || target >= len(code) || immediates[target] {
+				return nil, fmt.Errorf("%w: %v at %d to %d", ErrInvalidJumpDest, OpCode(code[pos]), pos, target)
+			}
+		}
+	}
+	if err := validateStack(c, section, jt); err != nil {
+		return nil, err
+	}
+	return callees, nil
+}
+
+// validateStack computes the range of the stack height at every instruction of a
+// code section, see EIP-5450.
+func validateStack(c *Container, section int, jt *JumpTable) error {
+	var (
+		code      = c.codeSections[section]
+		typ       = c.types[section]
+		mins      = make([]int, len(code))
+		maxs      = make([]int, len(code))
+		maxHeight = int(typ.inputs)
+		stackMax  = int(params.StackLimit)
+	)
+	for i := range mins {
+		mins[i], maxs[i] = -1, -1
+	}
+	mins[0], maxs[0] = int(typ.inputs), int(typ.inputs)
+
+	visit := func(from, target, lo, hi int) error {
+		if target <= from {
+			// Backward jumps must not change the stack height.
+			if mins[target] != lo || maxs[target] != hi {
+				return fmt.Errorf("%w: from %d to %d", ErrInvalidBackwardJump, from, target)
+			}
+			return nil
+		}
+		if mins[target] < 0 {
+			mins[target], maxs[target] = lo, hi
+			return nil
+		}
+		if lo < mins[target] {
+			mins[target] = lo
+		}
+		if hi > maxs[target] {
+			maxs[target] = hi
+		}
+		return nil
+	}
+
+	for pos := 0; pos < len(code); {
+		op := OpCode(code[pos])
+		next := pos + 1 + immediateSize(code, pos)
+		lo, hi := mins[pos], maxs[pos]
+		if lo < 0 {
+			return fmt.Errorf("%w: %v at %d", ErrUnreachableCode, op, pos)
+		}
+		required, change := stackEffect(c, jt, code, pos)
+		if lo < required {
+			return fmt.Errorf("%w: %v at %d needs %d items, has %d", ErrEOFStackUnderflow, op, pos, required, lo)
+		}
+		switch op {
+		case CALLF, JUMPF:
+			target := c.types[binary.BigEndian.Uint16(code[pos+1:])]
+			if hi+int(target.maxStackHeight)-int(target.inputs) > stackMax {
+				return fmt.Errorf("%w: %v at %d", ErrEOFStackOverflow, op, pos)
+			}
+			if op == JUMPF && target.outputs != nonReturningFunction {
+				// The stack is returned by the target section, it must match the outputs.
+				want := int(typ.outputs) + int(target.inputs) - int(target.outputs)
+				if target.outputs > typ.outputs || lo != want || hi != want {
+					return fmt.Errorf("%w: %v at %d", ErrInvalidOutputs, op, pos)
+				}
+			}
+		case RETF:
+			if lo != int(typ.outputs) || hi != int(typ.outputs) {
+				return fmt.Errorf("%w: %v at %d", ErrInvalidOutputs, op, pos)
+			}
+		}
+		lo, hi = lo+change, hi+change
+		if hi > maxHeight {
+			maxHeight = hi
+		}
+
+		if !isTerminating(op) {
+			if next >= len(code) {
+				return fmt.Errorf("%w: %v at %d", ErrInvalidCodeTermination, op, pos)
+			}
+			if err := visit(pos, next, lo, hi); err != nil {
+				return err
+			}
+		}
+		if op == RJUMP || op == RJUMPI || op == RJUMPV {
+			for _, target := range jumpTargets(code, pos) {
+				if err := visit(pos, target, lo, hi); err != nil {
+					return err
+				}
+			}
+		}
+		pos = next
+	}
+	if maxHeight != int(typ.maxStackHeight) {
+		return fmt.Errorf("%w: computed %d, declared %d", ErrInvalidMaxStackHeight, maxHeight, typ.maxStackHeight)
+	}
+	return nil
+}
+
+// immediateSize returns the size of the immediate arguments of the instruction at pos.
+func immediateSize(code []byte, pos int) int {
+	op := OpCode(code[pos])
+	if op >= PUSH1 && op <= PUSH32 {
+		return int(op-PUSH1) + 1
+	}
+	switch op {
+	case RJUMP, RJUMPI, CALLF, JUMPF, DATALOADN:
+		return 2
+	case DUPN, SWAPN, EXCHANGE, EOFCREATE, RETURNCONTRACT:
+		return 1
+	case RJUMPV:
+		if pos+1 < len(code) {
+			return 1 + 2*(int(code[pos+1])+1)
+		}
+		return 1
+	}
+	return 0
+}
+
+// jumpTargets returns the destinations of the relative jump at pos.
+func jumpTargets(code []byte, pos int) []int {
+	next := pos + 1 + immediateSize(code, pos)
+	switch OpCode(code[pos]) {
+	case RJUMP, RJUMPI:
+		return []int{next + int(int16(binary.BigEndian.Uint16(code[pos+1:])))}
+	case RJUMPV:
+		targets := make([]int, int(code[pos+1])+1)
+		for i := range targets {
+			targets[i] = next + int(int16(binary.BigEndian.Uint16(code[pos+2+2*i:])))
+		}
+		return targets
+	}
+	return nil
+}
+
+// stackEffect returns the number of stack items required by the instruction at pos
+// and the change of the stack height.
+func stackEffect(c *Container, jt *JumpTable, code []byte, pos int) (required, change int) {
+	switch op := OpCode(code[pos]); op {
+	case CALLF, JUMPF:
+		target := c.types[binary.BigEndian.Uint16(code[pos+1:])]
+		if op == JUMPF {
+			return int(target.inputs), 0
+		}
+		return int(target.inputs), int(target.outputs) - int(target.inputs)
+	case DUPN:
+		return int(code[pos+1]) + 1, 1
+	case SWAPN:
+		return int(code[pos+1]) + 2, 0
+	case EXCHANGE:
+		n, m := int(code[pos+1]>>4)+1, int(code[pos+1]&0x0f)+1
+		return n + m + 1, 0
+	default:
+		return jt[op].numPop, jt[op].numPush - jt[op].numPop
+	}
+}
+
+// isTerminating returns whether the execution never continues with the next instruction.
+func isTerminating(op OpCode) bool {
+	switch op {
+	case STOP, RETURN, REVERT, INVALID, RETF, JUMPF, RETURNCONTRACT, RJUMP:
+		return true
+	}
+	return false
+}
@@ -25,6 +25,8 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
 	"github.com/ledgerwatch/erigon/common/u256"
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
 	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/params"
@@ -35,6 +37,10 @@ import (
 var emptyCodeHash = crypto.Keccak256Hash(nil)
 
 func (evm *EVM) precompile(addr libcommon.Address) (PrecompiledContract, bool) {
+	if evm.precompiles != nil {
+		p, ok := evm.precompiles[addr]
+		return p, ok
+	}
 	var precompiles map[libcommon.Address]PrecompiledContract
 	switch {
 	case evm.chainRules.IsCancun:
@@ -90,6 +96,8 @@ type EVM struct {
 	// available gas is calculated in gasCall* according to the 63/64 rule and later
 	// applied in opCall*.
 	callGasTemp uint64
+	// precompiles overrides the set of precompiled contracts selected by chainRules, if not nil
+	precompiles map[libcommon.Address]PrecompiledContract
 }
 
 // NewEVM returns a new EVM. The returned EVM is not thread safe and should
@@ -105,6 +113,7 @@ func NewEVM(blockCtx evmtypes.BlockContext, txCtx evmtypes.TxContext, state evmt
 	}
 
 	evm.interpreter = NewEVMInterpreter(evm, vmConfig)
+	evm.attachStateHooks()
 
 	return evm
 }
@@ -114,6 +123,7 @@ func NewEVM(blockCtx evmtypes.BlockContext, txCtx evmtypes.TxContext, state evmt
 func (evm *EVM) Reset(txCtx evmtypes.TxContext, ibs evmtypes.IntraBlockState) {
 	evm.TxContext = txCtx
 	evm.intraBlockState = ibs
+	evm.attachStateHooks()
 
 	// ensure the evm is reset to be used again
 	atomic.StoreInt32(&evm.abort, 0)
@@ -127,11 +137,44 @@ func (evm *EVM) ResetBetweenBlocks(blockCtx evmtypes.BlockContext, txCtx evmtype
 	evm.chainRules = chainRules
 
 	evm.interpreter = NewEVMInterpreter(evm, vmConfig)
+	evm.attachStateHooks()
 
 	// ensure the evm is reset to be used again
 	atomic.StoreInt32(&evm.abort, 0)
 }
 
+// attachStateHooks lets the tracer observe the state changes if it implements tracing.StateHooks,
+// and detaches the tracer of a previous EVM otherwise.
+func (evm *EVM) attachStateHooks() {
+	if evm.intraBlockState == nil {
+		return
+	}
+	var hooks tracing.StateHooks
+	if evm.config.Debug {
+		hooks, _ = evm.config.Tracer.(tracing.StateHooks)
+	}
+	evm.intraBlockState.SetHooks(hooks)
+}
+
+// SetPrecompiles replaces the set of precompiled contracts used by this EVM.
+// It is meant for call simulation (e.g. eth_simulateV1) and must not be used during block execution.
+func (evm *EVM) SetPrecompiles(precompiles map[libcommon.Address]PrecompiledContract) {
+	evm.precompiles = precompiles
+}
+
+// ActivePrecompiles returns the addresses of the precompiled contracts used by this EVM, which are warm
+// from the start of every transaction. Relocated precompiles are warm at their new address.
+func (evm *EVM) ActivePrecompiles() []libcommon.Address {
+	if evm.precompiles == nil {
+		return ActivePrecompiles(evm.chainRules)
+	}
+	addrs := make([]libcommon.Address, 0, len(evm.precompiles))
+	for addr := range evm.precompiles {
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
 // Cancel cancels any running EVM operation. This may be called concurrently and
 // it's safe to be called multiple times.
 func (evm *EVM) Cancel() {
@@ -178,8 +221,12 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 	}
 	p, isPrecompile := evm.precompile(addr)
 	var code []byte
+	codeAddr := addr
 	if !isPrecompile {
 		code = evm.intraBlockState.GetCode(addr)
+		if evm.chainRules.IsPrague {
+			codeAddr, code = evm.resolveDelegation(addr, code)
+		}
 	}
 
 	snapshot := evm.intraBlockState.Snapshot()
@@ -211,7 +258,7 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 		// This doesn't matter on Mainnet, where all empties are gone at the time of Byzantium,
 		// but is the correct thing to do and matters on other networks, in tests, and potential
 		// future scenarios
-		evm.intraBlockState.AddBalance(addr, u256.Num0)
+		evm.intraBlockState.AddBalance(addr, u256.Num0, tracing.BalanceChangeTouchAccount)
 	}
 	if evm.config.Debug {
 		v := value
@@ -245,7 +292,7 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 		addrCopy := addr
 		// Initialise a new contract and set the code that is to be used by the EVM.
 		// The contract is a scoped environment for this execution context only.
-		codeHash := evm.intraBlockState.GetCodeHash(addrCopy)
+		codeHash := evm.intraBlockState.GetCodeHash(codeAddr)
 		var contract *Contract
 		if typ == CALLCODE {
 			contract = NewContract(caller, caller.Address(), value, gas, evm.config.SkipAnalysis)
@@ -255,6 +302,12 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 			contract = NewContract(caller, addrCopy, value, gas, evm.config.SkipAnalysis)
 		}
 		contract.SetCallCode(&addrCopy, codeHash, code)
+		if evm.chainRules.IsOsaka && HasEOFMagic(code) {
+			// Deployed EOF code is validated, legacy code starting with the magic is never run
+			if container, err := ParseContainer(code); err == nil {
+				contract.setContainer(container)
+			}
+		}
 		readOnly := false
 		if typ == STATICCALL {
 			readOnly = true
@@ -277,6 +330,16 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 	return ret, gas, err
 }
 
+// resolveDelegation follows the delegation designator of an EIP-7702 account, it returns the address
+// and the code which run when addr with the given code is called.
+func (evm *EVM) resolveDelegation(addr libcommon.Address, code []byte) (libcommon.Address, []byte) {
+	target, ok := types.ParseDelegation(code)
+	if !ok {
+		return addr, code
+	}
+	return target, evm.intraBlockState.GetCode(target)
+}
+
 // Call executes the contract associated with the addr with the given input as
 // parameters. It also handles any necessary value transfer required and takes
 // the necessary steps to create accounts and reverses the state in case of an
@@ -325,8 +388,8 @@ func (c *codeAndHash) Hash() libcommon.Hash {
 	return c.hash
 }
 
-// create creates a new contract using code as deployment code.
-func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64, value *uint256.Int, address libcommon.Address, typ OpCode, incrementNonce bool) ([]byte, libcommon.Address, uint64, error) {
+// create creates a new contract using code as deployment code. The input is the calldata of EOF initcode.
+func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, input []byte, gas uint64, value *uint256.Int, address libcommon.Address, typ OpCode, incrementNonce bool) ([]byte, libcommon.Address, uint64, error) {
 	var ret []byte
 	var err error
 	var gasConsumption uint64
@@ -364,6 +427,21 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 		}
 		evm.intraBlockState.SetNonce(caller.Address(), nonce+1)
 	}
+	var container *Container
+	if typ == EOFCREATE {
+		// The initcontainer is validated together with the code of the creator
+		if container, err = ParseContainer(codeAndHash.code); err != nil {
+			return nil, libcommon.Address{}, 0, err
+		}
+	} else if depth == 0 && evm.chainRules.IsOsaka && HasEOFMagic(codeAndHash.code) {
+		// EIP-7698: EOF initcode of a creation transaction is followed by the calldata
+		if container, input, err = ParseInitcodeContainer(codeAndHash.code); err == nil {
+			err = container.Validate(true /* isInitcode */)
+		}
+		if err != nil {
+			return nil, libcommon.Address{}, 0, err
+		}
+	}
 	// We add this to the access list _before_ taking a snapshot. Even if the creation fails,
 	// the access-list change should not be rolled back
 	if evm.chainRules.IsBerlin {
@@ -387,12 +465,15 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 	// The contract is a scoped environment for this execution context only.
 	contract := NewContract(caller, address, value, gas, evm.config.SkipAnalysis)
 	contract.SetCodeOptionalHash(&address, codeAndHash)
+	if container != nil {
+		contract.setContainer(container)
+	}
 
 	if evm.config.NoRecursion && depth > 0 {
 		return nil, address, gas, nil
 	}
 
-	ret, err = run(evm, contract, nil, false)
+	ret, err = run(evm, contract, input, false)
 
 	// EIP-170: Contract code size limit
 	if err == nil && evm.chainRules.IsSpuriousDragon && len(ret) > params.MaxCodeSize {
@@ -403,8 +484,8 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 		}
 	}
 
-	// Reject code starting with 0xEF if EIP-3541 is enabled.
-	if err == nil && evm.chainRules.IsLondon && len(ret) >= 1 && ret[0] == 0xEF {
+	// Reject code starting with 0xEF if EIP-3541 is enabled. EOF initcode deploys an EOF container.
+	if err == nil && evm.chainRules.IsLondon && container == nil && len(ret) >= 1 && ret[0] == 0xEF {
 		err = ErrInvalidCode
 	}
 	// if the contract creation ran successfully and no errors were returned
@@ -440,7 +521,7 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 // DESCRIBED: docs/programmers_guide/guide.md#nonce
 func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, endowment *uint256.Int) (ret []byte, contractAddr libcommon.Address, leftOverGas uint64, err error) {
 	contractAddr = crypto.CreateAddress(caller.Address(), evm.intraBlockState.GetNonce(caller.Address()))
-	return evm.create(caller, &codeAndHash{code: code}, gas, endowment, contractAddr, CREATE, true /* incrementNonce */)
+	return evm.create(caller, &codeAndHash{code: code}, nil, gas, endowment, contractAddr, CREATE, true /* incrementNonce */)
 }
 
 // Create2 creates a new contract using code as deployment code.
@@ -451,13 +532,21 @@ func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, endowment *u
 func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, endowment *uint256.Int, salt *uint256.Int) (ret []byte, contractAddr libcommon.Address, leftOverGas uint64, err error) {
 	codeAndHash := &codeAndHash{code: code}
 	contractAddr = crypto.CreateAddress2(caller.Address(), salt.Bytes32(), codeAndHash.Hash().Bytes())
-	return evm.create(caller, codeAndHash, gas, endowment, contractAddr, CREATE2, true /* incrementNonce */)
+	return evm.create(caller, codeAndHash, nil, gas, endowment, contractAddr, CREATE2, true /* incrementNonce */)
+}
+
+// EOFCreate creates a new contract from the EOF initcontainer of EOFCREATE with the
+// given calldata, the address is derived as by Create2.
+func (evm *EVM) EOFCreate(caller ContractRef, initcode []byte, input []byte, gas uint64, endowment *uint256.Int, salt *uint256.Int) (ret []byte, contractAddr libcommon.Address, leftOverGas uint64, err error) {
+	codeAndHash := &codeAndHash{code: initcode}
+	contractAddr = crypto.CreateAddress2(caller.Address(), salt.Bytes32(), codeAndHash.Hash().Bytes())
+	return evm.create(caller, codeAndHash, input, gas, endowment, contractAddr, EOFCREATE, true /* incrementNonce */)
 }
 
 // SysCreate is a special (system) contract creation methods for genesis constructors.
 // Unlike the normal Create & Create2, it doesn't increment caller's nonce.
 func (evm *EVM) SysCreate(caller ContractRef, code []byte, gas uint64, endowment *uint256.Int, contractAddr libcommon.Address) (ret []byte, leftOverGas uint64, err error) {
-	ret, _, leftOverGas, err = evm.create(caller, &codeAndHash{code: code}, gas, endowment, contractAddr, CREATE, false /* incrementNonce */)
+	ret, _, leftOverGas, err = evm.create(caller, &codeAndHash{code: code}, nil, gas, endowment, contractAddr, CREATE, false /* incrementNonce */)
 	return
 }
 
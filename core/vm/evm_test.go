@@ -9,6 +9,7 @@ import (
 	"github.com/ledgerwatch/erigon/params"
 
 	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
 )
 
@@ -404,3 +405,19 @@ func trace(isEVMSlice []bool, readOnlySlice []*readOnlyState) string {
 	}
 	return res
 }
+
+func TestActivePrecompiles(t *testing.T) {
+	t.Parallel()
+	env := NewEVM(evmtypes.BlockContext{}, evmtypes.TxContext{}, &dummyStatedb{}, params.TestChainConfig, Config{})
+	require.ElementsMatch(t, ActivePrecompiles(env.ChainRules()), env.ActivePrecompiles())
+
+	// a relocated precompile is warm at its new address only
+	precompiles := ActivePrecompiledContracts(env.ChainRules())
+	ecrecover, movedTo := libcommon.BytesToAddress([]byte{1}), libcommon.Address{0xaa}
+	precompiles[movedTo] = precompiles[ecrecover]
+	delete(precompiles, ecrecover)
+	env.SetPrecompiles(precompiles)
+	require.Contains(t, env.ActivePrecompiles(), movedTo)
+	require.NotContains(t, env.ActivePrecompiles(), ecrecover)
+	require.Len(t, env.ActivePrecompiles(), len(ActivePrecompiles(env.ChainRules())))
+}
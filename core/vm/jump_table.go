@@ -46,6 +46,8 @@ type operation struct {
 	isSwap  bool
 	isDup   bool
 	opNum   int // only for push, swap, dup
+	// undefined tells whether the opcode is not a valid instruction
+	undefined bool
 	// memorySize returns the memory size required for the operation
 	memorySize memorySizeFunc
 }
@@ -65,6 +67,14 @@ var (
 	pragueInstructionSet           = newPragueInstructionSet()
 )
 
+// eofInstructionSet is built in init, the creation of contracts refers to it to
+// validate EOF initcode, which would be an initialization cycle.
+var eofInstructionSet JumpTable
+
+func init() {
+	eofInstructionSet = newEOFInstructionSet()
+}
+
 // JumpTable contains the EVM opcodes supported at a given fork.
 type JumpTable [256]*operation
 
@@ -89,8 +99,24 @@ func validateAndFillMaxStack(jt *JumpTable) {
 // newPragueInstructionSet returns the frontier, homestead, byzantium,
 // constantinople, istanbul, petersburg, berlin, london, paris, shanghai,
 // cancun, and prague instructions.
+//
+// BLOCKHASH keeps reading the header chain: EIP-2935 as included in Prague only stores the hashes in the history
+// storage contract, for the contracts calling it, and leaves the opcode, its 20 gas and the access list unchanged.
+// Both return the same hashes, as the contract is written from the same headers.
 func newPragueInstructionSet() JumpTable {
 	instructionSet := newCancunInstructionSet()
+	enable7702(&instructionSet) // EXTCODE* and calls follow the delegation of EOA accounts
+	validateAndFillMaxStack(&instructionSet)
+	return instructionSet
+}
+
+// newEOFInstructionSet returns the instructions of EOF code, which are the prague
+// instructions without the legacy jumps, calls, creations and code introspection,
+// and with the instructions of EIP-4200, EIP-4750, EIP-6206, EIP-663, EIP-7069,
+// EIP-7480 and EIP-7620.
+func newEOFInstructionSet() JumpTable {
+	instructionSet := newPragueInstructionSet()
+	enableEOF(&instructionSet)
 	validateAndFillMaxStack(&instructionSet)
 	return instructionSet
 }
@@ -1204,7 +1230,7 @@ func newFrontierInstructionSet() JumpTable {
 	// Fill all unassigned slots with opUndefined.
 	for i, entry := range tbl {
 		if entry == nil {
-			tbl[i] = &operation{execute: opUndefined}
+			tbl[i] = &operation{execute: opUndefined, undefined: true}
 		}
 	}
 
@@ -28,6 +28,8 @@ import (
 // current VM state.
 // Note that reference types are actual VM data structures; make copies
 // if you need to retain them beyond the current call.
+// Loggers which also implement tracing.StateHooks are notified of the state
+// changes made while the EVM they are configured on is in use.
 type EVMLogger interface {
 	// Transaction level
 	CaptureTxStart(gasLimit uint64)
@@ -23,6 +23,7 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/math"
 
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm/stack"
 	"github.com/ledgerwatch/erigon/params"
 )
@@ -185,7 +186,43 @@ func makeCallVariantGasCallEIP2929(oldCalculator gasFunc) gasFunc {
 	}
 }
 
+// makeCallVariantGasCallEIP7702 charges the access of the delegation target of an EIP-7702 account on top of the
+// EIP-2929 costs of the call.
+func makeCallVariantGasCallEIP7702(eip2929Calculator gasFunc) gasFunc {
+	return func(evm *EVM, contract *Contract, stack *stack.Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		addr := libcommon.Address(stack.Back(1).Bytes20())
+		target, ok := types.ParseDelegation(evm.IntraBlockState().GetCode(addr))
+		if !ok {
+			return eip2929Calculator(evm, contract, stack, mem, memorySize)
+		}
+		delegationCost := params.WarmStorageReadCostEIP2929
+		if evm.IntraBlockState().AddAddressToAccessList(target) {
+			delegationCost = params.ColdAccountAccessCostEIP2929
+		}
+		// Charge the delegation cost here already, so that the 63/64ths rule applies to the remaining gas
+		if !contract.UseGas(delegationCost) {
+			return 0, ErrOutOfGas
+		}
+		gas, err := eip2929Calculator(evm, contract, stack, mem, memorySize)
+		if err != nil {
+			return gas, err
+		}
+		// As with the cold access of EIP-2929, give the charge back and add it to the dynamic gas
+		contract.Gas += delegationCost
+		var overflow bool
+		if gas, overflow = math.SafeAdd(gas, delegationCost); overflow {
+			return 0, ErrGasUintOverflow
+		}
+		return gas, nil
+	}
+}
+
 var (
+	gasCallEIP7702         = makeCallVariantGasCallEIP7702(gasCallEIP2929)
+	gasDelegateCallEIP7702 = makeCallVariantGasCallEIP7702(gasDelegateCallEIP2929)
+	gasStaticCallEIP7702   = makeCallVariantGasCallEIP7702(gasStaticCallEIP2929)
+	gasCallCodeEIP7702     = makeCallVariantGasCallEIP7702(gasCallCodeEIP2929)
+
 	gasCallEIP2929         = makeCallVariantGasCallEIP2929(gasCall)
 	gasDelegateCallEIP2929 = makeCallVariantGasCallEIP2929(gasDelegateCall)
 	gasStaticCallEIP2929   = makeCallVariantGasCallEIP2929(gasStaticCall)
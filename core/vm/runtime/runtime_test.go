@@ -17,25 +17,31 @@
 package runtime
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/holiman/uint256"
+
 	"github.com/ledgerwatch/erigon-lib/chain"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/kv/memdb"
 	"github.com/ledgerwatch/erigon/accounts/abi"
 	"github.com/ledgerwatch/erigon/common"
 	"github.com/ledgerwatch/erigon/consensus"
+	"github.com/ledgerwatch/erigon/consensus/misc"
 	"github.com/ledgerwatch/erigon/core"
 	"github.com/ledgerwatch/erigon/core/asm"
 	"github.com/ledgerwatch/erigon/core/state"
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm"
 	"github.com/ledgerwatch/erigon/eth/tracers/logger"
+	"github.com/ledgerwatch/erigon/params"
 )
 
 func TestDefaults(t *testing.T) {
@@ -133,6 +139,32 @@ func TestCall(t *testing.T) {
 	}
 }
 
+func TestCallDelegatedCode(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	state := state.New(state.NewDbStateReader(tx))
+	address := libcommon.HexToAddress("0xaa")
+	target := libcommon.HexToAddress("0xbb")
+	// the delegated code runs in the context of the delegating account
+	state.SetCode(target, []byte{
+		byte(vm.ADDRESS),
+		byte(vm.PUSH1), 0,
+		byte(vm.MSTORE),
+		byte(vm.PUSH1), 32,
+		byte(vm.PUSH1), 0,
+		byte(vm.RETURN),
+	})
+	state.SetCode(address, types.AddressToDelegation(target))
+
+	ret, _, err := Call(address, nil, &Config{State: state})
+	if err != nil {
+		t.Fatal("didn't expect error", err)
+	}
+	if got := libcommon.BytesToAddress(ret); got != address {
+		t.Errorf("Expected %x, got %x", address, got)
+	}
+}
+
 func BenchmarkCall(b *testing.B) {
 	var definition = `[{"constant":true,"inputs":[],"name":"seller","outputs":[{"name":"","type":"address"}],"type":"function"},{"constant":false,"inputs":[],"name":"abort","outputs":[],"type":"function"},{"constant":true,"inputs":[],"name":"value","outputs":[{"name":"","type":"uint256"}],"type":"function"},{"constant":false,"inputs":[],"name":"refund","outputs":[],"type":"function"},{"constant":true,"inputs":[],"name":"buyer","outputs":[{"name":"","type":"address"}],"type":"function"},{"constant":false,"inputs":[],"name":"confirmReceived","outputs":[],"type":"function"},{"constant":true,"inputs":[],"name":"state","outputs":[{"name":"","type":"uint8"}],"type":"function"},{"constant":false,"inputs":[],"name":"confirmPurchase","outputs":[],"type":"function"},{"inputs":[],"type":"constructor"},{"anonymous":false,"inputs":[],"name":"Aborted","type":"event"},{"anonymous":false,"inputs":[],"name":"PurchaseConfirmed","type":"event"},{"anonymous":false,"inputs":[],"name":"ItemReceived","type":"event"},{"anonymous":false,"inputs":[],"name":"Refunded","type":"event"}]`
 
@@ -341,6 +373,117 @@ func TestBlockhash(t *testing.T) {
 	}
 }
 
+// TestBlockhashHistoryStorage tests that after Prague BLOCKHASH keeps reading the header chain, whatever the history
+// storage contract of EIP-2935 holds.
+func TestBlockhashHistoryStorage(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	state := state.New(state.NewDbStateReader(tx))
+	slot := misc.BlockHashHistorySlot(900)
+	state.SetState(params.HistoryStorageAddress, &slot, *uint256.NewInt(0).SetBytes(libcommon.HexToHash("0x2935").Bytes()))
+
+	cfg := &Config{State: state, BlockNumber: big.NewInt(1000)}
+	setDefaults(cfg)
+	if !cfg.ChainConfig.IsPrague(cfg.Time.Uint64()) {
+		t.Fatal("expected the default config to be after Prague")
+	}
+	blockhash := func(number uint16) libcommon.Hash {
+		code := []byte{
+			byte(vm.PUSH2), byte(number >> 8), byte(number),
+			byte(vm.BLOCKHASH),
+			byte(vm.PUSH1), 0,
+			byte(vm.MSTORE),
+			byte(vm.PUSH1), 32,
+			byte(vm.PUSH1), 0,
+			byte(vm.RETURN),
+		}
+		ret, _, err := Execute(code, nil, cfg, 1000)
+		if err != nil {
+			t.Fatal("didn't expect error", err)
+		}
+		return libcommon.BytesToHash(ret)
+	}
+
+	for _, number := range []uint16{999, 900} {
+		if got, want := blockhash(number), cfg.GetHashFn(uint64(number)); got != want {
+			t.Errorf("Expected %x for block %d, got %x", want, number, got)
+		}
+	}
+	if got := blockhash(1000); got != (libcommon.Hash{}) {
+		t.Errorf("Expected no hash for the current block, got %x", got)
+	}
+}
+
+func TestCallEOFCode(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	state := state.New(state.NewDbStateReader(tx))
+	address := libcommon.HexToAddress("0xaa")
+	// section 0 adds 7 to the word of the data section with CALLF and returns the sum
+	code := common.FromHex("ef0001" + "010008" + "020002000e0002" + "ff0020" + "00" +
+		"00800002" + "02010002" +
+		"d10000" + "6007" + "e30001" + "5f" + "52" + "6020" + "5f" + "f3" +
+		"01" + "e4" +
+		"0000000000000000000000000000000000000000000000000000000000000005")
+	state.SetCode(address, code)
+
+	ret, _, err := Call(address, nil, &Config{State: state})
+	if err != nil {
+		t.Fatal("didn't expect error", err)
+	}
+	if got := new(big.Int).SetBytes(ret); got.Cmp(big.NewInt(12)) != 0 {
+		t.Errorf("Expected 12, got %v", got)
+	}
+
+	// Prague doesn't include EOF, the code is run as legacy code, whose 0xEF is invalid
+	cfg := &Config{State: state}
+	setDefaults(cfg)
+	pragueConfig := *cfg.ChainConfig
+	pragueConfig.OsakaTime = nil
+	cfg.ChainConfig = &pragueConfig
+	var invalidOpCode *vm.ErrInvalidOpCode
+	if _, _, err = Call(address, nil, cfg); !errors.As(err, &invalidOpCode) {
+		t.Errorf("Expected an invalid opcode before Osaka, got %v", err)
+	}
+}
+
+func TestCreateEOFContract(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	cfg := &Config{State: state.New(state.NewDbStateReader(tx))}
+	// the deployed container returns the word of its data section, which is appended on deployment
+	deployed := "ef0001" + "010004" + "0200010009" + "ff0020" + "00" +
+		"00800002" +
+		"d10000" + "5f" + "52" + "6020" + "5f" + "f3"
+	// the initcode appends the calldata to the data section of the deployed container
+	initcode := common.FromHex("ef0001" + "010004" + "020001000a" + "030001001c" + "ff0000" + "00" +
+		"00800003" +
+		"6020" + "5f" + "5f" + "37" + "6020" + "5f" + "ee00" +
+		deployed)
+	word := libcommon.HexToHash("0x2a")
+
+	_, address, _, err := Create(append(initcode, word.Bytes()...), cfg, 0)
+	if err != nil {
+		t.Fatal("didn't expect error", err)
+	}
+	if got, want := cfg.State.GetCode(address), append(common.FromHex(deployed), word.Bytes()...); !bytes.Equal(got, want) {
+		t.Errorf("Expected the deployed code %x, got %x", want, got)
+	}
+	ret, _, err := Call(address, nil, cfg)
+	if err != nil {
+		t.Fatal("didn't expect error", err)
+	}
+	if got := libcommon.BytesToHash(ret); got != word {
+		t.Errorf("Expected %x, got %x", word, got)
+	}
+
+	// invalid EOF initcode is rejected before the execution
+	_, _, _, err = Create(common.FromHex("ef0001"+"010004"+"0200010001"+"ff0000"+"00"+"00800000"+"00"), cfg, 0)
+	if !errors.Is(err, vm.ErrIncompatibleContainerKind) {
+		t.Errorf("Expected %v, got %v", vm.ErrIncompatibleContainerKind, err)
+	}
+}
+
 // benchmarkNonModifyingCode benchmarks code, but if the code modifies the
 // state, this should not be used, since it does not reset the state between runs.
 func benchmarkNonModifyingCode(b *testing.B, gas uint64, code []byte, name string) { //nolint:unparam
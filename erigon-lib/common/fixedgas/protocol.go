@@ -36,4 +36,8 @@ const (
 	BlobSize                       = FieldElementsPerBlob * 32
 	BlobGasPerBlob          uint64 = 0x20000
 	DefaultMaxBlobsPerBlock uint64 = 6 // lower for Gnosis
+
+	// EIP-7702: Set EOA account code
+	PerEmptyAccountCost uint64 = 25000 // Per authorization of a set code transaction
+	PerAuthBaseCost     uint64 = 12500 // Per authorization whose authority already exists, the rest is refunded
 )
@@ -119,3 +119,19 @@ func (s *TxPoolClient) Status(ctx context.Context, in *txpool_proto.StatusReques
 func (s *TxPoolClient) Nonce(ctx context.Context, in *txpool_proto.NonceRequest, opts ...grpc.CallOption) (*txpool_proto.NonceReply, error) {
 	return s.server.Nonce(ctx, in)
 }
+
+func (s *TxPoolClient) Lifecycle(ctx context.Context, in *txpool_proto.LifecycleRequest, opts ...grpc.CallOption) (*txpool_proto.LifecycleReply, error) {
+	return s.server.Lifecycle(ctx, in)
+}
+
+func (s *TxPoolClient) SendBundle(ctx context.Context, in *txpool_proto.SendBundleRequest, opts ...grpc.CallOption) (*txpool_proto.SendBundleReply, error) {
+	return s.server.SendBundle(ctx, in)
+}
+
+func (s *TxPoolClient) Locals(ctx context.Context, in *txpool_proto.LocalsRequest, opts ...grpc.CallOption) (*txpool_proto.LocalsReply, error) {
+	return s.server.Locals(ctx, in)
+}
+
+func (s *TxPoolClient) EvictLocal(ctx context.Context, in *txpool_proto.EvictLocalRequest, opts ...grpc.CallOption) (*txpool_proto.EvictLocalReply, error) {
+	return s.server.EvictLocal(ctx, in)
+}
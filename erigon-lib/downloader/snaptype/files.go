@@ -42,6 +42,7 @@ const (
 	BorEvents
 	BorSpans
 	BeaconBlocks
+	BlobSidecars
 )
 
 func (ft Type) String() string {
@@ -58,6 +59,8 @@ func (ft Type) String() string {
 		return "borspans"
 	case BeaconBlocks:
 		return "beaconblocks"
+	case BlobSidecars:
+		return "blobsidecars"
 	default:
 		panic(fmt.Sprintf("unknown file type: %d", ft))
 	}
@@ -77,6 +80,8 @@ func ParseFileType(s string) (Type, bool) {
 		return BorSpans, true
 	case "beaconblocks":
 		return BeaconBlocks, true
+	case "blobsidecars":
+		return BlobSidecars, true
 	default:
 		return Unknown, false
 	}
@@ -30,6 +30,10 @@ const (
 	Txpool_OnAdd_FullMethodName        = "/txpool.Txpool/OnAdd"
 	Txpool_Status_FullMethodName       = "/txpool.Txpool/Status"
 	Txpool_Nonce_FullMethodName        = "/txpool.Txpool/Nonce"
+	Txpool_Lifecycle_FullMethodName    = "/txpool.Txpool/Lifecycle"
+	Txpool_SendBundle_FullMethodName   = "/txpool.Txpool/SendBundle"
+	Txpool_Locals_FullMethodName       = "/txpool.Txpool/Locals"
+	Txpool_EvictLocal_FullMethodName   = "/txpool.Txpool/EvictLocal"
 )
 
 // TxpoolClient is the client API for Txpool service.
@@ -55,6 +59,14 @@ type TxpoolClient interface {
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusReply, error)
 	// returns nonce for given account
 	Nonce(ctx context.Context, in *NonceRequest, opts ...grpc.CallOption) (*NonceReply, error)
+	// returns the lifecycle events of a recently seen transaction, oldest first
+	Lifecycle(ctx context.Context, in *LifecycleRequest, opts ...grpc.CallOption) (*LifecycleReply, error)
+	// Expecting signed transactions, which are included together and in order in the target block or not at all
+	SendBundle(ctx context.Context, in *SendBundleRequest, opts ...grpc.CallOption) (*SendBundleReply, error)
+	// returns the local transactions in the pool, which are journaled and rebroadcast
+	Locals(ctx context.Context, in *LocalsRequest, opts ...grpc.CallOption) (*LocalsReply, error)
+	// drops a local transaction from the pool and from the journal
+	EvictLocal(ctx context.Context, in *EvictLocalRequest, opts ...grpc.CallOption) (*EvictLocalReply, error)
 }
 
 type txpoolClient struct {
@@ -169,6 +181,42 @@ func (c *txpoolClient) Nonce(ctx context.Context, in *NonceRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *txpoolClient) Lifecycle(ctx context.Context, in *LifecycleRequest, opts ...grpc.CallOption) (*LifecycleReply, error) {
+	out := new(LifecycleReply)
+	err := c.cc.Invoke(ctx, Txpool_Lifecycle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txpoolClient) SendBundle(ctx context.Context, in *SendBundleRequest, opts ...grpc.CallOption) (*SendBundleReply, error) {
+	out := new(SendBundleReply)
+	err := c.cc.Invoke(ctx, Txpool_SendBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txpoolClient) Locals(ctx context.Context, in *LocalsRequest, opts ...grpc.CallOption) (*LocalsReply, error) {
+	out := new(LocalsReply)
+	err := c.cc.Invoke(ctx, Txpool_Locals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txpoolClient) EvictLocal(ctx context.Context, in *EvictLocalRequest, opts ...grpc.CallOption) (*EvictLocalReply, error) {
+	out := new(EvictLocalReply)
+	err := c.cc.Invoke(ctx, Txpool_EvictLocal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TxpoolServer is the server API for Txpool service.
 // All implementations must embed UnimplementedTxpoolServer
 // for forward compatibility
@@ -192,6 +240,14 @@ type TxpoolServer interface {
 	Status(context.Context, *StatusRequest) (*StatusReply, error)
 	// returns nonce for given account
 	Nonce(context.Context, *NonceRequest) (*NonceReply, error)
+	// returns the lifecycle events of a recently seen transaction, oldest first
+	Lifecycle(context.Context, *LifecycleRequest) (*LifecycleReply, error)
+	// Expecting signed transactions, which are included together and in order in the target block or not at all
+	SendBundle(context.Context, *SendBundleRequest) (*SendBundleReply, error)
+	// returns the local transactions in the pool, which are journaled and rebroadcast
+	Locals(context.Context, *LocalsRequest) (*LocalsReply, error)
+	// drops a local transaction from the pool and from the journal
+	EvictLocal(context.Context, *EvictLocalRequest) (*EvictLocalReply, error)
 	mustEmbedUnimplementedTxpoolServer()
 }
 
@@ -226,6 +282,18 @@ func (UnimplementedTxpoolServer) Status(context.Context, *StatusRequest) (*Statu
 func (UnimplementedTxpoolServer) Nonce(context.Context, *NonceRequest) (*NonceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Nonce not implemented")
 }
+func (UnimplementedTxpoolServer) Lifecycle(context.Context, *LifecycleRequest) (*LifecycleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lifecycle not implemented")
+}
+func (UnimplementedTxpoolServer) SendBundle(context.Context, *SendBundleRequest) (*SendBundleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendBundle not implemented")
+}
+func (UnimplementedTxpoolServer) Locals(context.Context, *LocalsRequest) (*LocalsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locals not implemented")
+}
+func (UnimplementedTxpoolServer) EvictLocal(context.Context, *EvictLocalRequest) (*EvictLocalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictLocal not implemented")
+}
 func (UnimplementedTxpoolServer) mustEmbedUnimplementedTxpoolServer() {}
 
 // UnsafeTxpoolServer may be embedded to opt out of forward compatibility for this service.
@@ -404,6 +472,78 @@ func _Txpool_Nonce_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Txpool_Lifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxpoolServer).Lifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Txpool_Lifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxpoolServer).Lifecycle(ctx, req.(*LifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Txpool_SendBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxpoolServer).SendBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Txpool_SendBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxpoolServer).SendBundle(ctx, req.(*SendBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Txpool_Locals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxpoolServer).Locals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Txpool_Locals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxpoolServer).Locals(ctx, req.(*LocalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Txpool_EvictLocal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictLocalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxpoolServer).EvictLocal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Txpool_EvictLocal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxpoolServer).EvictLocal(ctx, req.(*EvictLocalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Txpool_ServiceDesc is the grpc.ServiceDesc for Txpool service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -443,6 +583,22 @@ var Txpool_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Nonce",
 			Handler:    _Txpool_Nonce_Handler,
 		},
+		{
+			MethodName: "Lifecycle",
+			Handler:    _Txpool_Lifecycle_Handler,
+		},
+		{
+			MethodName: "SendBundle",
+			Handler:    _Txpool_SendBundle_Handler,
+		},
+		{
+			MethodName: "Locals",
+			Handler:    _Txpool_Locals_Handler,
+		},
+		{
+			MethodName: "EvictLocal",
+			Handler:    _Txpool_EvictLocal_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
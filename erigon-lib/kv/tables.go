@@ -459,6 +459,10 @@ const (
 	LightClient = "LightClient"
 	// Period (one every 27 hours) => LightClientUpdate
 	LightClientUpdates = "LightClientUpdates"
+	// Slot + Block Root => LightClientBootstrap
+	LightClientBootstraps = "LightClientBootstraps"
+	// Block Root => Number of blob sidecars stored for the block
+	BlockRootToKzgCommitments = "BlockRootToKzgCommitments"
 	// Beacon historical data
 	// ValidatorIndex => [Field]
 	ValidatorPublicKeys         = "ValidatorPublickeys"
@@ -652,6 +656,8 @@ var ChaindataTables = []string{
 	Attestetations,
 	LightClient,
 	LightClientUpdates,
+	LightClientBootstraps,
+	BlockRootToKzgCommitments,
 	BlockRootToBlockHash,
 	BlockRootToBlockNumber,
 	LastBeaconSnapshot,
@@ -0,0 +1,113 @@
+package txpool
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/types"
+)
+
+const (
+	maxBundlesPerBlock = 256 // bundles kept per target block, later ones are rejected
+	maxBundleTxs       = 32  // transactions per bundle
+)
+
+var (
+	ErrEmptyBundle        = errors.New("bundle has no transactions")
+	ErrBundleTooLarge     = fmt.Errorf("bundle has more than %d transactions", maxBundleTxs)
+	ErrBundleTargetPassed = errors.New("bundle target block is already mined")
+	ErrTooManyBundles     = fmt.Errorf("more than %d bundles target the block", maxBundlesPerBlock)
+)
+
+// Bundle is a group of transactions which are included together, in order, in the target block or not at all
+type Bundle struct {
+	Hash              common.Hash              // keccak256 of the concatenated transaction hashes
+	BlockNum          uint64                   // the only block the bundle may be included in
+	Txs               types.TxsRlp             // in inclusion order
+	RevertingTxHashes map[common.Hash]struct{} // transactions allowed to revert without dropping the bundle
+}
+
+// CanRevert tells whether the transaction may revert without dropping the bundle
+func (b *Bundle) CanRevert(txHash common.Hash) bool {
+	_, ok := b.RevertingTxHashes[txHash]
+	return ok
+}
+
+// bundlesByBlock keeps the bundles until their target block is mined: block_num => bundles : non-persisted
+type bundlesByBlock struct {
+	byBlock map[uint64][]*Bundle
+	byHash  map[common.Hash]*Bundle
+}
+
+func newBundlesByBlock() *bundlesByBlock {
+	return &bundlesByBlock{byBlock: map[uint64][]*Bundle{}, byHash: map[common.Hash]*Bundle{}}
+}
+
+func (b *bundlesByBlock) add(bundle *Bundle) error {
+	if _, ok := b.byHash[bundle.Hash]; ok {
+		return nil
+	}
+	if len(b.byBlock[bundle.BlockNum]) >= maxBundlesPerBlock {
+		return ErrTooManyBundles
+	}
+	b.byBlock[bundle.BlockNum] = append(b.byBlock[bundle.BlockNum], bundle)
+	b.byHash[bundle.Hash] = bundle
+	return nil
+}
+
+// prune drops the bundles targeting the mined blocks
+func (b *bundlesByBlock) prune(minedBlockNum uint64) {
+	for blockNum, bundles := range b.byBlock {
+		if blockNum > minedBlockNum {
+			continue
+		}
+		for _, bundle := range bundles {
+			delete(b.byHash, bundle.Hash)
+		}
+		delete(b.byBlock, blockNum)
+	}
+}
+
+// AddBundle keeps a bundle of parsed transactions until its target block is mined, and returns its hash.
+// Adding the same bundle again is a noop.
+func (p *TxPool) AddBundle(txs types.TxSlots, blockNum uint64, revertingTxHashes []common.Hash) (common.Hash, error) {
+	if len(txs.Txs) == 0 {
+		return common.Hash{}, ErrEmptyBundle
+	}
+	if len(txs.Txs) > maxBundleTxs {
+		return common.Hash{}, ErrBundleTooLarge
+	}
+	bundle := &Bundle{BlockNum: blockNum, RevertingTxHashes: make(map[common.Hash]struct{}, len(revertingTxHashes))}
+	keccak := sha3.NewLegacyKeccak256()
+	bundle.Txs.Resize(uint(len(txs.Txs)))
+	for i, txn := range txs.Txs {
+		keccak.Write(txn.IDHash[:])
+		bundle.Txs.Txs[i] = common.Copy(txn.Rlp)
+		copy(bundle.Txs.Senders.At(i), txs.Senders.At(i))
+		bundle.Txs.IsLocal[i] = true
+	}
+	keccak.Sum(bundle.Hash[:0])
+	for _, hash := range revertingTxHashes {
+		bundle.RevertingTxHashes[hash] = struct{}{}
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if blockNum <= p.lastSeenBlock.Load() {
+		return common.Hash{}, ErrBundleTargetPassed
+	}
+	if err := p.bundles.add(bundle); err != nil {
+		return common.Hash{}, err
+	}
+	return bundle.Hash, nil
+}
+
+// Bundles returns the bundles targeting the block, in the order they were added
+func (p *TxPool) Bundles(blockNum uint64) []*Bundle {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return append([]*Bundle(nil), p.bundles.byBlock[blockNum]...)
+}
@@ -298,6 +298,9 @@ func (f *Fetch) handleInboundMessage(ctx context.Context, req *sentry.InboundMes
 			}
 
 			txnHash := hashes[i:cmp.Min(i+hashSize, len(hashes))]
+			if f.pool.IsPrivate(txnHash) {
+				continue
+			}
 			txn, err := f.pool.GetRlp(tx, txnHash)
 			if err != nil {
 				return err
@@ -479,11 +482,13 @@ func (f *Fetch) handleStateChangesRequest(ctx context.Context, req *remote.State
 	var unwindTxs, minedTxs types2.TxSlots
 	for _, change := range req.ChangeBatch {
 		if change.Direction == remote.Direction_FORWARD {
-			minedTxs.Resize(uint(len(change.Txs)))
+			// the transactions of all the blocks are kept, in order, for the pool to tell which block mined them
+			offset := len(minedTxs.Txs)
+			minedTxs.Resize(uint(offset + len(change.Txs)))
 			for i := range change.Txs {
-				minedTxs.Txs[i] = &types2.TxSlot{}
+				minedTxs.Txs[offset+i] = &types2.TxSlot{}
 				if err := f.threadSafeParseStateChangeTxn(func(parseContext *types2.TxParseContext) error {
-					_, err := parseContext.ParseTransaction(change.Txs[i], 0, minedTxs.Txs[i], minedTxs.Senders.At(i), false /* hasEnvelope */, false /* wrappedWithBlobs */, nil)
+					_, err := parseContext.ParseTransaction(change.Txs[i], 0, minedTxs.Txs[offset+i], minedTxs.Senders.At(offset+i), false /* hasEnvelope */, false /* wrappedWithBlobs */, nil)
 					return err
 				}); err != nil && !errors.Is(err, context.Canceled) {
 					f.logger.Warn("[txpool.fetch] stream.Recv", "err", err)
@@ -30,6 +30,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/gointerfaces/remote"
 	"github.com/ledgerwatch/erigon-lib/gointerfaces/sentry"
 	"github.com/ledgerwatch/erigon-lib/gointerfaces/types"
+	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/memdb"
 	types3 "github.com/ledgerwatch/erigon-lib/types"
 	"github.com/ledgerwatch/log/v3"
@@ -190,3 +191,37 @@ func TestOnNewBlock(t *testing.T) {
 	assert.Equal(t, 1, len(pool.OnNewBlockCalls()))
 	assert.Equal(t, 3, len(pool.OnNewBlockCalls()[0].MinedTxs.Txs))
 }
+
+func TestGetPooledTransactionsSkipsPrivate(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var privateHash, publicHash [32]byte
+	privateHash[0], publicHash[0] = 1, 2
+	pool := &PoolMock{
+		StartedFunc:   func() bool { return true },
+		IsPrivateFunc: func(hash []byte) bool { return hash[0] == privateHash[0] },
+		GetRlpFunc:    func(tx kv.Tx, hash []byte) ([]byte, error) { return []byte{0xc0}, nil },
+	}
+	var sent []*sentry.SendMessageByIdRequest
+	sentryClient := &sentry.SentryClientMock{
+		SendMessageByIdFunc: func(ctx context.Context, in *sentry.SendMessageByIdRequest, opts ...grpc.CallOption) (*sentry.SentPeers, error) {
+			sent = append(sent, in)
+			return &sentry.SentPeers{}, nil
+		},
+	}
+	fetch := NewFetch(ctx, nil, pool, &remote.KVClientMock{}, nil, memdb.NewTestPoolDB(t), *u256.N1, log.New())
+	request, err := types3.EncodeGetPooledTransactions66(append(privateHash[:], publicHash[:]...), 1, nil)
+	require.NoError(err)
+	require.NoError(fetch.handleInboundMessage(ctx, &sentry.InboundMessage{
+		Id:     sentry.MessageId_GET_POOLED_TRANSACTIONS_66,
+		Data:   request,
+		PeerId: peerID,
+	}, sentryClient))
+
+	// the private transaction is neither read nor sent
+	require.Len(pool.GetRlpCalls(), 1)
+	require.Equal(publicHash[:], pool.GetRlpCalls()[0].Hash)
+	require.Len(sent, 1)
+	require.Equal(types3.EncodePooledTransactions66([][]byte{{0xc0}}, 1, nil), sent[0].Data.Data)
+}
@@ -0,0 +1,94 @@
+package txpool
+
+import (
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
+)
+
+// lifecycleHistorySize is the number of transactions whose events are kept, enough for full sub-pools
+// with the default limits and the transactions recently removed from them
+const lifecycleHistorySize = 100_000
+
+// maxTxEvents is the number of events kept per transaction, the first one and the latest ones
+const maxTxEvents = 32
+
+type TxEventType uint8
+
+const (
+	TxAdded      TxEventType = iota // added to the pool
+	TxReinjected                    // added back to the pool after the block including it was unwound
+	TxMoved                         // moved to another sub-pool
+	TxDiscarded                     // removed from the pool
+	TxMined                         // included in a block
+)
+
+func (t TxEventType) String() string {
+	switch t {
+	case TxAdded:
+		return "added"
+	case TxReinjected:
+		return "reinjected"
+	case TxMoved:
+		return "moved"
+	case TxDiscarded:
+		return "discarded"
+	case TxMined:
+		return "mined"
+	}
+	return "unknown"
+}
+
+// TxEvent is something which happened to a transaction in the pool
+type TxEvent struct {
+	Type       TxEventType
+	Time       time.Time
+	SubPool    SubPoolType             // sub-pool the transaction was moved to, TxMoved only
+	Reason     txpoolcfg.DiscardReason // why the transaction was removed, TxDiscarded only
+	ReplacedBy common.Hash             // transaction which replaced this one, if it was replaced
+	BlockNum   uint64                  // block which mined the transaction, TxMined only
+}
+
+// lifecycleHistory keeps the events of the recently seen transactions: tx_hash => events : non-persisted
+type lifecycleHistory struct {
+	events *simplelru.LRU[string, []TxEvent]
+}
+
+func newLifecycleHistory(size int) (*lifecycleHistory, error) {
+	events, err := simplelru.NewLRU[string, []TxEvent](size, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &lifecycleHistory{events: events}, nil
+}
+
+// record appends an event to the history of the transaction, the history may be nil
+func (h *lifecycleHistory) record(hash []byte, event TxEvent) {
+	if h == nil {
+		return
+	}
+	event.Time = time.Now()
+	events, _ := h.events.Get(string(hash))
+	if len(events) >= maxTxEvents {
+		events = append(events[:1], events[2:]...)
+	}
+	h.events.Add(string(hash), append(events, event))
+}
+
+// replaced sets the transaction which replaced the given one on its latest event
+func (h *lifecycleHistory) replaced(hash []byte, by common.Hash) {
+	if h == nil {
+		return
+	}
+	if events, ok := h.events.Peek(string(hash)); ok && len(events) > 0 {
+		events[len(events)-1].ReplacedBy = by
+	}
+}
+
+func (h *lifecycleHistory) get(hash common.Hash) []TxEvent {
+	events, _ := h.events.Get(string(hash[:]))
+	return append([]TxEvent(nil), events...)
+}
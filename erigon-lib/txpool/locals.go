@@ -0,0 +1,295 @@
+package txpool
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/cmp"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
+	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
+	"github.com/ledgerwatch/erigon-lib/types"
+)
+
+// maxRebroadcastBackoff caps the interval between rebroadcasts of a local transaction, in cfg.RebroadcastEvery
+const maxRebroadcastBackoff = 32
+
+// LocalTx is a local transaction in the pool
+type LocalTx struct {
+	Hash    common.Hash
+	Sender  common.Address
+	Nonce   uint64
+	SubPool SubPoolType
+	Private bool // never gossiped, and not journaled as it's dropped after PrivateTxLifetime blocks anyway
+	Rlp     []byte
+}
+
+// journalEntry is a line of the journal, which is a JSON document per local transaction
+type journalEntry struct {
+	Hash   common.Hash      `json:"hash"`
+	Sender common.Address   `json:"sender"`
+	Rlp    hexutility.Bytes `json:"rlp"`
+}
+
+// rebroadcast is when a local transaction is sent to the peers again, the interval doubles each time
+type rebroadcast struct {
+	at       time.Time
+	interval time.Duration
+}
+
+// Locals returns the local transactions in the pool, ordered by sender and nonce
+func (p *TxPool) Locals(tx kv.Tx) ([]LocalTx, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var locals []LocalTx
+	var err error
+	p.all.ascendAll(func(mt *metaTx) bool {
+		if mt.subPool&IsLocal == 0 {
+			return true
+		}
+		var rlpTx []byte
+		var sender common.Address
+		rlpTx, sender, _, err = p.getRlpLocked(tx, mt.Tx.IDHash[:])
+		if err != nil {
+			return false
+		}
+		if rlpTx == nil {
+			p.logger.Warn("[txpool] locals: tx not found in db", "hash", common.Hash(mt.Tx.IDHash))
+			return true
+		}
+		_, private := p.private[string(mt.Tx.IDHash[:])]
+		locals = append(locals, LocalTx{
+			Hash:    mt.Tx.IDHash,
+			Sender:  sender,
+			Nonce:   mt.Tx.Nonce,
+			SubPool: mt.currentSubPool,
+			Private: private,
+			Rlp:     common.Copy(rlpTx),
+		})
+		return true
+	})
+	return locals, err
+}
+
+// EvictLocal drops a local transaction from the pool, and rewrites the journal without it.
+// Returns false if the transaction is not a local one in the pool.
+func (p *TxPool) EvictLocal(tx kv.Tx, hash common.Hash) (bool, error) {
+	if !p.evictLocal(hash) {
+		return false, nil
+	}
+	return true, p.journal(tx)
+}
+
+func (p *TxPool) evictLocal(hash common.Hash) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	hashStr := string(hash[:])
+	mt, ok := p.byHash[hashStr]
+	if !ok || mt.subPool&IsLocal == 0 {
+		return false
+	}
+	switch mt.currentSubPool {
+	case PendingSubPool:
+		p.pending.Remove(mt)
+	case BaseFeeSubPool:
+		p.baseFee.Remove(mt)
+	case QueuedSubPool:
+		p.queued.Remove(mt)
+	default:
+		//already removed
+	}
+	p.discardLocked(mt, txpoolcfg.EvictedLocal)
+	// not restored as a local one if its block is unwound
+	p.isLocalLRU.Remove(hashStr)
+	delete(p.private, hashStr)
+	delete(p.rebroadcasts, hashStr)
+	return true
+}
+
+// journal rewrites the journal with the local transactions in the pool. Transactions are read from the db
+// once flushed, so it's done after the flush.
+func (p *TxPool) journal(tx kv.Tx) error {
+	if p.cfg.Journal == "" {
+		return nil
+	}
+	locals, err := p.Locals(tx)
+	if err != nil {
+		return err
+	}
+	p.journalLock.Lock()
+	defer p.journalLock.Unlock()
+	return writeJournal(p.cfg.Journal, locals)
+}
+
+// fromJournal adds to txs the journaled transactions which are not in the pool db, as it happens if the
+// db was lost, with the local flag.
+func (p *TxPool) fromJournal(tx kv.Tx, parseCtx *types.TxParseContext, cacheView kvcache.CacheView, txs *types.TxSlots) error {
+	if p.cfg.Journal == "" {
+		return nil
+	}
+	entries, err := readJournal(p.cfg.Journal)
+	if err != nil {
+		// the transactions before the broken line are still restored
+		p.logger.Warn("[txpool] fromJournal: read journal", "err", err)
+	}
+	restored := 0
+	for _, entry := range entries {
+		txn := &types.TxSlot{}
+		if _, err := parseCtx.ParseTransaction(entry.Rlp, 0, txn, nil, false /* hasEnvelope */, true /*wrappedWithBlobs*/, nil); err != nil {
+			p.logger.Warn("[txpool] fromJournal: parseTransaction", "hash", entry.Hash, "err", err)
+			continue
+		}
+		has, err := tx.Has(kv.PoolTransaction, txn.IDHash[:])
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+		txn.SenderID, txn.Traced = p.senders.getOrCreateID(entry.Sender, p.logger)
+		if reason := p.validateTx(txn, true /* isLocal */, cacheView); reason != txpoolcfg.NotSet && reason != txpoolcfg.Success {
+			p.logger.Debug("[txpool] fromJournal: skipping tx", "hash", entry.Hash, "reason", reason)
+			continue
+		}
+		p.isLocalLRU.Add(string(txn.IDHash[:]), struct{}{})
+		i := len(txs.Txs)
+		txs.Resize(uint(i + 1))
+		txs.Txs[i] = txn
+		txs.IsLocal[i] = true
+		copy(txs.Senders.At(i), entry.Sender[:])
+		restored++
+	}
+	if restored > 0 {
+		p.logger.Info("[txpool] Local txs restored from journal", "amount", restored)
+	}
+	return nil
+}
+
+// writeJournal replaces the journal atomically, so a crash leaves either the previous one or the new one
+func writeJournal(path string, locals []LocalTx) error {
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, local := range locals {
+		if local.Private {
+			continue
+		}
+		if err := enc.Encode(journalEntry{Hash: local.Hash, Sender: local.Sender, Rlp: local.Rlp}); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// readJournal returns the journaled transactions, until the first broken line if any. A missing journal is empty.
+func readJournal(path string) ([]journalEntry, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var entries []journalEntry
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for {
+		var entry journalEntry
+		if err := dec.Decode(&entry); err != nil {
+			if errors.Is(err, io.EOF) {
+				return entries, nil
+			}
+			return entries, fmt.Errorf("journal %s: %w", path, err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// appendLocalRebroadcasts appends the local transactions due for a rebroadcast, and schedules the next one.
+// A transaction is first scheduled when seen here, as it was just broadcast when added.
+func (p *TxPool) appendLocalRebroadcasts(now time.Time, types []byte, sizes []uint32, hashes []byte) ([]byte, []uint32, []byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for hash := range p.rebroadcasts {
+		if _, ok := p.byHash[hash]; !ok {
+			delete(p.rebroadcasts, hash)
+		}
+	}
+	for hash, txn := range p.byHash {
+		if txn.subPool&IsLocal == 0 {
+			continue
+		}
+		if _, ok := p.private[hash]; ok {
+			continue
+		}
+		r, ok := p.rebroadcasts[hash]
+		if !ok {
+			p.rebroadcasts[hash] = &rebroadcast{at: now.Add(p.cfg.RebroadcastEvery), interval: p.cfg.RebroadcastEvery}
+			continue
+		}
+		if now.Before(r.at) {
+			continue
+		}
+		types = append(types, txn.Tx.Type)
+		sizes = append(sizes, txn.Tx.Size)
+		hashes = append(hashes, hash...)
+		r.interval = cmp.Min(2*r.interval, maxRebroadcastBackoff*p.cfg.RebroadcastEvery)
+		r.at = now.Add(r.interval)
+	}
+	return types, sizes, hashes
+}
+
+// rebroadcastLocals sends the local transactions due for it to the peers again, as they may have been lost
+// by them, for example if they are stuck in queued
+func (p *TxPool) rebroadcastLocals(ctx context.Context, db kv.RoDB, send *Send) {
+	txTypes, txSizes, txHashes := p.appendLocalRebroadcasts(time.Now(), nil, nil, nil)
+	if len(txTypes) == 0 {
+		return
+	}
+	hashes := types.Hashes(txHashes)
+	var txRlps [][]byte
+	if err := db.View(ctx, func(tx kv.Tx) error {
+		for i, t := range txTypes {
+			// "Nodes MUST NOT automatically broadcast blob transactions to their peers" - EIP-4844
+			if t == types.BlobTxType {
+				continue
+			}
+			txRlp, err := p.GetRlp(tx, hashes.At(i))
+			if err != nil {
+				return err
+			}
+			if len(txRlp) > 0 {
+				txRlps = append(txRlps, txRlp)
+			}
+		}
+		return nil
+	}); err != nil {
+		p.logger.Error("[txpool] collect local txs to rebroadcast", "err", err)
+		return
+	}
+	send.BroadcastPooledTxs(txRlps, localTxsBroadcastMaxPeers)
+	send.AnnouncePooledTxs(txTypes, txSizes, hashes, localTxsBroadcastMaxPeers*2)
+	p.logger.Debug("[txpool] Local txs rebroadcast", "amount", len(txTypes))
+}
@@ -43,6 +43,9 @@ var _ Pool = &PoolMock{}
 //			IdHashKnownFunc: func(tx kv.Tx, hash []byte) (bool, error) {
 //				panic("mock out the IdHashKnown method")
 //			},
+//			IsPrivateFunc: func(hash []byte) bool {
+//				panic("mock out the IsPrivate method")
+//			},
 //			OnNewBlockFunc: func(ctx context.Context, stateChanges *remote.StateChangeBatch, unwindTxs types2.TxSlots, minedTxs types2.TxSlots, tx kv.Tx) error {
 //				panic("mock out the OnNewBlock method")
 //			},
@@ -80,6 +83,9 @@ type PoolMock struct {
 	// IdHashKnownFunc mocks the IdHashKnown method.
 	IdHashKnownFunc func(tx kv.Tx, hash []byte) (bool, error)
 
+	// IsPrivateFunc mocks the IsPrivate method.
+	IsPrivateFunc func(hash []byte) bool
+
 	// OnNewBlockFunc mocks the OnNewBlock method.
 	OnNewBlockFunc func(ctx context.Context, stateChanges *remote.StateChangeBatch, unwindTxs types2.TxSlots, minedTxs types2.TxSlots, tx kv.Tx) error
 
@@ -140,6 +146,11 @@ type PoolMock struct {
 			// Hash is the hash argument value.
 			Hash []byte
 		}
+		// IsPrivate holds details about calls to the IsPrivate method.
+		IsPrivate []struct {
+			// Hash is the hash argument value.
+			Hash []byte
+		}
 		// OnNewBlock holds details about calls to the OnNewBlock method.
 		OnNewBlock []struct {
 			// Ctx is the ctx argument value.
@@ -169,6 +180,7 @@ type PoolMock struct {
 	lockGetKnownBlobTxn       sync.RWMutex
 	lockGetRlp                sync.RWMutex
 	lockIdHashKnown           sync.RWMutex
+	lockIsPrivate             sync.RWMutex
 	lockOnNewBlock            sync.RWMutex
 	lockStarted               sync.RWMutex
 	lockValidateSerializedTxn sync.RWMutex
@@ -446,6 +458,41 @@ func (mock *PoolMock) IdHashKnownCalls() []struct {
 	return calls
 }
 
+// IsPrivate calls IsPrivateFunc.
+func (mock *PoolMock) IsPrivate(hash []byte) bool {
+	callInfo := struct {
+		Hash []byte
+	}{
+		Hash: hash,
+	}
+	mock.lockIsPrivate.Lock()
+	mock.calls.IsPrivate = append(mock.calls.IsPrivate, callInfo)
+	mock.lockIsPrivate.Unlock()
+	if mock.IsPrivateFunc == nil {
+		var (
+			bOut bool
+		)
+		return bOut
+	}
+	return mock.IsPrivateFunc(hash)
+}
+
+// IsPrivateCalls gets all the calls that were made to IsPrivate.
+// Check the length with:
+//
+//	len(mockedPool.IsPrivateCalls())
+func (mock *PoolMock) IsPrivateCalls() []struct {
+	Hash []byte
+} {
+	var calls []struct {
+		Hash []byte
+	}
+	mock.lockIsPrivate.RLock()
+	calls = mock.calls.IsPrivate
+	mock.lockIsPrivate.RUnlock()
+	return calls
+}
+
 // OnNewBlock calls OnNewBlockFunc.
 func (mock *PoolMock) OnNewBlock(ctx context.Context, stateChanges *remote.StateChangeBatch, unwindTxs types2.TxSlots, minedTxs types2.TxSlots, tx kv.Tx) error {
 	callInfo := struct {
@@ -0,0 +1,80 @@
+package txpool
+
+import (
+	"fmt"
+
+	"github.com/holiman/uint256"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
+)
+
+// bestOrdering orders the pending transactions offered for block building. It must never prefer a
+// transaction to one of the same sender with a lower nonce. For this the orderings compare a key
+// aggregated over the transaction and the ones of the sender before it, as minTip and maxArrival
+// are, and then the nonce distance.
+type bestOrdering interface {
+	better(mt, than *metaTx, pendingBaseFee uint256.Int) bool
+}
+
+// priceOrdering offers the transactions with the highest effective tip first
+type priceOrdering struct{}
+
+func (priceOrdering) better(mt, than *metaTx, pendingBaseFee uint256.Int) bool {
+	return mt.better(than, pendingBaseFee)
+}
+
+// arrivalOrdering offers the transactions in the order they arrived, first come first served
+type arrivalOrdering struct{}
+
+func (arrivalOrdering) better(mt, than *metaTx, _ uint256.Int) bool {
+	if mt.maxArrival != than.maxArrival {
+		return mt.maxArrival < than.maxArrival
+	}
+	return mt.nonceDistance < than.nonceDistance
+}
+
+// priorityOrdering offers the transactions of the priority senders first, each lane ordered by next.
+// The senders are kept by address, as their IDs are deleted when they have no transactions left.
+type priorityOrdering struct {
+	senders map[common.Address]struct{}
+	ids     *sendersBatch
+	next    bestOrdering
+}
+
+func (o priorityOrdering) better(mt, than *metaTx, pendingBaseFee uint256.Int) bool {
+	priority, thanPriority := o.priority(mt.Tx.SenderID), o.priority(than.Tx.SenderID)
+	if priority != thanPriority {
+		return priority
+	}
+	return o.next.better(mt, than, pendingBaseFee)
+}
+
+func (o priorityOrdering) priority(senderID uint64) bool {
+	addr, ok := o.ids.senderID2Addr[senderID]
+	if !ok {
+		return false
+	}
+	_, ok = o.senders[addr]
+	return ok
+}
+
+func newBestOrdering(cfg txpoolcfg.Config, senders *sendersBatch) (bestOrdering, error) {
+	var ordering bestOrdering
+	switch cfg.Ordering {
+	case txpoolcfg.OrderByPrice, "":
+		ordering = priceOrdering{}
+	case txpoolcfg.OrderByArrival:
+		ordering = arrivalOrdering{}
+	default:
+		return nil, fmt.Errorf("unknown transaction ordering %q, expected %q or %q", cfg.Ordering, txpoolcfg.OrderByPrice, txpoolcfg.OrderByArrival)
+	}
+	if len(cfg.PrioritySenders) == 0 {
+		return ordering, nil
+	}
+	priority := priorityOrdering{senders: make(map[common.Address]struct{}, len(cfg.PrioritySenders)), ids: senders, next: ordering}
+	for _, sender := range cfg.PrioritySenders {
+		priority.senders[common.BytesToAddress([]byte(sender))] = struct{}{}
+	}
+	return priority, nil
+}
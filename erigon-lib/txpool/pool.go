@@ -89,6 +89,8 @@ type Pool interface {
 	FilterKnownIdHashes(tx kv.Tx, hashes types.Hashes) (unknownHashes types.Hashes, err error)
 	Started() bool
 	GetRlp(tx kv.Tx, hash []byte) ([]byte, error)
+	// IsPrivate checks whether the transaction must not be sent to peers
+	IsPrivate(hash []byte) bool
 	GetKnownBlobTxn(tx kv.Tx, hash []byte) (*metaTx, error)
 
 	AddNewGoodPeer(peerID types.PeerID)
@@ -124,6 +126,8 @@ type metaTx struct {
 	bestIndex                 int
 	worstIndex                int
 	timestamp                 uint64 // when it was added to pool
+	arrival                   uint64 // order in which it was added to pool
+	maxArrival                uint64 // latest arrival of the transaction and the ones of its sender with lower nonces
 	subPool                   SubPoolMarker
 	currentSubPool            SubPoolType
 	alreadyYielded            bool
@@ -198,16 +202,23 @@ type TxPool struct {
 	unprocessedRemoteByHash map[string]int                                  // to reject duplicates
 	byHash                  map[string]*metaTx                              // tx_hash => tx : only those records not committed to db yet
 	discardReasonsLRU       *simplelru.LRU[string, txpoolcfg.DiscardReason] // tx_hash => discard_reason : non-persisted
+	history                 *lifecycleHistory                               // tx_hash => lifecycle events : non-persisted
 	pending                 *PendingPool
 	baseFee                 *SubPool
 	queued                  *SubPool
 	minedBlobTxsByBlock     map[uint64][]*metaTx             // (blockNum => slice): cache of recently mined blobs
 	minedBlobTxsByHash      map[string]*metaTx               // (hash => mt): map of recently mined blobs
+	blobHashToTxn           map[common.Hash]*metaTx          // (versioned hash => mt): blob transactions in the sub-pools
 	isLocalLRU              *simplelru.LRU[string, struct{}] // tx_hash => is_local : to restore isLocal flag of unwinded transactions
+	private                 map[string]uint64                // tx_hash => expiry block : never gossiped, kept for unwinded transactions too : non-persisted
+	bundles                 *bundlesByBlock                  // block_num => bundles : non-persisted
+	rebroadcasts            map[string]*rebroadcast          // tx_hash => next rebroadcast of a local transaction : non-persisted
+	journalLock             sync.Mutex                       // serializes the rewrites of the journal of local transactions
 	newPendingTxs           chan types.Announcements         // notifications about new txs in Pending sub-pool
 	all                     *BySenderAndNonce                // senderID => (sorted map of tx nonce => *metaTx)
 	deletedTxs              []*metaTx                        // list of discarded txs since last db commit
 	promoted                types.Announcements
+	arrivals                uint64 // number of transactions added to the pool
 	cfg                     txpoolcfg.Config
 	chainID                 uint256.Int
 	lastSeenBlock           atomic.Uint64
@@ -222,12 +233,14 @@ type TxPool struct {
 	isPostAgra              atomic.Bool
 	cancunTime              *uint64
 	isPostCancun            atomic.Bool
+	pragueTime              *uint64
+	isPostPrague            atomic.Bool
 	maxBlobsPerBlock        uint64
 	logger                  log.Logger
 }
 
 func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config, cache kvcache.Cache,
-	chainID uint256.Int, shanghaiTime, agraBlock, cancunTime *big.Int, maxBlobsPerBlock uint64, logger log.Logger,
+	chainID uint256.Int, shanghaiTime, agraBlock, cancunTime, pragueTime *big.Int, maxBlobsPerBlock uint64, logger log.Logger,
 ) (*TxPool, error) {
 	localsHistory, err := simplelru.NewLRU[string, struct{}](10_000, nil)
 	if err != nil {
@@ -237,6 +250,10 @@ func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config,
 	if err != nil {
 		return nil, err
 	}
+	history, err := newLifecycleHistory(lifecycleHistorySize)
+	if err != nil {
+		return nil, err
+	}
 
 	byNonce := &BySenderAndNonce{
 		tree:              btree.NewG[*metaTx](32, SortByNonceLess),
@@ -253,7 +270,11 @@ func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config,
 		lock:                    &sync.Mutex{},
 		byHash:                  map[string]*metaTx{},
 		isLocalLRU:              localsHistory,
+		private:                 map[string]uint64{},
+		bundles:                 newBundlesByBlock(),
+		rebroadcasts:            map[string]*rebroadcast{},
 		discardReasonsLRU:       discardHistory,
+		history:                 history,
 		all:                     byNonce,
 		recentlyConnectedPeers:  &recentlyConnectedPeers{},
 		pending:                 NewPendingSubPool(PendingSubPool, cfg.PendingSubPoolLimit),
@@ -269,9 +290,21 @@ func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config,
 		unprocessedRemoteByHash: map[string]int{},
 		minedBlobTxsByBlock:     map[uint64][]*metaTx{},
 		minedBlobTxsByHash:      map[string]*metaTx{},
+		blobHashToTxn:           map[common.Hash]*metaTx{},
 		maxBlobsPerBlock:        maxBlobsPerBlock,
 		logger:                  logger,
 	}
+	res.pending.history, res.baseFee.history, res.queued.history = history, history, history
+	if res.pending.best.ordering, err = newBestOrdering(cfg, res.senders); err != nil {
+		return nil, err
+	}
+	if cfg.RebroadcastEvery <= 0 {
+		return nil, fmt.Errorf("rebroadcast interval of the local transactions must be positive, got %s", cfg.RebroadcastEvery)
+	}
+	if cfg.PrivateTxLifetime == 0 {
+		// a private transaction would expire at the next block, before a locally built one could include it
+		return nil, errors.New("lifetime of the private transactions must be at least one block")
+	}
 
 	if shanghaiTime != nil {
 		if !shanghaiTime.IsUint64() {
@@ -294,6 +327,13 @@ func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config,
 		cancunTimeU64 := cancunTime.Uint64()
 		res.cancunTime = &cancunTimeU64
 	}
+	if pragueTime != nil {
+		if !pragueTime.IsUint64() {
+			return nil, errors.New("pragueTime overflow")
+		}
+		pragueTimeU64 := pragueTime.Uint64()
+		res.pragueTime = &pragueTimeU64
+	}
 
 	return res, nil
 }
@@ -374,15 +414,27 @@ func (p *TxPool) OnNewBlock(ctx context.Context, stateChanges *remote.StateChang
 	if err := p.processMinedFinalizedBlobs(coreTx, minedTxs.Txs, stateChanges.FinalizedBlock); err != nil {
 		return err
 	}
-	if err := removeMined(p.all, minedTxs.Txs, p.pending, p.baseFee, p.queued, p.discardLocked, p.logger); err != nil {
+	minedIn := minedBlockNums(stateChanges, minedTxs.Txs)
+	discardMined := func(mt *metaTx, reason txpoolcfg.DiscardReason) {
+		if blockNum, ok := minedIn[mt.Tx.IDHash]; ok {
+			p.removeLocked(mt, reason)
+			p.history.record(mt.Tx.IDHash[:], TxEvent{Type: TxMined, BlockNum: blockNum})
+			return
+		}
+		// another transaction with the same nonce was mined
+		p.discardLocked(mt, reason)
+	}
+	if err := removeMined(p.all, minedTxs.Txs, p.pending, p.baseFee, p.queued, discardMined, p.logger); err != nil {
 		return err
 	}
+	p.expirePrivateLocked(p.lastSeenBlock.Load())
+	p.bundles.prune(p.lastSeenBlock.Load())
 
 	//p.logger.Debug("[txpool] new block", "unwinded", len(unwindTxs.txs), "mined", len(minedTxs.txs), "baseFee", baseFee, "blockHeight", blockHeight)
 
 	announcements, err := addTxsOnNewBlock(p.lastSeenBlock.Load(), cacheView, stateChanges, p.senders, unwindTxs, /* newTxs */
 		pendingBaseFee, stateChanges.BlockGasLimit,
-		p.pending, p.baseFee, p.queued, p.all, p.byHash, p.addLocked, p.discardLocked, p.logger)
+		p.pending, p.baseFee, p.queued, p.all, p.byHash, p.addUnwoundLocked, p.discardLocked, p.logger)
 	if err != nil {
 		return err
 	}
@@ -495,6 +547,9 @@ func (p *TxPool) AppendLocalAnnouncements(types []byte, sizes []uint32, hashes [
 		if txn.subPool&IsLocal == 0 {
 			continue
 		}
+		if _, ok := p.private[hash]; ok {
+			continue
+		}
 		types = append(types, txn.Tx.Type)
 		sizes = append(sizes, txn.Tx.Size)
 		hashes = append(hashes, hash...)
@@ -509,6 +564,9 @@ func (p *TxPool) AppendRemoteAnnouncements(types []byte, sizes []uint32, hashes
 		if txn.subPool&IsLocal != 0 {
 			continue
 		}
+		if _, ok := p.private[hash]; ok {
+			continue
+		}
 		types = append(types, txn.Tx.Type)
 		sizes = append(sizes, txn.Tx.Size)
 		hashes = append(hashes, hash...)
@@ -601,12 +659,41 @@ func (p *TxPool) GetKnownBlobTxn(tx kv.Tx, hash []byte) (*metaTx, error) {
 	return newMetaTx(txSlot, false, 0), nil
 }
 
+// GetBlobs returns the blobs and their KZG proofs for the given versioned hashes, looked up in the transactions of
+// the sub-pools. The blob of an unknown versioned hash is left nil.
+func (p *TxPool) GetBlobs(blobHashes []common.Hash) ([][]byte, []gokzg4844.KZGProof) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	blobs := make([][]byte, len(blobHashes))
+	proofs := make([]gokzg4844.KZGProof, len(blobHashes))
+	for i, blobHash := range blobHashes {
+		mt, ok := p.blobHashToTxn[blobHash]
+		if !ok {
+			continue
+		}
+		for j, h := range mt.Tx.BlobHashes {
+			if h == blobHash && j < len(mt.Tx.Blobs) && j < len(mt.Tx.Proofs) {
+				blobs[i] = mt.Tx.Blobs[j]
+				proofs[i] = mt.Tx.Proofs[j]
+				break
+			}
+		}
+	}
+	return blobs, proofs
+}
+
 func (p *TxPool) IsLocal(idHash []byte) bool {
 	hashS := string(idHash)
 	p.lock.Lock()
 	defer p.lock.Unlock()
 	return p.isLocalLRU.Contains(hashS)
 }
+func (p *TxPool) IsPrivate(idHash []byte) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, ok := p.private[string(idHash)]
+	return ok
+}
 func (p *TxPool) AddNewGoodPeer(peerID types.PeerID) { p.recentlyConnectedPeers.AddPeer(peerID) }
 func (p *TxPool) Started() bool                      { return p.started.Load() }
 
@@ -623,6 +710,18 @@ func (p *TxPool) best(n uint16, txs *types.TxsRlp, tx kv.Tx, onTopOf, availableG
 	var toRemove []*metaTx
 	count := 0
 
+	// the transactions of each sender already offered for the block, to cap them
+	var senderCounts map[uint64]int
+	if p.cfg.MaxBestPerSender > 0 {
+		senderCounts = map[uint64]int{}
+		toSkip.Each(func(hash [32]byte) bool {
+			if mt, ok := p.byHash[string(hash[:])]; ok {
+				senderCounts[mt.Tx.SenderID]++
+			}
+			return false
+		})
+	}
+
 	for i := 0; count < int(n) && i < len(best.ms); i++ {
 		// if we wouldn't have enough gas for a standard transaction then quit out early
 		if availableGas < fixedgas.TxGas {
@@ -640,6 +739,10 @@ func (p *TxPool) best(n uint16, txs *types.TxsRlp, tx kv.Tx, onTopOf, availableG
 			continue
 		}
 
+		if senderCounts != nil && senderCounts[mt.Tx.SenderID] >= p.cfg.MaxBestPerSender {
+			continue
+		}
+
 		rlpTx, sender, isLocal, err := p.getRlpLocked(tx, mt.Tx.IDHash[:])
 		if err != nil {
 			return false, count, err
@@ -659,7 +762,7 @@ func (p *TxPool) best(n uint16, txs *types.TxsRlp, tx kv.Tx, onTopOf, availableG
 		// make sure we have enough gas in the caller to add this transaction.
 		// not an exact science using intrinsic gas but as close as we could hope for at
 		// this stage
-		intrinsicGas, _ := txpoolcfg.CalcIntrinsicGas(uint64(mt.Tx.DataLen), uint64(mt.Tx.DataNonZeroLen), nil, mt.Tx.Creation, true, true, isShanghai)
+		intrinsicGas, _ := txpoolcfg.CalcIntrinsicGas(uint64(mt.Tx.DataLen), uint64(mt.Tx.DataNonZeroLen), uint64(mt.Tx.AuthorizationsLen), nil, mt.Tx.Creation, true, true, isShanghai)
 		if intrinsicGas > availableGas {
 			// we might find another TX with a low enough intrinsic gas to include so carry on
 			continue
@@ -670,6 +773,9 @@ func (p *TxPool) best(n uint16, txs *types.TxsRlp, tx kv.Tx, onTopOf, availableG
 		copy(txs.Senders.At(count), sender.Bytes())
 		txs.IsLocal[count] = isLocal
 		toSkip.Add(mt.Tx.IDHash) // TODO: Is this unnecessary
+		if senderCounts != nil {
+			senderCounts[mt.Tx.SenderID]++
+		}
 		count++
 	}
 
@@ -784,6 +890,14 @@ func (p *TxPool) validateTx(txn *types.TxSlot, isLocal bool, stateCache kvcache.
 			return txpoolcfg.UnmatchedBlobTxExt
 		}
 	}
+	if txn.Type == types.SetCodeTxType {
+		if !p.isPrague() {
+			return txpoolcfg.TypeNotActivated
+		}
+		if txn.AuthorizationsLen == 0 {
+			return txpoolcfg.NoAuthorizations
+		}
+	}
 
 	// Drop non-local transactions under our own minimal accepted gas price or tip
 	if !isLocal && uint256.NewInt(p.cfg.MinFeeCap).Cmp(&txn.FeeCap) == 1 {
@@ -792,7 +906,7 @@ func (p *TxPool) validateTx(txn *types.TxSlot, isLocal bool, stateCache kvcache.
 		}
 		return txpoolcfg.UnderPriced
 	}
-	gas, reason := txpoolcfg.CalcIntrinsicGas(uint64(txn.DataLen), uint64(txn.DataNonZeroLen), nil, txn.Creation, true, true, isShanghai)
+	gas, reason := txpoolcfg.CalcIntrinsicGas(uint64(txn.DataLen), uint64(txn.DataNonZeroLen), uint64(txn.AuthorizationsLen), nil, txn.Creation, true, true, isShanghai)
 	if txn.Traced {
 		p.logger.Info(fmt.Sprintf("TX TRACING: validateTx intrinsic gas idHash=%x gas=%d", txn.IDHash, gas))
 	}
@@ -959,6 +1073,31 @@ func (p *TxPool) isCancun() bool {
 	return activated
 }
 
+func (p *TxPool) isPrague() bool {
+	// once this flag has been set for the first time we no longer need to check the timestamp
+	set := p.isPostPrague.Load()
+	if set {
+		return true
+	}
+	if p.pragueTime == nil {
+		return false
+	}
+	pragueTime := *p.pragueTime
+
+	// a zero here means Prague is always active
+	if pragueTime == 0 {
+		p.isPostPrague.Swap(true)
+		return true
+	}
+
+	now := time.Now().Unix()
+	activated := uint64(now) >= pragueTime
+	if activated {
+		p.isPostPrague.Swap(true)
+	}
+	return activated
+}
+
 // Check that that the serialized txn should not exceed a certain max size
 func (p *TxPool) ValidateSerializedTxn(serializedTxn []byte) error {
 	const (
@@ -1060,6 +1199,16 @@ func fillDiscardReasons(reasons []txpoolcfg.DiscardReason, newTxs types.TxSlots,
 }
 
 func (p *TxPool) AddLocalTxs(ctx context.Context, newTransactions types.TxSlots, tx kv.Tx) ([]txpoolcfg.DiscardReason, error) {
+	return p.addLocalTxs(ctx, newTransactions, tx, false)
+}
+
+// AddPrivateTxs adds local transactions which are never announced or broadcast to peers, only included
+// in locally built blocks. They are dropped if not included within cfg.PrivateTxLifetime blocks.
+func (p *TxPool) AddPrivateTxs(ctx context.Context, newTransactions types.TxSlots, tx kv.Tx) ([]txpoolcfg.DiscardReason, error) {
+	return p.addLocalTxs(ctx, newTransactions, tx, true)
+}
+
+func (p *TxPool) addLocalTxs(ctx context.Context, newTransactions types.TxSlots, tx kv.Tx, private bool) ([]txpoolcfg.DiscardReason, error) {
 	coreDb, cache := p.coreDBWithCache()
 	coreTx, err := coreDb.BeginRo(ctx)
 	if err != nil {
@@ -1093,8 +1242,12 @@ func (p *TxPool) AddLocalTxs(ctx context.Context, newTransactions types.TxSlots,
 		return nil, err
 	}
 
+	add := p.addLocked
+	if private {
+		add = p.addPrivateLocked
+	}
 	announcements, addReasons, err := addTxs(p.lastSeenBlock.Load(), cacheView, p.senders, newTxs,
-		p.pendingBaseFee.Load(), p.pendingBlobFee.Load(), p.blockGasLimit.Load(), p.pending, p.baseFee, p.queued, p.all, p.byHash, p.addLocked, p.discardLocked, true, p.logger)
+		p.pendingBaseFee.Load(), p.pendingBlobFee.Load(), p.blockGasLimit.Load(), p.pending, p.baseFee, p.queued, p.all, p.byHash, add, p.discardLocked, true, p.logger)
 	if err == nil {
 		for i, reason := range addReasons {
 			if reason != txpoolcfg.NotSet {
@@ -1271,6 +1424,26 @@ func (p *TxPool) setBlobFee(blobFee uint64) {
 }
 
 func (p *TxPool) addLocked(mt *metaTx, announcements *types.Announcements) txpoolcfg.DiscardReason {
+	return p.addLockedAs(mt, announcements, TxAdded)
+}
+
+// addPrivateLocked adds a transaction which must not be gossiped
+func (p *TxPool) addPrivateLocked(mt *metaTx, announcements *types.Announcements) txpoolcfg.DiscardReason {
+	if reason := p.addLocked(mt, announcements); reason != txpoolcfg.NotSet {
+		return reason
+	}
+	p.private[string(mt.Tx.IDHash[:])] = p.lastSeenBlock.Load() + p.cfg.PrivateTxLifetime
+	return txpoolcfg.NotSet
+}
+
+// addUnwoundLocked adds back a transaction of an unwound block
+func (p *TxPool) addUnwoundLocked(mt *metaTx, announcements *types.Announcements) txpoolcfg.DiscardReason {
+	// a local transaction is broadcast again as a new one, so its rebroadcasts start over
+	delete(p.rebroadcasts, string(mt.Tx.IDHash[:]))
+	return p.addLockedAs(mt, announcements, TxReinjected)
+}
+
+func (p *TxPool) addLockedAs(mt *metaTx, announcements *types.Announcements, event TxEventType) txpoolcfg.DiscardReason {
 	// Insert to pending pool, if pool doesn't have txn with same Nonce and bigger Tip
 	found := p.all.get(mt.Tx.SenderID, mt.Tx.Nonce)
 	if found != nil {
@@ -1326,6 +1499,7 @@ func (p *TxPool) addLocked(mt *metaTx, announcements *types.Announcements) txpoo
 		}
 
 		p.discardLocked(found, txpoolcfg.ReplacedByHigherTip)
+		p.history.replaced(found.Tx.IDHash[:], mt.Tx.IDHash)
 	}
 
 	// Don't add blob tx to queued if it's less than current pending blob base fee
@@ -1335,6 +1509,9 @@ func (p *TxPool) addLocked(mt *metaTx, announcements *types.Announcements) txpoo
 
 	hashStr := string(mt.Tx.IDHash[:])
 	p.byHash[hashStr] = mt
+	for _, blobHash := range mt.Tx.BlobHashes {
+		p.blobHashToTxn[blobHash] = mt
+	}
 
 	if replaced := p.all.replaceOrInsert(mt); replaced != nil {
 		if assert.Enable {
@@ -1345,6 +1522,9 @@ func (p *TxPool) addLocked(mt *metaTx, announcements *types.Announcements) txpoo
 	if mt.subPool&IsLocal != 0 {
 		p.isLocalLRU.Add(hashStr, struct{}{})
 	}
+	p.arrivals++
+	mt.arrival = p.arrivals
+	p.history.record(mt.Tx.IDHash[:], TxEvent{Type: event})
 	// All transactions are first added to the queued pool and then immediately promoted from there if required
 	p.queued.Add(mt, p.logger)
 	// Remove from mined cache as we are now "resurrecting" it to a sub-pool
@@ -1354,14 +1534,79 @@ func (p *TxPool) addLocked(mt *metaTx, announcements *types.Announcements) txpoo
 
 // dropping transaction from all sub-structures and from db
 // Important: don't call it while iterating by all
+// expirePrivateLocked drops the private transactions which were not included in time. The mined ones
+// are remembered until then too, to not gossip them if their block is unwound.
+func (p *TxPool) expirePrivateLocked(blockNum uint64) {
+	for hashStr, expiry := range p.private {
+		if blockNum <= expiry {
+			continue
+		}
+		delete(p.private, hashStr)
+		mt, ok := p.byHash[hashStr]
+		if !ok {
+			continue
+		}
+		switch mt.currentSubPool {
+		case PendingSubPool:
+			p.pending.Remove(mt)
+		case BaseFeeSubPool:
+			p.baseFee.Remove(mt)
+		case QueuedSubPool:
+			p.queued.Remove(mt)
+		default:
+			//already removed
+		}
+		p.discardLocked(mt, txpoolcfg.PrivateTxExpired)
+	}
+}
+
 func (p *TxPool) discardLocked(mt *metaTx, reason txpoolcfg.DiscardReason) {
+	p.removeLocked(mt, reason)
+	p.history.record(mt.Tx.IDHash[:], TxEvent{Type: TxDiscarded, Reason: reason})
+}
+
+// removeLocked removes the transaction from the pool without recording it in its lifecycle
+func (p *TxPool) removeLocked(mt *metaTx, reason txpoolcfg.DiscardReason) {
 	hashStr := string(mt.Tx.IDHash[:])
 	delete(p.byHash, hashStr)
+	for _, blobHash := range mt.Tx.BlobHashes {
+		// another transaction carrying the same blob may have replaced this one
+		if p.blobHashToTxn[blobHash] == mt {
+			delete(p.blobHashToTxn, blobHash)
+		}
+	}
 	p.deletedTxs = append(p.deletedTxs, mt)
 	p.all.delete(mt)
 	p.discardReasonsLRU.Add(hashStr, reason)
 }
 
+// minedBlockNums returns the height of the block which mined each of minedTxs, by hash. minedTxs are the
+// transactions of the forward state changes of the batch, in order.
+func minedBlockNums(stateChanges *remote.StateChangeBatch, minedTxs []*types.TxSlot) map[[32]byte]uint64 {
+	blockNums := make(map[[32]byte]uint64, len(minedTxs))
+	i := 0
+	for _, change := range stateChanges.ChangeBatch {
+		if change.Direction != remote.Direction_FORWARD {
+			continue
+		}
+		for range change.Txs {
+			if i == len(minedTxs) {
+				return blockNums
+			}
+			blockNums[minedTxs[i].IDHash] = change.BlockHeight
+			i++
+		}
+	}
+	return blockNums
+}
+
+// Lifecycle returns the events of a recently seen transaction, oldest first
+func (p *TxPool) Lifecycle(hash common.Hash) []TxEvent {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.history.get(hash)
+}
+
 // Cache recently mined blobs in anticipation of reorg, delete finalized ones
 func (p *TxPool) processMinedFinalizedBlobs(coreTx kv.Tx, minedTxs []*types.TxSlot, finalizedBlock uint64) error {
 	p.lastFinalizedBlock.Store(finalizedBlock)
@@ -1478,6 +1723,7 @@ func onSenderStateChange(senderID uint64, senderNonce uint64, senderBalance uint
 	cumulativeRequiredBalance := uint256.NewInt(0)
 	minFeeCap := uint256.NewInt(0).SetAllOne()
 	minTip := uint64(math.MaxUint64)
+	maxArrival := uint64(0)
 	var toDel []*metaTx // can't delete items while iterate them
 	byNonce.ascend(senderID, func(mt *metaTx) bool {
 		if mt.Tx.Traced {
@@ -1516,6 +1762,8 @@ func onSenderStateChange(senderID uint64, senderNonce uint64, senderBalance uint
 			minTip = cmp.Min(minTip, mt.Tx.Tip.Uint64())
 		}
 		mt.minTip = minTip
+		maxArrival = cmp.Max(maxArrival, mt.arrival)
+		mt.maxArrival = maxArrival
 
 		mt.nonceDistance = 0
 		if mt.Tx.Nonce > senderNonce { // no uint underflow
@@ -1639,6 +1887,9 @@ func promote(pending *PendingPool, baseFee, queued *SubPool, pendingBaseFee uint
 // by the peer.
 const txMaxBroadcastSize = 4 * 1024
 
+// localTxsBroadcastMaxPeers is the number of peers local transactions are broadcast to, and announced to twice as many
+const localTxsBroadcastMaxPeers uint64 = 10
+
 // MainLoop - does:
 // send pending byHash to p2p:
 //   - new byHash
@@ -1656,6 +1907,8 @@ func MainLoop(ctx context.Context, db kv.RwDB, coreDB kv.RoDB, p *TxPool, newTxs
 	defer commitEvery.Stop()
 	logEvery := time.NewTicker(p.cfg.LogEvery)
 	defer logEvery.Stop()
+	rebroadcastEvery := time.NewTicker(p.cfg.RebroadcastEvery)
+	defer rebroadcastEvery.Stop()
 
 	for {
 		select {
@@ -1664,6 +1917,11 @@ func MainLoop(ctx context.Context, db kv.RwDB, coreDB kv.RoDB, p *TxPool, newTxs
 			return
 		case <-logEvery.C:
 			p.logStats()
+		case <-rebroadcastEvery.C:
+			if !p.Started() || p.cfg.NoGossip {
+				continue
+			}
+			go p.rebroadcastLocals(ctx, db, send)
 		case <-processRemoteTxsEvery.C:
 			if !p.Started() {
 				continue
@@ -1730,6 +1988,9 @@ func MainLoop(ctx context.Context, db kv.RwDB, coreDB kv.RoDB, p *TxPool, newTxs
 				if err := db.View(ctx, func(tx kv.Tx) error {
 					for i := 0; i < announcements.Len(); i++ {
 						t, size, hash := announcements.At(i)
+						if p.IsPrivate(hash) { // neither broadcast nor streamed
+							continue
+						}
 						slotRlp, err := p.GetRlp(tx, hash)
 						if err != nil {
 							return err
@@ -1772,7 +2033,6 @@ func MainLoop(ctx context.Context, db kv.RwDB, coreDB kv.RoDB, p *TxPool, newTxs
 				}
 
 				// broadcast local transactions
-				const localTxsBroadcastMaxPeers uint64 = 10
 				txSentTo := send.BroadcastPooledTxs(localTxRlps, localTxsBroadcastMaxPeers)
 				for i, peer := range txSentTo {
 					p.logger.Info("Local tx broadcasted", "txHash", hex.EncodeToString(broadCastedHashes.At(i)), "to peer", peer)
@@ -1842,6 +2102,11 @@ func (p *TxPool) flush(ctx context.Context, db kv.RwDB) (written uint64, err err
 	if err := db.Update(ctx, func(tx kv.RwTx) error { return nil }); err != nil {
 		return 0, err
 	}
+
+	// journal the local transactions, which are all in the db now
+	if err := db.View(ctx, p.journal); err != nil {
+		return 0, err
+	}
 	return written, nil
 }
 func (p *TxPool) flushLocked(tx kv.RwTx) (err error) {
@@ -1885,6 +2150,9 @@ func (p *TxPool) flushLocked(tx kv.RwTx) (err error) {
 		if metaTx.Tx.Rlp == nil {
 			continue
 		}
+		if _, ok := p.private[txHash]; ok { // not persisted, must not be gossiped after a restart
+			continue
+		}
 		v = common.EnsureEnoughSize(v, 20+len(metaTx.Tx.Rlp))
 
 		addr, ok := p.senders.senderID2Addr[metaTx.Tx.SenderID]
@@ -1997,6 +2265,9 @@ func (p *TxPool) fromDB(ctx context.Context, tx kv.Tx, coreTx kv.Tx) error {
 		copy(txs.Senders.At(i), addr[:])
 		i++
 	}
+	if err := p.fromJournal(tx, parseCtx, cacheView, &txs); err != nil {
+		return err
+	}
 
 	var pendingBaseFee uint64
 	{
@@ -2377,14 +2648,15 @@ func (b *BySenderAndNonce) replaceOrInsert(mt *metaTx) *metaTx {
 // It's more expensive to maintain "slice sort" invariant, but it allow do cheap copy of
 // pending.best slice for mining (because we consider txs and metaTx are immutable)
 type PendingPool struct {
-	best  *bestSlice
-	worst *WorstQueue
-	limit int
-	t     SubPoolType
+	best    *bestSlice
+	worst   *WorstQueue
+	limit   int
+	t       SubPoolType
+	history *lifecycleHistory
 }
 
 func NewPendingSubPool(t SubPoolType, limit int) *PendingPool {
-	return &PendingPool{limit: limit, t: t, best: &bestSlice{ms: []*metaTx{}}, worst: &WorstQueue{ms: []*metaTx{}}}
+	return &PendingPool{limit: limit, t: t, best: &bestSlice{ms: []*metaTx{}, ordering: priceOrdering{}}, worst: &WorstQueue{ms: []*metaTx{}}}
 }
 
 // bestSlice - is similar to best queue, but uses a linear structure with O(n log n) sort complexity and
@@ -2392,6 +2664,7 @@ func NewPendingSubPool(t SubPoolType, limit int) *PendingPool {
 type bestSlice struct {
 	ms             []*metaTx
 	pendingBaseFee uint64
+	ordering       bestOrdering
 }
 
 func (s *bestSlice) Len() int { return len(s.ms) }
@@ -2400,7 +2673,7 @@ func (s *bestSlice) Swap(i, j int) {
 	s.ms[i].bestIndex, s.ms[j].bestIndex = i, j
 }
 func (s *bestSlice) Less(i, j int) bool {
-	return s.ms[i].better(s.ms[j], *uint256.NewInt(s.pendingBaseFee))
+	return s.ordering.better(s.ms[i], s.ms[j], *uint256.NewInt(s.pendingBaseFee))
 }
 func (s *bestSlice) UnsafeRemove(i *metaTx) {
 	s.Swap(i.bestIndex, len(s.ms)-1)
@@ -2461,6 +2734,7 @@ func (p *PendingPool) Add(i *metaTx, logger log.Logger) {
 	i.currentSubPool = p.t
 	heap.Push(p.worst, i)
 	p.best.UnsafeAdd(i)
+	p.history.record(i.Tx.IDHash[:], TxEvent{Type: TxMoved, SubPool: p.t})
 }
 func (p *PendingPool) DebugPrint(prefix string) {
 	for i, it := range p.best.ms {
@@ -2472,10 +2746,11 @@ func (p *PendingPool) DebugPrint(prefix string) {
 }
 
 type SubPool struct {
-	best  *BestQueue
-	worst *WorstQueue
-	limit int
-	t     SubPoolType
+	best    *BestQueue
+	worst   *WorstQueue
+	limit   int
+	t       SubPoolType
+	history *lifecycleHistory
 }
 
 func NewSubPool(t SubPoolType, limit int) *SubPool {
@@ -2516,6 +2791,7 @@ func (p *SubPool) Add(i *metaTx, logger log.Logger) {
 	i.currentSubPool = p.t
 	heap.Push(p.best, i)
 	heap.Push(p.worst, i)
+	p.history.record(i.Tx.IDHash[:], TxEvent{Type: TxMoved, SubPool: p.t})
 }
 
 func (p *SubPool) Remove(i *metaTx) {
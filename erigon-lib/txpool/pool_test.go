@@ -23,7 +23,9 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"path/filepath"
 	"testing"
+	"time"
 
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	"github.com/holiman/uint256"
@@ -52,7 +54,7 @@ func TestNonceFromAddress(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -172,7 +174,7 @@ func TestReplaceWithHigherFee(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
 	assert.NoError(err)
 	require.NotEqual(nil, pool)
 	ctx := context.Background()
@@ -289,7 +291,7 @@ func TestReverseNonces(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -416,7 +418,7 @@ func TestTxPoke(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -627,7 +629,7 @@ func TestShanghaiIntrinsicGas(t *testing.T) {
 
 	for name, c := range cases {
 		t.Run(name, func(t *testing.T) {
-			gas, reason := txpoolcfg.CalcIntrinsicGas(c.dataLen, c.dataNonZeroLen, nil, c.creation, true, true, c.isShanghai)
+			gas, reason := txpoolcfg.CalcIntrinsicGas(c.dataLen, c.dataNonZeroLen, 0, nil, c.creation, true, true, c.isShanghai)
 			if reason != txpoolcfg.Success {
 				t.Errorf("expected success but got reason %v", reason)
 			}
@@ -681,7 +683,7 @@ func TestShanghaiValidateTx(t *testing.T) {
 			}
 
 			cache := &kvcache.DummyCache{}
-			pool, err := New(ch, coreDB, cfg, cache, *u256.N1, shanghaiTime, nil /* agraBlock */, nil /* cancunTime */, fixedgas.DefaultMaxBlobsPerBlock, logger)
+			pool, err := New(ch, coreDB, cfg, cache, *u256.N1, shanghaiTime, nil /* agraBlock */, nil /* cancunTime */, nil /* pragueTime */, fixedgas.DefaultMaxBlobsPerBlock, logger)
 			asrt.NoError(err)
 			ctx := context.Background()
 			tx, err := coreDB.BeginRw(ctx)
@@ -720,6 +722,83 @@ func TestShanghaiValidateTx(t *testing.T) {
 	}
 }
 
+func TestSetCodeTxValidateTx(t *testing.T) {
+	asrt := assert.New(t)
+	tests := map[string]struct {
+		expected          txpoolcfg.DiscardReason
+		authorizationsLen int
+		isPrague          bool
+	}{
+		"no prague": {
+			expected:          txpoolcfg.TypeNotActivated,
+			authorizationsLen: 1,
+			isPrague:          false,
+		},
+		"prague without authorizations": {
+			expected:          txpoolcfg.NoAuthorizations,
+			authorizationsLen: 0,
+			isPrague:          true,
+		},
+		"prague with authorizations": {
+			expected:          txpoolcfg.Success,
+			authorizationsLen: 2,
+			isPrague:          true,
+		},
+	}
+
+	logger := log.New()
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ch := make(chan types.Announcements, 100)
+			_, coreDB := memdb.NewTestPoolDB(t), memdb.NewTestDB(t)
+			cfg := txpoolcfg.DefaultConfig
+
+			var pragueTime *big.Int
+			if test.isPrague {
+				pragueTime = big.NewInt(0)
+			}
+
+			cache := &kvcache.DummyCache{}
+			pool, err := New(ch, coreDB, cfg, cache, *u256.N1, big.NewInt(0) /* shanghaiTime */, nil /* agraBlock */, big.NewInt(0) /* cancunTime */, pragueTime, fixedgas.DefaultMaxBlobsPerBlock, logger)
+			asrt.NoError(err)
+			ctx := context.Background()
+			tx, err := coreDB.BeginRw(ctx)
+			defer tx.Rollback()
+			asrt.NoError(err)
+
+			sndr := sender{nonce: 0, balance: *uint256.NewInt(math.MaxUint64)}
+			sndrBytes := make([]byte, types.EncodeSenderLengthForStorage(sndr.nonce, sndr.balance))
+			types.EncodeSender(sndr.nonce, sndr.balance, sndrBytes)
+			err = tx.Put(kv.PlainState, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, sndrBytes)
+			asrt.NoError(err)
+
+			txn := &types.TxSlot{
+				Type:              types.SetCodeTxType,
+				FeeCap:            *uint256.NewInt(21000),
+				Gas:               500000,
+				SenderID:          0,
+				AuthorizationsLen: test.authorizationsLen,
+			}
+
+			txns := types.TxSlots{
+				Txs:     append([]*types.TxSlot{}, txn),
+				Senders: types.Addresses{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			}
+			err = pool.senders.registerNewSenders(&txns, logger)
+			asrt.NoError(err)
+			view, err := cache.View(ctx, tx)
+			asrt.NoError(err)
+
+			reason := pool.validateTx(txn, false, view)
+
+			if reason != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, reason)
+			}
+		})
+	}
+}
+
 // Blob gas price bump + other requirements to replace existing txns in the pool
 func TestBlobTxReplacement(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
@@ -727,7 +806,7 @@ func TestBlobTxReplacement(t *testing.T) {
 	db, coreDB := memdb.NewTestPoolDB(t), memdb.NewTestDB(t)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -873,6 +952,65 @@ func TestBlobTxReplacement(t *testing.T) {
 	}
 }
 
+func TestGetBlobs(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan types.Announcements, 5)
+	db, coreDB := memdb.NewTestPoolDB(t), memdb.NewTestDB(t)
+	cfg := txpoolcfg.DefaultConfig
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	assert.NoError(err)
+	require.True(pool != nil)
+	ctx := context.Background()
+
+	h1 := gointerfaces.ConvertHashToH256([32]byte{})
+	change := &remote.StateChangeBatch{
+		StateVersionId:       0,
+		PendingBlockBaseFee:  200_000,
+		BlockGasLimit:        1000000,
+		PendingBlobFeePerGas: 100_000,
+		ChangeBatch: []*remote.StateChange{
+			{BlockHeight: 0, BlockHash: h1},
+		},
+	}
+	var addr [20]byte
+	addr[0] = 1
+
+	// Add 1 eth to the user account, as a part of change
+	v := make([]byte, types.EncodeSenderLengthForStorage(2, *uint256.NewInt(1 * common.Ether)))
+	types.EncodeSender(2, *uint256.NewInt(1 * common.Ether), v)
+
+	change.ChangeBatch[0].Changes = append(change.ChangeBatch[0].Changes, &remote.AccountChange{
+		Action:  remote.Action_UPSERT,
+		Address: gointerfaces.ConvertAddressToH160(addr),
+		Data:    v,
+	})
+	tx, err := db.BeginRw(ctx)
+	require.NoError(err)
+	defer tx.Rollback()
+	err = pool.OnNewBlock(ctx, change, types.TxSlots{}, types.TxSlots{}, tx)
+	assert.NoError(err)
+
+	blobTxn := makeBlobTx()
+	blobTxn.Nonce = 0x2
+	txSlots := types.TxSlots{}
+	txSlots.Append(&blobTxn, addr[:], true)
+	reasons, err := pool.AddLocalTxs(ctx, txSlots, tx)
+	assert.NoError(err)
+	for _, reason := range reasons {
+		assert.Equal(txpoolcfg.Success, reason, reason.String())
+	}
+
+	blobs, proofs := pool.GetBlobs([]common.Hash{blobTxn.BlobHashes[1], {0x01}, blobTxn.BlobHashes[0]})
+	require.Len(blobs, 3)
+	require.Len(proofs, 3)
+	assert.Equal(blobTxn.Blobs[1], blobs[0])
+	assert.Equal(blobTxn.Proofs[1], proofs[0])
+	assert.Nil(blobs[1])
+	assert.Equal(blobTxn.Blobs[0], blobs[2])
+	assert.Equal(blobTxn.Proofs[0], proofs[2])
+}
+
 // Todo, make the tx more realistic with good values
 func makeBlobTx() types.TxSlot {
 	// Some arbitrary hardcoded example
@@ -952,7 +1090,7 @@ func TestDropRemoteAtNoGossip(t *testing.T) {
 	logger := log.New()
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
 
-	txPool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, big.NewInt(0), big.NewInt(0), nil, fixedgas.DefaultMaxBlobsPerBlock, logger)
+	txPool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, big.NewInt(0), big.NewInt(0), nil, nil, fixedgas.DefaultMaxBlobsPerBlock, logger)
 	assert.NoError(err)
 	require.True(txPool != nil)
 
@@ -1043,3 +1181,457 @@ func TestDropRemoteAtNoGossip(t *testing.T) {
 	// no announcement because unprocessedRemoteTxs is already empty
 	assert.True(checkAnnouncementEmpty())
 }
+
+func TestTxLifecycle(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan types.Announcements, 100)
+	db, coreDB := memdb.NewTestPoolDB(t), memdb.NewTestDB(t)
+
+	cfg := txpoolcfg.DefaultConfig
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	require.NoError(err)
+	ctx := context.Background()
+	var addr [20]byte
+	addr[0] = 1
+	senderChange := func(blockHeight, nonce uint64) *remote.StateChangeBatch {
+		v := make([]byte, types.EncodeSenderLengthForStorage(nonce, *uint256.NewInt(1 * common.Ether)))
+		types.EncodeSender(nonce, *uint256.NewInt(1 * common.Ether), v)
+		return &remote.StateChangeBatch{
+			PendingBlockBaseFee: 200000,
+			BlockGasLimit:       1000000,
+			ChangeBatch: []*remote.StateChange{{
+				BlockHeight: blockHeight,
+				BlockHash:   gointerfaces.ConvertHashToH256([32]byte{byte(blockHeight)}),
+				Changes: []*remote.AccountChange{{
+					Action:  remote.Action_UPSERT,
+					Address: gointerfaces.ConvertAddressToH160(addr),
+					Data:    v,
+				}},
+			}},
+		}
+	}
+	tx, err := db.BeginRw(ctx)
+	require.NoError(err)
+	defer tx.Rollback()
+	require.NoError(pool.OnNewBlock(ctx, senderChange(0, 2), types.TxSlots{}, types.TxSlots{}, tx))
+
+	newSlot := func(id byte, fee uint64) *types.TxSlot {
+		txSlot := &types.TxSlot{Tip: *uint256.NewInt(fee), FeeCap: *uint256.NewInt(fee), Gas: 100000, Nonce: 2}
+		txSlot.IDHash[0] = id
+		return txSlot
+	}
+	for _, txSlot := range []*types.TxSlot{newSlot(1, 300000), newSlot(2, 330000)} {
+		var txSlots types.TxSlots
+		txSlots.Append(txSlot, addr[:], true)
+		reasons, err := pool.AddLocalTxs(ctx, txSlots, tx)
+		require.NoError(err)
+		assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+	}
+	var replaced, replacing common.Hash
+	replaced[0], replacing[0] = 1, 2
+
+	events := pool.Lifecycle(replaced)
+	require.Len(events, 4)
+	assert.Equal(TxAdded, events[0].Type)
+	assert.Equal(TxEvent{Type: TxMoved, SubPool: QueuedSubPool}, TxEvent{Type: events[1].Type, SubPool: events[1].SubPool})
+	assert.Equal(TxEvent{Type: TxMoved, SubPool: PendingSubPool}, TxEvent{Type: events[2].Type, SubPool: events[2].SubPool})
+	assert.Equal(TxDiscarded, events[3].Type)
+	assert.Equal(txpoolcfg.ReplacedByHigherTip, events[3].Reason)
+	assert.Equal(replacing, events[3].ReplacedBy)
+
+	// the replacing transaction is mined, in a batch which goes on with the next block, then its block is unwound
+	var mined types.TxSlots
+	mined.Append(newSlot(2, 330000), addr[:], true)
+	minedChange := senderChange(1, 3)
+	minedChange.ChangeBatch[0].Txs = [][]byte{nil} // the pool only counts the transactions of a state change
+	minedChange.ChangeBatch = append(minedChange.ChangeBatch, &remote.StateChange{
+		BlockHeight: 2,
+		BlockHash:   gointerfaces.ConvertHashToH256([32]byte{2}),
+	})
+	require.NoError(pool.OnNewBlock(ctx, minedChange, types.TxSlots{}, mined, tx))
+	var unwound types.TxSlots
+	unwound.Append(newSlot(2, 330000), addr[:], false)
+	require.NoError(pool.OnNewBlock(ctx, senderChange(1, 2), unwound, types.TxSlots{}, tx))
+
+	events = pool.Lifecycle(replacing)
+	var seen []TxEventType
+	for _, event := range events {
+		seen = append(seen, event.Type)
+	}
+	assert.Equal([]TxEventType{TxAdded, TxMoved, TxMoved, TxMined, TxReinjected, TxMoved, TxMoved}, seen)
+	assert.Equal(uint64(1), events[3].BlockNum)
+	assert.Equal(PendingSubPool, events[6].SubPool)
+	assert.Empty(pool.Lifecycle(common.Hash{3}))
+}
+
+func TestBestOrdering(t *testing.T) {
+	var addrA, addrB [20]byte
+	addrA[0], addrB[0] = 1, 2
+	for _, tt := range []struct {
+		name   string
+		config func(cfg *txpoolcfg.Config)
+		want   []byte
+	}{
+		{"price", func(cfg *txpoolcfg.Config) {}, []byte{0xa0, 0xa1, 0xb0}},
+		{"arrival", func(cfg *txpoolcfg.Config) { cfg.Ordering = txpoolcfg.OrderByArrival }, []byte{0xb0, 0xa0, 0xa1}},
+		{"priority", func(cfg *txpoolcfg.Config) { cfg.PrioritySenders = []string{string(addrB[:])} }, []byte{0xb0, 0xa0, 0xa1}},
+		{"fairness", func(cfg *txpoolcfg.Config) { cfg.MaxBestPerSender = 1 }, []byte{0xa0, 0xb0}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ch := make(chan types.Announcements, 100)
+			db, coreDB := memdb.NewTestPoolDB(t), memdb.NewTestDB(t)
+			cfg := txpoolcfg.DefaultConfig
+			tt.config(&cfg)
+			pool, err := New(ch, coreDB, cfg, kvcache.New(kvcache.DefaultCoherentConfig), *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+			require.NoError(err)
+			ctx := context.Background()
+			change := &remote.StateChangeBatch{
+				PendingBlockBaseFee: 200000,
+				BlockGasLimit:       1000000,
+				ChangeBatch:         []*remote.StateChange{{BlockHeight: 0, BlockHash: gointerfaces.ConvertHashToH256([32]byte{})}},
+			}
+			for _, addr := range [][20]byte{addrA, addrB} {
+				v := make([]byte, types.EncodeSenderLengthForStorage(0, *uint256.NewInt(1 * common.Ether)))
+				types.EncodeSender(0, *uint256.NewInt(1 * common.Ether), v)
+				change.ChangeBatch[0].Changes = append(change.ChangeBatch[0].Changes, &remote.AccountChange{
+					Action:  remote.Action_UPSERT,
+					Address: gointerfaces.ConvertAddressToH160(addr),
+					Data:    v,
+				})
+			}
+			tx, err := db.BeginRw(ctx)
+			require.NoError(err)
+			defer tx.Rollback()
+			require.NoError(pool.OnNewBlock(ctx, change, types.TxSlots{}, types.TxSlots{}, tx))
+
+			// the second transaction of A arrives first, then the one of B, then the first one of A
+			for _, txn := range []struct {
+				id     byte
+				sender [20]byte
+				nonce  uint64
+				tip    uint64
+			}{{0xa1, addrA, 1, 400000}, {0xb0, addrB, 0, 250000}, {0xa0, addrA, 0, 300000}} {
+				txSlot := &types.TxSlot{Tip: *uint256.NewInt(txn.tip), FeeCap: *uint256.NewInt(txn.tip), Gas: 100000, Nonce: txn.nonce, Rlp: []byte{txn.id}}
+				txSlot.IDHash[0] = txn.id
+				var txSlots types.TxSlots
+				txSlots.Append(txSlot, txn.sender[:], true)
+				reasons, err := pool.AddLocalTxs(ctx, txSlots, tx)
+				require.NoError(err)
+				require.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+			}
+
+			var txs types.TxsRlp
+			onTime, err := pool.PeekBest(10, &txs, tx, 0, 1000000, 0)
+			require.NoError(err)
+			require.True(onTime)
+			var got []byte
+			for _, rlpTx := range txs.Txs {
+				got = append(got, rlpTx[0])
+			}
+			require.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestPrioritySenderFlushed(t *testing.T) {
+	require := require.New(t)
+	ch := make(chan types.Announcements, 100)
+	db, coreDB := memdb.NewTestPoolDB(t), memdb.NewTestDB(t)
+	var addrA, addrB [20]byte
+	addrA[0], addrB[0] = 1, 2
+	cfg := txpoolcfg.DefaultConfig
+	cfg.PrioritySenders = []string{string(addrB[:])}
+	pool, err := New(ch, coreDB, cfg, kvcache.New(kvcache.DefaultCoherentConfig), *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	require.NoError(err)
+	ctx := context.Background()
+	change := func(blockHeight, nonceB uint64) *remote.StateChangeBatch {
+		batch := &remote.StateChangeBatch{
+			PendingBlockBaseFee: 200000,
+			BlockGasLimit:       1000000,
+			ChangeBatch:         []*remote.StateChange{{BlockHeight: blockHeight, BlockHash: gointerfaces.ConvertHashToH256([32]byte{byte(blockHeight)})}},
+		}
+		for _, sender := range []struct {
+			addr  [20]byte
+			nonce uint64
+		}{{addrA, 0}, {addrB, nonceB}} {
+			v := make([]byte, types.EncodeSenderLengthForStorage(sender.nonce, *uint256.NewInt(1 * common.Ether)))
+			types.EncodeSender(sender.nonce, *uint256.NewInt(1 * common.Ether), v)
+			batch.ChangeBatch[0].Changes = append(batch.ChangeBatch[0].Changes, &remote.AccountChange{
+				Action:  remote.Action_UPSERT,
+				Address: gointerfaces.ConvertAddressToH160(sender.addr),
+				Data:    v,
+			})
+		}
+		return batch
+	}
+	newSlot := func(id byte, nonce, tip uint64) *types.TxSlot {
+		txSlot := &types.TxSlot{Tip: *uint256.NewInt(tip), FeeCap: *uint256.NewInt(tip), Gas: 100000, Nonce: nonce, Rlp: []byte{id}}
+		txSlot.IDHash[0] = id
+		return txSlot
+	}
+	add := func(tx kv.Tx, id byte, sender [20]byte, nonce, tip uint64) {
+		var txSlots types.TxSlots
+		txSlots.Append(newSlot(id, nonce, tip), sender[:], true)
+		reasons, err := pool.AddLocalTxs(ctx, txSlots, tx)
+		require.NoError(err)
+		require.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+	}
+	tx, err := db.BeginRw(ctx)
+	require.NoError(err)
+	defer tx.Rollback()
+	require.NoError(pool.OnNewBlock(ctx, change(0, 0), types.TxSlots{}, types.TxSlots{}, tx))
+
+	// the only transaction of B is mined, so flushing deletes its sender ID
+	add(tx, 0xb0, addrB, 0, 250000)
+	var mined types.TxSlots
+	mined.Append(newSlot(0xb0, 0, 250000), addrB[:], true)
+	minedChange := change(1, 1)
+	minedChange.ChangeBatch[0].Txs = [][]byte{nil}
+	require.NoError(pool.OnNewBlock(ctx, minedChange, types.TxSlots{}, mined, tx))
+	require.NoError(pool.flushLocked(tx))
+	_, ok := pool.senders.getID(addrB)
+	require.False(ok)
+
+	// B comes back with another ID and is still offered first
+	add(tx, 0xa0, addrA, 0, 300000)
+	add(tx, 0xb1, addrB, 1, 250000)
+	var txs types.TxsRlp
+	onTime, err := pool.PeekBest(10, &txs, tx, 0, 1000000, 0)
+	require.NoError(err)
+	require.True(onTime)
+	var got []byte
+	for _, rlpTx := range txs.Txs {
+		got = append(got, rlpTx[0])
+	}
+	require.Equal([]byte{0xb1, 0xa0}, got)
+}
+
+func TestNewRejectsNonPositiveIntervals(t *testing.T) {
+	coreDB := memdb.NewTestDB(t)
+	for name, set := range map[string]func(cfg *txpoolcfg.Config){
+		"zero rebroadcast":     func(cfg *txpoolcfg.Config) { cfg.RebroadcastEvery = 0 },
+		"negative rebroadcast": func(cfg *txpoolcfg.Config) { cfg.RebroadcastEvery = -time.Second },
+		"zero private":         func(cfg *txpoolcfg.Config) { cfg.PrivateTxLifetime = 0 },
+	} {
+		cfg := txpoolcfg.DefaultConfig
+		set(&cfg)
+		_, err := New(make(chan types.Announcements), coreDB, cfg, kvcache.NewDummy(), *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+		require.Error(t, err, name)
+	}
+}
+
+func TestPrivateTxs(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan types.Announcements, 100)
+	db, coreDB := memdb.NewTestPoolDB(t), memdb.NewTestDB(t)
+
+	cfg := txpoolcfg.DefaultConfig
+	cfg.PrivateTxLifetime = 2
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	require.NoError(err)
+	ctx := context.Background()
+	var privateAddr, publicAddr [20]byte
+	privateAddr[0], publicAddr[0] = 1, 2
+	v := make([]byte, types.EncodeSenderLengthForStorage(2, *uint256.NewInt(1 * common.Ether)))
+	types.EncodeSender(2, *uint256.NewInt(1 * common.Ether), v)
+	newBlock := func(blockHeight uint64) *remote.StateChangeBatch {
+		change := &remote.StateChange{BlockHeight: blockHeight, BlockHash: gointerfaces.ConvertHashToH256([32]byte{byte(blockHeight)})}
+		for _, addr := range [][20]byte{privateAddr, publicAddr} {
+			change.Changes = append(change.Changes, &remote.AccountChange{
+				Action:  remote.Action_UPSERT,
+				Address: gointerfaces.ConvertAddressToH160(addr),
+				Data:    v,
+			})
+		}
+		return &remote.StateChangeBatch{PendingBlockBaseFee: 200000, BlockGasLimit: 1000000, ChangeBatch: []*remote.StateChange{change}}
+	}
+	tx, err := db.BeginRw(ctx)
+	require.NoError(err)
+	defer tx.Rollback()
+	require.NoError(pool.OnNewBlock(ctx, newBlock(0), types.TxSlots{}, types.TxSlots{}, tx))
+
+	newSlot := func(id byte) *types.TxSlot {
+		txSlot := &types.TxSlot{Tip: *uint256.NewInt(300000), FeeCap: *uint256.NewInt(300000), Gas: 100000, Nonce: 2, Rlp: []byte{id}}
+		txSlot.IDHash[0] = id
+		return txSlot
+	}
+	var privateTxs, publicTxs types.TxSlots
+	privateTxs.Append(newSlot(1), privateAddr[:], true)
+	publicTxs.Append(newSlot(2), publicAddr[:], true)
+	reasons, err := pool.AddPrivateTxs(ctx, privateTxs, tx)
+	require.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+	reasons, err = pool.AddLocalTxs(ctx, publicTxs, tx)
+	require.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+
+	// only the public transaction is gossiped, both are served to the local clients
+	_, _, hashes := pool.AppendAllAnnouncements(nil, nil, nil)
+	assert.Equal(types.Hashes(publicTxs.Txs[0].IDHash[:]), types.Hashes(hashes))
+	assert.True(pool.IsPrivate(privateTxs.Txs[0].IDHash[:]))
+	assert.False(pool.IsPrivate(publicTxs.Txs[0].IDHash[:]))
+	rlpTx, err := pool.GetRlp(tx, privateTxs.Txs[0].IDHash[:])
+	require.NoError(err)
+	assert.Equal([]byte{1}, rlpTx)
+	rlpTx, err = pool.GetRlp(tx, publicTxs.Txs[0].IDHash[:])
+	require.NoError(err)
+	assert.Equal([]byte{2}, rlpTx)
+
+	// both are offered for block building
+	var txs types.TxsRlp
+	_, err = pool.PeekBest(10, &txs, tx, 0, 1000000, 0)
+	require.NoError(err)
+	assert.Len(txs.Txs, 2)
+
+	// the private transaction expires after cfg.PrivateTxLifetime blocks
+	require.NoError(pool.OnNewBlock(ctx, newBlock(2), types.TxSlots{}, types.TxSlots{}, tx))
+	assert.Len(pool.byHash, 2)
+	require.NoError(pool.OnNewBlock(ctx, newBlock(3), types.TxSlots{}, types.TxSlots{}, tx))
+	assert.Len(pool.byHash, 1)
+	events := pool.Lifecycle(privateTxs.Txs[0].IDHash)
+	require.NotEmpty(events)
+	assert.Equal(TxEvent{Type: TxDiscarded, Reason: txpoolcfg.PrivateTxExpired}, TxEvent{Type: events[len(events)-1].Type, Reason: events[len(events)-1].Reason})
+	assert.Empty(pool.private)
+}
+
+func TestBundles(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan types.Announcements, 100)
+	db, coreDB := memdb.NewTestPoolDB(t), memdb.NewTestDB(t)
+
+	cfg := txpoolcfg.DefaultConfig
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+	require.NoError(err)
+	ctx := context.Background()
+	newBlock := func(blockHeight uint64) *remote.StateChangeBatch {
+		return &remote.StateChangeBatch{PendingBlockBaseFee: 200000, BlockGasLimit: 1000000, ChangeBatch: []*remote.StateChange{
+			{BlockHeight: blockHeight, BlockHash: gointerfaces.ConvertHashToH256([32]byte{byte(blockHeight)})},
+		}}
+	}
+	tx, err := db.BeginRw(ctx)
+	require.NoError(err)
+	defer tx.Rollback()
+	require.NoError(pool.OnNewBlock(ctx, newBlock(1), types.TxSlots{}, types.TxSlots{}, tx))
+
+	var addr [20]byte
+	addr[0] = 1
+	newBundle := func(ids ...byte) types.TxSlots {
+		var txs types.TxSlots
+		for i, id := range ids {
+			txSlot := &types.TxSlot{Nonce: uint64(i), Rlp: []byte{id}}
+			txSlot.IDHash[0] = id
+			txs.Append(txSlot, addr[:], true)
+		}
+		return txs
+	}
+
+	_, err = pool.AddBundle(newBundle(), 2, nil)
+	assert.ErrorIs(err, ErrEmptyBundle)
+	_, err = pool.AddBundle(newBundle(1), 1, nil)
+	assert.ErrorIs(err, ErrBundleTargetPassed)
+
+	hash, err := pool.AddBundle(newBundle(1, 2), 2, []common.Hash{{2}})
+	require.NoError(err)
+	again, err := pool.AddBundle(newBundle(1, 2), 2, nil)
+	require.NoError(err)
+	assert.Equal(hash, again)
+	otherHash, err := pool.AddBundle(newBundle(2, 1), 3, nil)
+	require.NoError(err)
+	assert.NotEqual(hash, otherHash)
+
+	bundles := pool.Bundles(2)
+	require.Len(bundles, 1)
+	assert.Equal(hash, bundles[0].Hash)
+	assert.Equal([][]byte{{1}, {2}}, bundles[0].Txs.Txs)
+	assert.Equal(addr[:], bundles[0].Txs.Senders.At(1))
+	assert.True(bundles[0].CanRevert(common.Hash{2}))
+	assert.False(bundles[0].CanRevert(common.Hash{1}))
+
+	// the bundles are dropped once their target block is mined
+	require.NoError(pool.OnNewBlock(ctx, newBlock(2), types.TxSlots{}, types.TxSlots{}, tx))
+	assert.Empty(pool.Bundles(2))
+	assert.Len(pool.Bundles(3), 1)
+}
+
+func TestLocals(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	cfg := txpoolcfg.DefaultConfig
+	cfg.Journal = filepath.Join(t.TempDir(), "locals.jsonl")
+	sender := common.HexToAddress("81f5daee2c61807d0fc5e4c8b4e1d3c3e028d9ab")
+	v := make([]byte, types.EncodeSenderLengthForStorage(0, *uint256.NewInt(1 * common.Ether)))
+	types.EncodeSender(0, *uint256.NewInt(1 * common.Ether), v)
+	newBlock := &remote.StateChangeBatch{PendingBlockBaseFee: 200000, BlockGasLimit: 1000000, ChangeBatch: []*remote.StateChange{{
+		BlockHeight: 1,
+		BlockHash:   gointerfaces.ConvertHashToH256([32]byte{1}),
+		Changes:     []*remote.AccountChange{{Action: remote.Action_UPSERT, Address: gointerfaces.ConvertAddressToH160(sender), Data: v}},
+	}}}
+	newPool := func() (*TxPool, kv.RwDB) {
+		db := memdb.NewTestPoolDB(t)
+		pool, err := New(make(chan types.Announcements, 100), memdb.NewTestDB(t), cfg, kvcache.New(kvcache.DefaultCoherentConfig), *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, log.New())
+		require.NoError(err)
+		require.NoError(db.Update(ctx, func(tx kv.RwTx) error {
+			return pool.OnNewBlock(ctx, newBlock, types.TxSlots{}, types.TxSlots{}, tx)
+		}))
+		return pool, db
+	}
+
+	pool, db := newPool()
+	var txs types.TxSlots
+	txSlot := &types.TxSlot{}
+	parseCtx := types.NewTxParseContext(*u256.N1)
+	parseCtx.WithSender(false)
+	_, err := parseCtx.ParseTransaction(hexutility.MustDecodeHex(types.TxParseMainnetTests[1].PayloadStr), 0, txSlot, nil, false /* hasEnvelope */, true /* wrappedWithBlobs */, nil)
+	require.NoError(err)
+	txs.Append(txSlot, sender[:], true)
+	require.NoError(db.View(ctx, func(tx kv.Tx) error {
+		reasons, err := pool.AddLocalTxs(ctx, txs, tx)
+		assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+		return err
+	}))
+	hash := common.Hash(txSlot.IDHash)
+
+	// rebroadcast with backoff, starting after cfg.RebroadcastEvery
+	now := time.Now()
+	for i, due := range []bool{false, true, false, true, false, false, false, true} {
+		_, _, hashes := pool.appendLocalRebroadcasts(now.Add(time.Duration(i)*cfg.RebroadcastEvery), nil, nil, nil)
+		assert.Equal(due, len(hashes) > 0, i)
+	}
+
+	// journaled on flush
+	_, err = pool.flush(ctx, db)
+	require.NoError(err)
+	entries, err := readJournal(cfg.Journal)
+	require.NoError(err)
+	require.Len(entries, 1)
+	assert.Equal(journalEntry{Hash: hash, Sender: sender, Rlp: hexutility.MustDecodeHex(types.TxParseMainnetTests[1].PayloadStr)}, entries[0])
+
+	// restored from the journal as a local transaction when the pool db is lost
+	pool, db = newPool()
+	assert.True(pool.IsLocal(hash[:]))
+	require.NoError(db.View(ctx, func(tx kv.Tx) error {
+		locals, err := pool.Locals(tx)
+		require.NoError(err)
+		require.Len(locals, 1)
+		assert.Equal(LocalTx{Hash: hash, Sender: sender, Nonce: 0, SubPool: PendingSubPool, Rlp: entries[0].Rlp}, locals[0])
+
+		evicted, err := pool.EvictLocal(tx, common.Hash{1})
+		require.NoError(err)
+		assert.False(evicted)
+		evicted, err = pool.EvictLocal(tx, hash)
+		require.NoError(err)
+		assert.True(evicted)
+		locals, err = pool.Locals(tx)
+		require.NoError(err)
+		assert.Empty(locals)
+		return nil
+	}))
+	assert.False(pool.IsLocal(hash[:]))
+	entries, err = readJournal(cfg.Journal)
+	require.NoError(err)
+	assert.Empty(entries)
+}
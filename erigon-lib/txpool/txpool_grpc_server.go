@@ -47,7 +47,7 @@ import (
 )
 
 // TxPoolAPIVersion
-var TxPoolAPIVersion = &types2.VersionReply{Major: 1, Minor: 0, Patch: 0}
+var TxPoolAPIVersion = &types2.VersionReply{Major: 1, Minor: 4, Patch: 0}
 
 type txPool interface {
 	ValidateSerializedTxn(serializedTxn []byte) error
@@ -55,10 +55,15 @@ type txPool interface {
 	PeekBest(n uint16, txs *types.TxsRlp, tx kv.Tx, onTopOf, availableGas, availableBlobGas uint64) (bool, error)
 	GetRlp(tx kv.Tx, hash []byte) ([]byte, error)
 	AddLocalTxs(ctx context.Context, newTxs types.TxSlots, tx kv.Tx) ([]txpoolcfg.DiscardReason, error)
+	AddPrivateTxs(ctx context.Context, newTxs types.TxSlots, tx kv.Tx) ([]txpoolcfg.DiscardReason, error)
 	deprecatedForEach(_ context.Context, f func(rlp []byte, sender common.Address, t SubPoolType), tx kv.Tx)
 	CountContent() (int, int, int)
 	IdHashKnown(tx kv.Tx, hash []byte) (bool, error)
 	NonceFromAddress(addr [20]byte) (nonce uint64, inPool bool)
+	Lifecycle(hash common.Hash) []TxEvent
+	AddBundle(txs types.TxSlots, blockNum uint64, revertingTxHashes []common.Hash) (common.Hash, error)
+	Locals(tx kv.Tx) ([]LocalTx, error)
+	EvictLocal(tx kv.Tx, hash common.Hash) (bool, error)
 }
 
 var _ txpool_proto.TxpoolServer = (*GrpcServer)(nil)   // compile-time interface check
@@ -97,6 +102,18 @@ func (*GrpcDisabled) Status(ctx context.Context, request *txpool_proto.StatusReq
 func (*GrpcDisabled) Nonce(ctx context.Context, request *txpool_proto.NonceRequest) (*txpool_proto.NonceReply, error) {
 	return nil, ErrPoolDisabled
 }
+func (*GrpcDisabled) Lifecycle(ctx context.Context, request *txpool_proto.LifecycleRequest) (*txpool_proto.LifecycleReply, error) {
+	return nil, ErrPoolDisabled
+}
+func (*GrpcDisabled) SendBundle(ctx context.Context, request *txpool_proto.SendBundleRequest) (*txpool_proto.SendBundleReply, error) {
+	return nil, ErrPoolDisabled
+}
+func (*GrpcDisabled) Locals(ctx context.Context, request *txpool_proto.LocalsRequest) (*txpool_proto.LocalsReply, error) {
+	return nil, ErrPoolDisabled
+}
+func (*GrpcDisabled) EvictLocal(ctx context.Context, request *txpool_proto.EvictLocalRequest) (*txpool_proto.EvictLocalReply, error) {
+	return nil, ErrPoolDisabled
+}
 
 type GrpcServer struct {
 	txpool_proto.UnimplementedTxpoolServer
@@ -174,6 +191,9 @@ func (s *GrpcServer) FindUnknown(ctx context.Context, in *txpool_proto.TxHashes)
 	return nil, fmt.Errorf("unimplemented")
 }
 
+// Add adds local transactions to the pool. The private ones aren't authorized further: this server is only reachable
+// through the private API, whose transport credentials already guard adding any transaction, and a private
+// transaction only differs by being kept from the peers.
 func (s *GrpcServer) Add(ctx context.Context, in *txpool_proto.AddRequest) (*txpool_proto.AddReply, error) {
 	tx, err := s.db.BeginRo(ctx)
 	if err != nil {
@@ -213,7 +233,12 @@ func (s *GrpcServer) Add(ctx context.Context, in *txpool_proto.AddRequest) (*txp
 		j++
 	}
 
-	discardReasons, err := s.txPool.AddLocalTxs(ctx, slots, tx)
+	var discardReasons []txpoolcfg.DiscardReason
+	if in.Private {
+		discardReasons, err = s.txPool.AddPrivateTxs(ctx, slots, tx)
+	} else {
+		discardReasons, err = s.txPool.AddLocalTxs(ctx, slots, tx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -304,6 +329,104 @@ func (s *GrpcServer) Nonce(ctx context.Context, in *txpool_proto.NonceRequest) (
 	}, nil
 }
 
+// returns the lifecycle events of a recently seen transaction
+func (s *GrpcServer) Lifecycle(_ context.Context, in *txpool_proto.LifecycleRequest) (*txpool_proto.LifecycleReply, error) {
+	if in.Hash == nil {
+		return nil, errors.New("transaction hash is required")
+	}
+	events := s.txPool.Lifecycle(gointerfaces.ConvertH256ToHash(in.Hash))
+	reply := &txpool_proto.LifecycleReply{Events: make([]*txpool_proto.LifecycleReply_Event, len(events))}
+	for i, event := range events {
+		e := &txpool_proto.LifecycleReply_Event{Timestamp: uint64(event.Time.UnixMilli())}
+		switch event.Type {
+		case TxAdded:
+			e.Type = txpool_proto.LifecycleReply_ADDED
+		case TxReinjected:
+			e.Type = txpool_proto.LifecycleReply_REINJECTED
+		case TxMoved:
+			e.Type = txpool_proto.LifecycleReply_MOVED
+			e.SubPool = convertSubPoolType(event.SubPool)
+		case TxDiscarded:
+			e.Type = txpool_proto.LifecycleReply_DISCARDED
+			e.Reason = event.Reason.String()
+		case TxMined:
+			e.Type = txpool_proto.LifecycleReply_MINED
+			e.BlockNumber = event.BlockNum
+		}
+		if event.ReplacedBy != (common.Hash{}) {
+			e.ReplacedBy = gointerfaces.ConvertHashToH256(event.ReplacedBy)
+		}
+		reply.Events[i] = e
+	}
+	return reply, nil
+}
+
+// keeps a bundle of transactions until its target block is mined, for the local block building
+func (s *GrpcServer) SendBundle(_ context.Context, in *txpool_proto.SendBundleRequest) (*txpool_proto.SendBundleReply, error) {
+	var slots types.TxSlots
+	parseCtx := types.NewTxParseContext(s.chainID).ChainIDRequired()
+	parseCtx.ValidateRLP(s.txPool.ValidateSerializedTxn)
+	slots.Resize(uint(len(in.RlpTxs)))
+	for i := range in.RlpTxs {
+		slots.Txs[i] = &types.TxSlot{}
+		slots.IsLocal[i] = true
+		if _, err := parseCtx.ParseTransaction(in.RlpTxs[i], 0, slots.Txs[i], slots.Senders.At(i), false /* hasEnvelope */, true /* wrappedWithBlobs */, nil); err != nil {
+			return nil, fmt.Errorf("bundle transaction %d: %w", i, err)
+		}
+	}
+	revertingTxHashes := make([]common.Hash, len(in.RevertingTxHashes))
+	for i, hash := range in.RevertingTxHashes {
+		revertingTxHashes[i] = gointerfaces.ConvertH256ToHash(hash)
+	}
+	hash, err := s.txPool.AddBundle(slots, in.BlockNumber, revertingTxHashes)
+	if err != nil {
+		return nil, err
+	}
+	return &txpool_proto.SendBundleReply{Hash: gointerfaces.ConvertHashToH256(hash)}, nil
+}
+
+// returns the local transactions in the pool
+func (s *GrpcServer) Locals(ctx context.Context, _ *txpool_proto.LocalsRequest) (*txpool_proto.LocalsReply, error) {
+	tx, err := s.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	locals, err := s.txPool.Locals(tx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &txpool_proto.LocalsReply{Txs: make([]*txpool_proto.LocalsReply_Tx, len(locals))}
+	for i, local := range locals {
+		reply.Txs[i] = &txpool_proto.LocalsReply_Tx{
+			Hash:    gointerfaces.ConvertHashToH256(local.Hash),
+			Sender:  gointerfaces.ConvertAddressToH160(local.Sender),
+			Nonce:   local.Nonce,
+			SubPool: convertSubPoolType(local.SubPool),
+			Private: local.Private,
+			RlpTx:   local.Rlp,
+		}
+	}
+	return reply, nil
+}
+
+// drops a local transaction from the pool and from the journal
+func (s *GrpcServer) EvictLocal(ctx context.Context, in *txpool_proto.EvictLocalRequest) (*txpool_proto.EvictLocalReply, error) {
+	if in.Hash == nil {
+		return nil, errors.New("transaction hash is required")
+	}
+	tx, err := s.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	evicted, err := s.txPool.EvictLocal(tx, gointerfaces.ConvertH256ToHash(in.Hash))
+	if err != nil {
+		return nil, err
+	}
+	return &txpool_proto.EvictLocalReply{Evicted: evicted}, nil
+}
+
 // NewSlotsStreams - it's safe to use this class as non-pointer
 type NewSlotsStreams struct {
 	chans map[uint]txpool_proto.Txpool_OnAddServer
@@ -41,6 +41,17 @@ type Config struct {
 	BlobPriceBump       uint64 //Price bump percentage to replace an existing 4844 blob tx (type-3)
 	OverrideCancunTime  *big.Int
 
+	// ordering of the pending transactions offered for block building
+	Ordering         Ordering
+	PrioritySenders  []string // List of senders whose transactions are offered before the others
+	MaxBestPerSender int      // Maximum number of transactions of a sender offered for a block, 0 means no limit
+
+	PrivateTxLifetime uint64 // Number of blocks after which a private transaction which was not included is dropped
+
+	// local transactions
+	Journal          string        // File the local transactions are journaled to, to restore them if the pool db is lost. Empty disables it
+	RebroadcastEvery time.Duration // First interval between rebroadcasts of a local transaction, doubled after each one
+
 	// regular batch tasks processing
 	SyncToNewPeersEvery   time.Duration
 	ProcessRemoteTxsEvery time.Duration
@@ -71,9 +82,24 @@ var DefaultConfig = Config{
 	PriceBump:     10, // Price bump percentage to replace an already existing transaction
 	BlobPriceBump: 100,
 
+	Ordering: OrderByPrice,
+
+	PrivateTxLifetime: 25,
+
+	RebroadcastEvery: time.Minute,
+
 	NoGossip: false,
 }
 
+// Ordering is the order in which the pending transactions are offered for block building.
+// The transactions of a sender are always offered in nonce order.
+type Ordering string
+
+const (
+	OrderByPrice   Ordering = "price"   // highest effective tip first
+	OrderByArrival Ordering = "arrival" // first come, first served
+)
+
 type DiscardReason uint8
 
 const (
@@ -108,6 +134,9 @@ const (
 	BlobHashCheckFail   DiscardReason = 28 // KZGcommitment's versioned hash has to be equal to blob_versioned_hash at the same index
 	UnmatchedBlobTxExt  DiscardReason = 29 // KZGcommitments must match the corresponding blobs and proofs
 	BlobTxReplace       DiscardReason = 30 // Cannot replace type-3 blob txn with another type of txn
+	NoAuthorizations    DiscardReason = 31 // EIP-7702 transactions with an empty authorization list are invalid
+	PrivateTxExpired    DiscardReason = 32 // Private transaction was not included within PrivateTxLifetime blocks
+	EvictedLocal        DiscardReason = 33 // Local transaction was evicted through the API
 )
 
 func (r DiscardReason) String() string {
@@ -168,13 +197,19 @@ func (r DiscardReason) String() string {
 		return "max number of blobs exceeded"
 	case BlobTxReplace:
 		return "can't replace blob-txn with a non-blob-txn"
+	case NoAuthorizations:
+		return "EIP-7702 transactions with an empty authorization list are invalid"
+	case PrivateTxExpired:
+		return "private transaction expired"
+	case EvictedLocal:
+		return "local transaction evicted"
 	default:
 		panic(fmt.Sprintf("discard reason: %d", r))
 	}
 }
 
 // CalcIntrinsicGas computes the 'intrinsic gas' for a message with the given data.
-func CalcIntrinsicGas(dataLen, dataNonZeroLen uint64, accessList types.AccessList, isContractCreation, isHomestead, isEIP2028, isShanghai bool) (uint64, DiscardReason) {
+func CalcIntrinsicGas(dataLen, dataNonZeroLen, authorizationsLen uint64, accessList types.AccessList, isContractCreation, isHomestead, isEIP2028, isShanghai bool) (uint64, DiscardReason) {
 	// Set the starting gas for the raw transaction
 	var gas uint64
 	if isContractCreation && isHomestead {
@@ -243,6 +278,17 @@ func CalcIntrinsicGas(dataLen, dataNonZeroLen uint64, accessList types.AccessLis
 			return 0, GasUintOverflow
 		}
 	}
+	// Add the cost of authorizations from EIP-7702
+	if authorizationsLen > 0 {
+		product, overflow := emath.SafeMul(authorizationsLen, fixedgas.PerEmptyAccountCost)
+		if overflow {
+			return 0, GasUintOverflow
+		}
+		gas, overflow = emath.SafeAdd(gas, product)
+		if overflow {
+			return 0, GasUintOverflow
+		}
+	}
 	return gas, Success
 }
 
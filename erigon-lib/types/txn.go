@@ -108,6 +108,9 @@ type TxSlot struct {
 	Blobs       [][]byte
 	Commitments []gokzg4844.KZGCommitment
 	Proofs      []gokzg4844.KZGProof
+
+	// EIP-7702: Set EOA account code
+	AuthorizationsLen int // Number of authorizations of a set code transaction
 }
 
 const (
@@ -115,6 +118,7 @@ const (
 	AccessListTxType byte = 1 // EIP-2930
 	DynamicFeeTxType byte = 2 // EIP-1559
 	BlobTxType       byte = 3 // EIP-4844
+	SetCodeTxType    byte = 4 // EIP-7702
 )
 
 var ErrParseTxn = fmt.Errorf("%w transaction", rlp.ErrParse)
@@ -184,7 +188,7 @@ func (ctx *TxParseContext) ParseTransaction(payload []byte, pos int, slot *TxSlo
 	// If it is non-legacy transaction, the transaction type follows, and then the the list
 	if !legacy {
 		slot.Type = payload[p]
-		if slot.Type > BlobTxType {
+		if slot.Type > SetCodeTxType {
 			return 0, fmt.Errorf("%w: unknown transaction type: %d", ErrParseTxn, slot.Type)
 		}
 		p++
@@ -465,6 +469,61 @@ func (ctx *TxParseContext) parseTransactionBody(payload []byte, pos, p0 int, slo
 		}
 		p = dataPos + dataLen
 	}
+	if slot.Type == SetCodeTxType {
+		if slot.Creation {
+			return 0, fmt.Errorf("%w: set code transaction without to field", ErrParseTxn)
+		}
+		dataPos, dataLen, err = rlp.List(payload, p)
+		if err != nil {
+			return 0, fmt.Errorf("%w: authorizations len: %s", ErrParseTxn, err) //nolint
+		}
+		authPos := dataPos
+		for authPos < dataPos+dataLen {
+			var authLen int
+			authPos, authLen, err = rlp.List(payload, authPos)
+			if err != nil {
+				return 0, fmt.Errorf("%w: authorization len: %s", ErrParseTxn, err) //nolint
+			}
+			var chainID, r, s uint256.Int
+			fieldPos, err := rlp.U256(payload, authPos, &chainID)
+			if err != nil {
+				return 0, fmt.Errorf("%w: authorization chainId: %s", ErrParseTxn, err) //nolint
+			}
+			fieldPos, err = rlp.StringOfLen(payload, fieldPos, 20)
+			if err != nil {
+				return 0, fmt.Errorf("%w: authorization address: %s", ErrParseTxn, err) //nolint
+			}
+			fieldPos, _, err = rlp.U64(payload, fieldPos+20)
+			if err != nil {
+				return 0, fmt.Errorf("%w: authorization nonce: %s", ErrParseTxn, err) //nolint
+			}
+			var yParity uint64
+			fieldPos, yParity, err = rlp.U64(payload, fieldPos)
+			if err != nil {
+				return 0, fmt.Errorf("%w: authorization y parity: %s", ErrParseTxn, err) //nolint
+			}
+			if yParity > 255 {
+				return 0, fmt.Errorf("%w: authorization y parity is too large: %d", ErrParseTxn, yParity)
+			}
+			fieldPos, err = rlp.U256(payload, fieldPos, &r)
+			if err != nil {
+				return 0, fmt.Errorf("%w: authorization r: %s", ErrParseTxn, err) //nolint
+			}
+			fieldPos, err = rlp.U256(payload, fieldPos, &s)
+			if err != nil {
+				return 0, fmt.Errorf("%w: authorization s: %s", ErrParseTxn, err) //nolint
+			}
+			if fieldPos != authPos+authLen {
+				return 0, fmt.Errorf("%w: extraneous space in the authorization", ErrParseTxn)
+			}
+			slot.AuthorizationsLen++
+			authPos += authLen
+		}
+		if authPos != dataPos+dataLen {
+			return 0, fmt.Errorf("%w: extraneous space in the authorizations", ErrParseTxn)
+		}
+		p = dataPos + dataLen
+	}
 	// This is where the data for Sighash ends
 	// Next follows V of the signature
 	var vByte byte
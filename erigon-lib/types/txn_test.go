@@ -292,3 +292,25 @@ func TestBlobTxParsing(t *testing.T) {
 	assert.Equal(t, proof0, fatTx.Proofs[0])
 	assert.Equal(t, proof1, fatTx.Proofs[1])
 }
+
+func TestSetCodeTxParsing(t *testing.T) {
+	// A set code transaction with two authorizations, the second one valid on any chain
+	payload := hexutility.MustDecodeHex("04f9011d01020102830186a094000000000000000000000000000000000000cccc0180c0f8b8f85a0194" +
+		"000000000000000000000000000000000000aaaa0101a0f7e3e597fc097e71ed6c26b14b25e5395bc8510d58b9136af439e12715f2d721a0" +
+		"6cf7c3d7939bfdb784373effc0ebb0bd7549691a513f395e3cdabf8602724987f85a8094000000000000000000000000000000000000bbbb" +
+		"8080a04601e4da1a413b41f83a1ec6d441b1a1bf238266f6e14f5183b9dfcf6d835381a05e90d5016fab7ad5b69d409a183936c8c6c96dbe" +
+		"5fc693d4d73517feb1b08d9a01a00c73fe8f69c75544e64ac9e33222a7e59fbd260c0001076c98bd1065278d1b21a0206e73c55d7e46ef57" +
+		"abdf709392977ea7630e699b7408fe15dfa4715ea71e40")
+
+	ctx := NewTxParseContext(*uint256.NewInt(1))
+	var tx TxSlot
+	var sender [20]byte
+	p, err := ctx.ParseTransaction(payload, 0, &tx, sender[:], false /* hasEnvelope */, false /* wrappedWithBlobs */, nil)
+	require.NoError(t, err)
+	assert.Equal(t, len(payload), p)
+	assert.Equal(t, SetCodeTxType, tx.Type)
+	assert.Equal(t, uint64(2), tx.Nonce)
+	assert.Equal(t, 2, tx.AuthorizationsLen)
+	assert.Equal(t, hexutility.MustDecodeHex("8642a2644b88045b969bdaed6a77abffd21454ebd2cbf20ede4c21e491dde1a7"), tx.IDHash[:])
+	assert.Equal(t, hexutility.MustDecodeHex("703c4b2bd70c169f5717101caee543299fc946c7"), sender[:])
+}
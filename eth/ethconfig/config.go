@@ -258,6 +258,11 @@ type Config struct {
 	SilkwormRpcDaemon bool
 	SilkwormSentry    bool
 
+	// Live tracing of the executed blocks, see eth/tracers/live
+	LiveTracer       string // name of the tracer, e.g. "callTracer", disabled if empty
+	LiveTracerConfig string // JSON configuration of the tracer
+	LiveTracerOutput string // file the traces are appended to, "-" for stdout
+
 	DisableTxPoolGossip bool
 }
 
@@ -71,6 +71,12 @@ var DefaultTxPool2Config = func(fullCfg *Config) txpoolcfg.Config {
 	cfg.MinFeeCap = pool1Cfg.PriceLimit
 	cfg.AccountSlots = pool1Cfg.AccountSlots
 	cfg.BlobSlots = fullCfg.TxPool.BlobSlots
+	cfg.Ordering = fullCfg.TxPool.Ordering
+	cfg.PrioritySenders = fullCfg.TxPool.PrioritySenders
+	cfg.MaxBestPerSender = fullCfg.TxPool.MaxBestPerSender
+	cfg.PrivateTxLifetime = fullCfg.TxPool.PrivateTxLifetime
+	cfg.Journal = fullCfg.TxPool.Journal
+	cfg.RebroadcastEvery = fullCfg.TxPool.RebroadcastEvery
 	cfg.LogEvery = 3 * time.Minute
 	cfg.CommitEvery = 5 * time.Minute
 	cfg.TracedSenders = pool1Cfg.TracedSenders
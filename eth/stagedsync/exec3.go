@@ -249,6 +249,14 @@ func ExecV3(ctx context.Context,
 	execWorkers, applyWorker, rws, stopWorkers, waitWorkers := exec3.NewWorkersPool(lock.RLocker(), ctx, parallel, chainDb, rs, in, blockReader, chainConfig, genesis, engine, workerCount+1)
 	defer stopWorkers()
 	applyWorker.DiscardReadList()
+	liveTracer := cfg.liveTracer
+	if parallel {
+		// blocks are executed by several workers, out of order
+		liveTracer = nil
+	}
+	if liveTracer != nil {
+		applyWorker.SetLiveTracer(liveTracer)
+	}
 
 	commitThreshold := batchSize.Bytes()
 	progress := NewProgress(block, commitThreshold, workerCount, execStage.LogPrefix(), logger)
@@ -575,6 +583,9 @@ Loop:
 
 		rules := chainConfig.Rules(blockNum, b.Time())
 		var gasUsed uint64
+		if liveTracer != nil {
+			liveTracer.OnBlockStart(b)
+		}
 		for txIndex := -1; txIndex <= len(txs); txIndex++ {
 
 			// Do not oversend, wait for the result heap to go under certain size
@@ -651,6 +662,9 @@ Loop:
 							return err
 						}
 					}
+					if liveTracer != nil {
+						liveTracer.OnBlockEnd(err)
+					}
 					u.UnwindTo(blockNum-1, BadBlock(header.Hash(), err))
 					break Loop
 				}
@@ -669,6 +683,10 @@ Loop:
 			inputTxNum++
 		}
 
+		if liveTracer != nil {
+			liveTracer.OnBlockEnd(nil)
+		}
+
 		if !parallel {
 			outputBlockNum.SetUint64(blockNum)
 
@@ -42,6 +42,7 @@ import (
 	"github.com/ledgerwatch/erigon/eth/ethconfig"
 	"github.com/ledgerwatch/erigon/eth/ethconfig/estimate"
 	"github.com/ledgerwatch/erigon/eth/stagedsync/stages"
+	"github.com/ledgerwatch/erigon/eth/tracers/live"
 	trace_logger "github.com/ledgerwatch/erigon/eth/tracers/logger"
 	"github.com/ledgerwatch/erigon/ethdb/prune"
 	"github.com/ledgerwatch/erigon/turbo/services"
@@ -88,6 +89,8 @@ type ExecuteBlockCfg struct {
 	agg       *libstate.AggregatorV3
 
 	silkworm *silkworm.Silkworm
+	// liveTracer, if not nil, traces every block executed by the stage
+	liveTracer live.Tracer
 }
 
 func StageExecuteBlocksCfg(
@@ -110,6 +113,7 @@ func StageExecuteBlocksCfg(
 	syncCfg ethconfig.Sync,
 	agg *libstate.AggregatorV3,
 	silkworm *silkworm.Silkworm,
+	liveTracer live.Tracer,
 ) ExecuteBlockCfg {
 	if genesis == nil {
 		panic("assert: nil genesis")
@@ -133,6 +137,7 @@ func StageExecuteBlocksCfg(
 		syncCfg:       syncCfg,
 		agg:           agg,
 		silkworm:      silkworm,
+		liveTracer:    liveTracer,
 	}
 }
 
@@ -168,6 +173,14 @@ func executeBlock(
 	callTracer := calltracer.NewCallTracer()
 	vmConfig.Debug = true
 	vmConfig.Tracer = callTracer
+	if cfg.liveTracer != nil {
+		// the tracers are then set per transaction, so that the live tracer knows which one is executed
+		vmConfig.Tracer = nil
+		getTracer = func(txIndex int, txHash common.Hash) (vm.EVMLogger, error) {
+			cfg.liveTracer.OnTxStart(txIndex, block.Transactions()[txIndex])
+			return live.NewMux(callTracer, cfg.liveTracer), nil
+		}
+	}
 
 	var receipts types.Receipts
 	var stateSyncReceipt *types.Receipt
@@ -281,7 +294,8 @@ func ExecBlockV3(s *StageState, u Unwinder, txc wrap.TxContainer, toBlock uint64
 	if to > s.BlockNumber+16 {
 		logger.Info(fmt.Sprintf("[%s] Blocks execution", logPrefix), "from", s.BlockNumber, "to", to)
 	}
-	parallel := txc.Tx == nil
+	// the live tracer follows the blocks in order, which parallel execution does not guarantee
+	parallel := txc.Tx == nil && cfg.liveTracer == nil
 	if err := ExecV3(ctx, s, u, workersCount, cfg, txc, parallel, logPrefix,
 		to, logger, initialCycle); err != nil {
 		return fmt.Errorf("ExecV3: %w", err)
@@ -468,7 +482,13 @@ Loop:
 		if cfg.silkworm != nil && !isMemoryMutation {
 			blockNum, err = silkworm.ExecuteBlocks(cfg.silkworm, txc.Tx, cfg.chainConfig.ChainID, blockNum, to, uint64(cfg.batchSize), writeChangeSets, writeReceipts, writeCallTraces)
 		} else {
+			if cfg.liveTracer != nil {
+				cfg.liveTracer.OnBlockStart(block)
+			}
 			err = executeBlock(block, txc.Tx, batch, cfg, *cfg.vmConfig, writeChangeSets, writeReceipts, writeCallTraces, initialCycle, stateStream, logger)
+			if cfg.liveTracer != nil {
+				cfg.liveTracer.OnBlockEnd(err)
+			}
 		}
 
 		if err != nil {
@@ -695,6 +715,9 @@ func UnwindExecutionStage(u *UnwindState, s *StageState, txc wrap.TxContainer, c
 	if err = u.Done(txc.Tx); err != nil {
 		return err
 	}
+	if cfg.liveTracer != nil {
+		cfg.liveTracer.OnUnwind(u.UnwindPoint)
+	}
 
 	if !useExternalTx {
 		if err = txc.Tx.Commit(); err != nil {
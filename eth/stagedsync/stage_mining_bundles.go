@@ -0,0 +1,96 @@
+package stagedsync
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/log/v3"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/txpool"
+	"github.com/ledgerwatch/erigon/consensus"
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/state"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+)
+
+// addBundlesToMiningBlock includes the bundles targeting the block, in the order they were sent, each one only if
+// all of its transactions succeed or revert while allowed to. Otherwise the state and the gas used are reverted to
+// what they were before the bundle.
+func addBundlesToMiningBlock(logPrefix string, current *MiningBlock, chainConfig chain.Config, vmConfig *vm.Config, getHeader func(hash libcommon.Hash, number uint64) *types.Header,
+	engine consensus.Engine, bundles []*txpool.Bundle, chainID *uint256.Int, coinbase libcommon.Address, ibs *state.IntraBlockState, logger log.Logger) types.Logs {
+	header := current.Header
+	noop := state.NewNoopWriter()
+	var coalescedLogs types.Logs
+
+	ibs.KeepJournal(true)
+	defer ibs.KeepJournal(false)
+	for _, bundle := range bundles {
+		txs, err := decodeBundle(bundle, chainID)
+		if err != nil {
+			logger.Debug(fmt.Sprintf("[%s] Skipping bundle", logPrefix), "hash", bundle.Hash, "err", err)
+			continue
+		}
+
+		snapshot := ibs.Snapshot()
+		gasUsed := header.GasUsed
+		var blobGasUsed uint64
+		gasPool := new(core.GasPool).AddGas(header.GasLimit - header.GasUsed)
+		if header.BlobGasUsed != nil {
+			blobGasUsed = *header.BlobGasUsed
+			gasPool.AddBlobGas(chainConfig.GetMaxBlobGasPerBlock() - blobGasUsed)
+		}
+		receipts := make(types.Receipts, 0, len(txs))
+		for i, txn := range txs {
+			ibs.SetTxContext(txn.Hash(), libcommon.Hash{}, len(current.Txs)+i)
+			var receipt *types.Receipt
+			receipt, _, err = core.ApplyTransaction(&chainConfig, core.GetHashFn(header, getHeader), engine, &coinbase, gasPool, ibs, noop, header, txn, &header.GasUsed, header.BlobGasUsed, *vmConfig)
+			if err == nil && receipt.Status == types.ReceiptStatusFailed && !bundle.CanRevert(txn.Hash()) {
+				err = errors.New("reverted")
+			}
+			if err != nil {
+				err = fmt.Errorf("transaction %x: %w", txn.Hash(), err)
+				break
+			}
+			receipts = append(receipts, receipt)
+		}
+		if err != nil {
+			ibs.RevertToSnapshot(snapshot)
+			header.GasUsed = gasUsed
+			if header.BlobGasUsed != nil {
+				*header.BlobGasUsed = blobGasUsed
+			}
+			logger.Debug(fmt.Sprintf("[%s] Skipping bundle", logPrefix), "hash", bundle.Hash, "err", err)
+			continue
+		}
+
+		current.Txs = append(current.Txs, txs...)
+		current.Receipts = append(current.Receipts, receipts...)
+		for _, receipt := range receipts {
+			coalescedLogs = append(coalescedLogs, receipt.Logs...)
+		}
+		logger.Debug(fmt.Sprintf("[%s] Bundle included", logPrefix), "hash", bundle.Hash, "txs", len(txs))
+	}
+	return coalescedLogs
+}
+
+func decodeBundle(bundle *txpool.Bundle, chainID *uint256.Int) ([]types.Transaction, error) {
+	txs := make([]types.Transaction, len(bundle.Txs.Txs))
+	for i := range bundle.Txs.Txs {
+		txn, err := types.DecodeWrappedTransaction(bundle.Txs.Txs[i])
+		if err != nil {
+			return nil, err
+		}
+		if !txn.GetChainID().IsZero() && txn.GetChainID().Cmp(chainID) != 0 {
+			return nil, fmt.Errorf("transaction %x: invalid chain id %d", txn.Hash(), txn.GetChainID())
+		}
+		var sender libcommon.Address
+		copy(sender[:], bundle.Txs.Senders.At(i))
+		txn.SetSender(sender)
+		txs[i] = txn
+	}
+	return txs, nil
+}
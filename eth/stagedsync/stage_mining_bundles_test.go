@@ -0,0 +1,82 @@
+package stagedsync
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+	"github.com/ledgerwatch/erigon-lib/txpool"
+
+	"github.com/ledgerwatch/erigon/core/state"
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/params"
+)
+
+func TestAddBundlesToMiningBlock(t *testing.T) {
+	require := require.New(t)
+	tx, err := memdb.NewTestDB(t).BeginRw(context.Background())
+	require.NoError(err)
+	defer tx.Rollback()
+
+	chainConfig := *params.TestChainConfig
+	chainID, _ := uint256.FromBig(chainConfig.ChainID)
+	signer := types.LatestSignerForChainID(chainConfig.ChainID)
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	reverting := libcommon.Address{0xfd}
+
+	ibs := state.New(state.NewPlainStateReader(tx))
+	ibs.SetBalance(sender, uint256.NewInt(params.Ether), tracing.BalanceChangeUnspecified)
+	ibs.SetCode(reverting, []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.REVERT)})
+	require.NoError(ibs.FinalizeTx(chainConfig.Rules(0, 0), state.NewNoopWriter()))
+
+	newTx := func(nonce uint64, to libcommon.Address) types.Transaction {
+		txn, err := types.SignTx(types.NewTransaction(nonce, to, uint256.NewInt(1), 100_000, uint256.NewInt(1), nil), *signer, key)
+		require.NoError(err)
+		return txn
+	}
+	newBundle := func(txs ...types.Transaction) *txpool.Bundle {
+		bundle := &txpool.Bundle{BlockNum: 1, RevertingTxHashes: map[libcommon.Hash]struct{}{}}
+		bundle.Txs.Resize(uint(len(txs)))
+		for i, txn := range txs {
+			var buf bytes.Buffer
+			require.NoError(txn.MarshalBinary(&buf))
+			bundle.Txs.Txs[i] = buf.Bytes()
+			copy(bundle.Txs.Senders.At(i), sender[:])
+		}
+		return bundle
+	}
+
+	includedTx := newTx(0, libcommon.Address{1})
+	included := newBundle(includedTx)
+	partlyFailing := newBundle(newTx(1, libcommon.Address{3}), newTx(5, libcommon.Address{3}))
+	revertingTx, afterRevertingTx := newTx(1, reverting), newTx(2, libcommon.Address{1})
+	dropped := newBundle(revertingTx, afterRevertingTx)
+	allowedToRevert := newBundle(revertingTx, afterRevertingTx)
+	allowedToRevert.RevertingTxHashes[revertingTx.Hash()] = struct{}{}
+	tooHighNonce := newBundle(newTx(5, libcommon.Address{1}))
+
+	current := &MiningBlock{Header: &types.Header{Number: big.NewInt(1), GasLimit: 10_000_000, Difficulty: big.NewInt(1)}}
+	getHeader := func(hash libcommon.Hash, number uint64) *types.Header { return nil }
+	addBundlesToMiningBlock("test", current, chainConfig, &vm.Config{}, getHeader, nil, []*txpool.Bundle{included, partlyFailing, dropped, allowedToRevert, tooHighNonce}, chainID, libcommon.Address{2}, ibs, log.New())
+
+	require.Len(current.Txs, 3)
+	require.Equal(includedTx.Hash(), current.Txs[0].Hash())
+	require.Equal(revertingTx.Hash(), current.Txs[1].Hash())
+	require.Equal(afterRevertingTx.Hash(), current.Txs[2].Hash())
+	require.Equal(types.ReceiptStatusFailed, current.Receipts[1].Status)
+	require.Equal(types.ReceiptStatusSuccessful, current.Receipts[2].Status)
+	require.Equal(current.Receipts[2].CumulativeGasUsed, current.Header.GasUsed)
+	require.Equal(uint64(3), ibs.GetNonce(sender))
+	require.True(ibs.GetBalance(libcommon.Address{3}).IsZero())
+}
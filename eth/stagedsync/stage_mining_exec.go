@@ -17,6 +17,7 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/membatch"
+	"github.com/ledgerwatch/erigon-lib/txpool"
 	types2 "github.com/ledgerwatch/erigon-lib/types"
 	"github.com/ledgerwatch/erigon/consensus"
 	"github.com/ledgerwatch/erigon/core"
@@ -47,6 +48,7 @@ type MiningExecCfg struct {
 
 type TxPoolForMining interface {
 	YieldBest(n uint16, txs *types2.TxsRlp, tx kv.Tx, onTopOf, availableGas, availableBlobGas uint64, toSkip mapset.Set[[32]byte]) (bool, int, error)
+	Bundles(blockNum uint64) []*txpool.Bundle
 }
 
 func StageMiningExecCfg(
@@ -123,6 +125,15 @@ func SpawnMiningExecStage(s *StageState, tx kv.RwTx, cfg MiningExecCfg, quit <-c
 				return err
 			}
 
+			// bundles go first, at the top of the block
+			if bundles := cfg.txPool2.Bundles(current.Header.Number.Uint64()); len(bundles) > 0 {
+				logs := addBundlesToMiningBlock(logPrefix, current, cfg.chainConfig, cfg.vmConfig, getHeader, cfg.engine, bundles, chainID, cfg.miningState.MiningConfig.Etherbase, ibs, logger)
+				NotifyPendingLogs(logPrefix, cfg.notifier, logs, logger)
+				for _, txn := range current.Txs {
+					yielded.Add(txn.Hash())
+				}
+			}
+
 			for {
 				txs, y, err := getNextTransactions(cfg, chainID, current.Header, 50, executionAt, simulationTx, yielded, logger)
 				if err != nil {
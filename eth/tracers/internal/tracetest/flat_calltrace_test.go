@@ -0,0 +1,99 @@
+package tracetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon/common"
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+	"github.com/ledgerwatch/erigon/tests"
+	"github.com/ledgerwatch/erigon/turbo/stages/mock"
+)
+
+// flatCallTracerTest defines a single test to check the flat call tracer against,
+// the result is in the format of trace_transaction.
+type flatCallTracerTest struct {
+	Genesis      *types.Genesis  `json:"genesis"`
+	Context      *callContext    `json:"context"`
+	Input        string          `json:"input"`
+	TracerConfig json.RawMessage `json:"tracerConfig"`
+	Result       json.RawMessage `json:"result"`
+}
+
+func TestFlatCallTracerNative(t *testing.T) {
+	files, err := os.ReadDir(filepath.Join("testdata", "call_tracer_flat"))
+	if err != nil {
+		t.Fatalf("failed to retrieve tracer test suite: %v", err)
+	}
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		file := file // capture range variable
+		t.Run(camel(strings.TrimSuffix(file.Name(), ".json")), func(t *testing.T) {
+			t.Parallel()
+
+			test := new(flatCallTracerTest)
+			if blob, err := os.ReadFile(filepath.Join("testdata", "call_tracer_flat", file.Name())); err != nil {
+				t.Fatalf("failed to read testcase: %v", err)
+			} else if err := json.Unmarshal(blob, test); err != nil {
+				t.Fatalf("failed to parse testcase: %v", err)
+			}
+			tx, err := types.UnmarshalTransactionFromBinary(common.FromHex(test.Input))
+			require.NoError(t, err)
+			var (
+				signer    = types.MakeSigner(test.Genesis.Config, uint64(test.Context.Number), uint64(test.Context.Time))
+				origin, _ = signer.Sender(tx)
+				txContext = evmtypes.TxContext{
+					Origin:   origin,
+					GasPrice: tx.GetPrice(),
+				}
+				context = evmtypes.BlockContext{
+					CanTransfer: core.CanTransfer,
+					Transfer:    core.Transfer,
+					Coinbase:    test.Context.Miner,
+					BlockNumber: uint64(test.Context.Number),
+					Time:        uint64(test.Context.Time),
+					Difficulty:  (*big.Int)(test.Context.Difficulty),
+					GasLimit:    uint64(test.Context.GasLimit),
+				}
+				rules = test.Genesis.Config.Rules(context.BlockNumber, context.Time)
+			)
+			m := mock.Mock(t)
+			dbTx, err := m.DB.BeginRw(m.Ctx)
+			require.NoError(t, err)
+			defer dbTx.Rollback()
+			statedb, _ := tests.MakePreState(rules, dbTx, test.Genesis.Alloc, uint64(test.Context.Number))
+			if test.Genesis.BaseFee != nil {
+				context.BaseFee, _ = uint256.FromBig(test.Genesis.BaseFee)
+			}
+			tracer, err := tracers.New("flatCallTracer", &tracers.Context{TxHash: tx.Hash()}, test.TracerConfig)
+			require.NoError(t, err)
+			evm := vm.NewEVM(context, txContext, statedb, test.Genesis.Config, vm.Config{Debug: true, Tracer: tracer})
+			msg, err := tx.AsMessage(*signer, test.Genesis.BaseFee, rules)
+			require.NoError(t, err)
+			if _, err = core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(tx.GetGas()).AddBlobGas(tx.GetBlobGas()), true /* refunds */, false /* gasBailout */); err != nil {
+				t.Fatalf("failed to execute transaction: %v", err)
+			}
+			res, err := tracer.GetResult()
+			require.NoError(t, err)
+			want := new(bytes.Buffer)
+			require.NoError(t, json.Compact(want, test.Result))
+			if want.String() != string(res) {
+				t.Fatalf("trace mismatch\n have: %v\n want: %v\n", string(res), want.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package tracetest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/tests"
+	"github.com/ledgerwatch/erigon/turbo/stages/mock"
+)
+
+type gasProfileResult struct {
+	GasUsed uint64 `json:"gasUsed"`
+	Opcodes map[string]struct {
+		Count uint64 `json:"count"`
+		Gas   uint64 `json:"gas"`
+	} `json:"opcodes"`
+	Contracts map[libcommon.Address]struct {
+		Calls     uint64 `json:"calls"`
+		Gas       uint64 `json:"gas"`
+		SelfGas   uint64 `json:"selfGas"`
+		Functions map[string]struct {
+			Calls uint64 `json:"calls"`
+			Gas   uint64 `json:"gas"`
+		} `json:"functions"`
+	} `json:"contracts"`
+	Pprof []byte `json:"pprof"`
+}
+
+// TestGasProfileTracer runs a tx calling a function of another contract twice, and checks
+// that the gas of the opcodes adds up to the gas of the contracts.
+func TestGasProfileTracer(t *testing.T) {
+	var (
+		contract = libcommon.HexToAddress("0x00000000000000000000000000000000000000a0")
+		callee   = libcommon.HexToAddress("0x00000000000000000000000000000000000000bb")
+	)
+	privkey, err := crypto.HexToECDSA("0000000000000000deadbeef00000000000000000000000000000000deadbeef")
+	require.NoError(t, err)
+	signer := types.LatestSigner(params.MainnetChainConfig)
+	tx, err := types.SignNewTx(privkey, *signer, &types.LegacyTx{
+		GasPrice: uint256.NewInt(0),
+		CommonTx: types.CommonTx{
+			Gas:  500000,
+			To:   &contract,
+			Data: []byte{0xaa, 0xbb, 0xcc, 0xdd},
+		},
+	})
+	require.NoError(t, err)
+	origin, _ := signer.Sender(tx)
+
+	// increments slot 0
+	calleeCode := push(nil, 0)
+	calleeCode = append(calleeCode, byte(vm.SLOAD))
+	calleeCode = push(calleeCode, 1)
+	calleeCode = append(calleeCode, byte(vm.ADD))
+	calleeCode = push(calleeCode, 0)
+	calleeCode = append(calleeCode, byte(vm.SSTORE), byte(vm.STOP))
+	// calls 0x12345678 of the callee twice
+	code := push(nil, 0x12, 0x34, 0x56, 0x78)
+	code = push(code, 0xe0)
+	code = append(code, byte(vm.SHL))
+	code = push(code, 0)
+	code = append(code, byte(vm.MSTORE))
+	for i := 0; i < 2; i++ {
+		code = push(code, 0)
+		code = push(code, 0)
+		code = push(code, 4)
+		code = push(code, 0)
+		code = push(code, 0)
+		code = push(code, callee[:]...)
+		code = append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+	}
+	code = append(code, byte(vm.STOP))
+
+	alloc := types.GenesisAlloc{
+		contract: types.GenesisAccount{Code: code},
+		callee:   types.GenesisAccount{Code: calleeCode},
+		origin:   types.GenesisAccount{Balance: big.NewInt(500000000000000)},
+	}
+	context := evmtypes.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: 8000000,
+		Time:        5,
+		Difficulty:  big.NewInt(0x30000),
+		GasLimit:    uint64(6000000),
+	}
+	rules := params.MainnetChainConfig.Rules(context.BlockNumber, context.Time)
+	m := mock.Mock(t)
+	dbTx, err := m.DB.BeginRw(m.Ctx)
+	require.NoError(t, err)
+	defer dbTx.Rollback()
+	statedb, _ := tests.MakePreState(rules, dbTx, alloc, context.BlockNumber)
+
+	tracer, err := tracers.New("gasProfileTracer", nil, json.RawMessage(`{"pprof":true}`))
+	require.NoError(t, err)
+	evm := vm.NewEVM(context, evmtypes.TxContext{Origin: origin, GasPrice: uint256.NewInt(0)}, statedb, params.MainnetChainConfig, vm.Config{Debug: true, Tracer: tracer})
+	msg, err := tx.AsMessage(*signer, nil, rules)
+	require.NoError(t, err)
+	res, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(tx.GetGas()), true /* refunds */, false /* gasBailout */)
+	require.NoError(t, err)
+	require.NoError(t, res.Err)
+
+	raw, err := tracer.GetResult()
+	require.NoError(t, err)
+	var profile gasProfileResult
+	require.NoError(t, json.Unmarshal(raw, &profile))
+	require.Equal(t, res.UsedGas, profile.GasUsed)
+
+	var opcodesGas uint64
+	for _, op := range profile.Opcodes {
+		opcodesGas += op.Gas
+	}
+	top, sub := profile.Contracts[contract], profile.Contracts[callee]
+	require.Equal(t, uint64(1), top.Calls)
+	require.Equal(t, top.SelfGas+sub.Gas, top.Gas)
+	require.Equal(t, top.SelfGas+sub.SelfGas, opcodesGas)
+	require.Less(t, top.Gas, profile.GasUsed)
+	require.Equal(t, uint64(2), profile.Opcodes["CALL"].Count)
+	require.Equal(t, uint64(2), profile.Opcodes["SSTORE"].Count)
+	// the slot is set, then updated
+	require.Equal(t, uint64(20000+5000), profile.Opcodes["SSTORE"].Gas)
+
+	require.Equal(t, uint64(2), sub.Calls)
+	require.Equal(t, sub.SelfGas, sub.Gas)
+	require.Len(t, sub.Functions, 1)
+	require.Equal(t, uint64(2), sub.Functions["0x12345678"].Calls)
+	require.Equal(t, sub.Gas, sub.Functions["0x12345678"].Gas)
+	require.Equal(t, top.Gas, top.Functions["0xaabbccdd"].Gas)
+
+	zr, err := gzip.NewReader(bytes.NewReader(profile.Pprof))
+	require.NoError(t, err)
+	pprof, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	require.True(t, bytes.Contains(pprof, []byte(callee.Hex()+" 0x12345678")))
+	require.True(t, bytes.Contains(pprof, []byte("SSTORE")))
+}
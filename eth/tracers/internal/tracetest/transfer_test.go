@@ -0,0 +1,148 @@
+package tracetest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/tests"
+	"github.com/ledgerwatch/erigon/turbo/stages/mock"
+)
+
+func push(code []byte, b ...byte) []byte {
+	return append(append(code, byte(vm.PUSH1)+byte(len(b)-1)), b...)
+}
+
+// logTransfer appends the code emitting a Transfer event of the caller to "to"
+// with the given token id (ERC-721) or value (ERC-20).
+func logTransfer(code []byte, to libcommon.Address, value byte, erc721 bool) []byte {
+	topic := crypto.Keccak256([]byte("Transfer(address,address,uint256)"))
+	if erc721 {
+		code = push(code, value)
+	}
+	code = push(code, to[:]...)
+	code = append(code, byte(vm.CALLER))
+	code = push(code, topic...)
+	if erc721 {
+		code = push(code, 0)
+		code = push(code, 0)
+		return append(code, byte(vm.LOG4))
+	}
+	code = push(code, value)
+	code = push(code, 0)
+	code = append(code, byte(vm.MSTORE))
+	code = push(code, 32)
+	code = push(code, 0)
+	return append(code, byte(vm.LOG3))
+}
+
+func call(code []byte, to libcommon.Address, value byte) []byte {
+	code = push(code, 0)
+	code = push(code, 0)
+	code = push(code, 0)
+	code = push(code, 0)
+	code = push(code, value)
+	code = push(code, to[:]...)
+	return append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+}
+
+// TestTransferTracer runs a tx transferring ether and tokens, through a call which reverts as well.
+func TestTransferTracer(t *testing.T) {
+	var (
+		contract = libcommon.HexToAddress("0x00000000000000000000000000000000000000a0")
+		token    = libcommon.HexToAddress("0x00000000000000000000000000000000000000aa")
+		reverter = libcommon.HexToAddress("0x00000000000000000000000000000000000000cc")
+		receiver = libcommon.HexToAddress("0x00000000000000000000000000000000000000bb")
+		eoa      = libcommon.HexToAddress("0x00000000000000000000000000000000000000dd")
+	)
+	privkey, err := crypto.HexToECDSA("0000000000000000deadbeef00000000000000000000000000000000deadbeef")
+	require.NoError(t, err)
+	signer := types.LatestSigner(params.MainnetChainConfig)
+	tx, err := types.SignNewTx(privkey, *signer, &types.LegacyTx{
+		GasPrice: uint256.NewInt(0),
+		CommonTx: types.CommonTx{
+			Gas:   500000,
+			To:    &contract,
+			Value: uint256.NewInt(10),
+		},
+	})
+	require.NoError(t, err)
+	origin, _ := signer.Sender(tx)
+
+	// ERC-20, ERC-721 and ERC-1155 transfers of the caller to the receiver
+	tokenCode := logTransfer(nil, receiver, 100, false)
+	tokenCode = logTransfer(tokenCode, receiver, 7, true)
+	tokenCode = push(tokenCode, 9)
+	tokenCode = push(tokenCode, 0)
+	tokenCode = append(tokenCode, byte(vm.MSTORE))
+	tokenCode = push(tokenCode, 2)
+	tokenCode = push(tokenCode, 32)
+	tokenCode = append(tokenCode, byte(vm.MSTORE))
+	tokenCode = push(tokenCode, receiver[:]...)
+	tokenCode = append(tokenCode, byte(vm.CALLER), byte(vm.CALLER))
+	tokenCode = push(tokenCode, crypto.Keccak256([]byte("TransferSingle(address,address,address,uint256,uint256)"))...)
+	tokenCode = push(tokenCode, 64)
+	tokenCode = push(tokenCode, 0)
+	tokenCode = append(tokenCode, byte(vm.LOG4), byte(vm.STOP))
+	// a transfer which is reverted
+	reverterCode := logTransfer(nil, receiver, 1, false)
+	reverterCode = push(reverterCode, 0)
+	reverterCode = push(reverterCode, 0)
+	reverterCode = append(reverterCode, byte(vm.REVERT))
+
+	code := call(nil, token, 0)
+	code = call(code, reverter, 5)
+	code = call(code, eoa, 3)
+	code = append(code, byte(vm.STOP))
+
+	alloc := types.GenesisAlloc{
+		contract: types.GenesisAccount{Code: code, Balance: big.NewInt(100)},
+		token:    types.GenesisAccount{Code: tokenCode},
+		reverter: types.GenesisAccount{Code: reverterCode},
+		origin:   types.GenesisAccount{Balance: big.NewInt(500000000000000)},
+	}
+	context := evmtypes.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: 8000000,
+		Time:        5,
+		Difficulty:  big.NewInt(0x30000),
+		GasLimit:    uint64(6000000),
+	}
+	rules := params.MainnetChainConfig.Rules(context.BlockNumber, context.Time)
+	m := mock.Mock(t)
+	dbTx, err := m.DB.BeginRw(m.Ctx)
+	require.NoError(t, err)
+	defer dbTx.Rollback()
+	statedb, _ := tests.MakePreState(rules, dbTx, alloc, context.BlockNumber)
+
+	tracer, err := tracers.New("transferTracer", nil, nil)
+	require.NoError(t, err)
+	evm := vm.NewEVM(context, evmtypes.TxContext{Origin: origin, GasPrice: uint256.NewInt(0)}, statedb, params.MainnetChainConfig, vm.Config{Debug: true, Tracer: tracer})
+	msg, err := tx.AsMessage(*signer, nil, rules)
+	require.NoError(t, err)
+	_, err = core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(tx.GetGas()), true /* refunds */, false /* gasBailout */)
+	require.NoError(t, err)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	want := `[` +
+		`{"type":"eth","from":"0x682a80a6f560eec50d54e63cbeda1c324c5f8d1b","to":"0x00000000000000000000000000000000000000a0","value":"0xa"},` +
+		`{"type":"erc20","token":"0x00000000000000000000000000000000000000aa","from":"0x00000000000000000000000000000000000000a0","to":"0x00000000000000000000000000000000000000bb","value":"0x64"},` +
+		`{"type":"erc721","token":"0x00000000000000000000000000000000000000aa","from":"0x00000000000000000000000000000000000000a0","to":"0x00000000000000000000000000000000000000bb","tokenId":"0x7"},` +
+		`{"type":"erc1155","token":"0x00000000000000000000000000000000000000aa","operator":"0x00000000000000000000000000000000000000a0","from":"0x00000000000000000000000000000000000000a0","to":"0x00000000000000000000000000000000000000bb","tokenId":"0x9","value":"0x2"},` +
+		`{"type":"eth","from":"0x00000000000000000000000000000000000000a0","to":"0x00000000000000000000000000000000000000dd","value":"0x3"}` +
+		`]`
+	require.Equal(t, want, string(res))
+}
@@ -0,0 +1,306 @@
+package js
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dop251/goja"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	erigonmetrics "github.com/ledgerwatch/erigon-lib/metrics"
+
+	"github.com/ledgerwatch/erigon/crypto"
+)
+
+// programCacheSize is the number of compiled tracers kept, the built-in ones included.
+const programCacheSize = 256
+
+// budgetCheckInterval is the least time between two checks of a running JS call.
+const budgetCheckInterval = 10 * time.Millisecond
+
+var (
+	programCache, _ = lru.New[libcommon.Hash, *goja.Program](programCacheSize)
+
+	mxProgramCacheHit  = erigonmetrics.GetOrCreateCounter(`js_tracer_programs{cache="hit"}`)
+	mxProgramCacheMiss = erigonmetrics.GetOrCreateCounter(`js_tracer_programs{cache="miss"}`)
+	mxRuntime          = erigonmetrics.GetOrCreateSummary("js_tracer_runtime")
+	mxTimeExceeded     = erigonmetrics.GetOrCreateCounter(`js_tracer_budget_exceeded{budget="time"}`)
+	mxStepsExceeded    = erigonmetrics.GetOrCreateCounter(`js_tracer_budget_exceeded{budget="steps"}`)
+	mxHeapExceeded     = erigonmetrics.GetOrCreateCounter(`js_tracer_budget_exceeded{budget="heap"}`)
+
+	// heapSizeProgram estimates the bytes held by the JS values reachable from its root through data
+	// properties, stopping once they exceed limit. Accessors aren't called, so that measuring runs no tracer
+	// code, and functions count as any other primitive value.
+	heapSizeProgram = goja.MustCompile("", `(function(root, limit) {
+	var seen = new Set(), stack = [root], size = 0;
+	while (stack.length > 0 && size <= limit) {
+		var v = stack.pop();
+		if (typeof v === "string") {
+			size += 16 + v.length;
+		} else if (typeof v !== "object" || v === null) {
+			size += 16;
+		} else if (!seen.has(v)) {
+			seen.add(v);
+			size += 64;
+			if (v instanceof ArrayBuffer || ArrayBuffer.isView(v)) {
+				size += v.byteLength;
+			} else if (v instanceof Map || v instanceof Set) {
+				v.forEach(function(value, key) { stack.push(key, value); });
+			}
+			var keys = Object.getOwnPropertyNames(v);
+			for (var i = 0; i < keys.length && size <= limit; i++) {
+				var desc = Object.getOwnPropertyDescriptor(v, keys[i]);
+				size += 16 + keys[i].length;
+				if ("value" in desc) {
+					stack.push(desc.value);
+				}
+			}
+		}
+	}
+	return size;
+})`, false)
+)
+
+// compile returns the program of the tracer code, compiled once per distinct source.
+func compile(code string) (*goja.Program, error) {
+	hash := crypto.Keccak256Hash([]byte(code))
+	if program, ok := programCache.Get(hash); ok {
+		mxProgramCacheHit.Inc()
+		return program, nil
+	}
+	mxProgramCacheMiss.Inc()
+	program, err := goja.Compile("", "("+code+")", false)
+	if err != nil {
+		return nil, err
+	}
+	programCache.Add(hash, program)
+	return program, nil
+}
+
+// Limits are the resources every JS tracer instance, i.e. the tracing of a transaction, may use.
+// goja doesn't count the instructions it executes, so the instructions are bounded by the number of
+// calls into the tracer, one per step of the EVM at most, and by the time spent running its JS code.
+// goja doesn't account for the memory of a runtime either, so the heap is estimated from the values
+// reachable from the tracer object and the globals, sampled between two calls once the watchdog found
+// it due. The values held only by closures aren't seen. Zero means no limit.
+type Limits struct {
+	MaxExecutionTime time.Duration
+	MaxSteps         uint64
+	MaxHeapSize      uint64 // bytes
+}
+
+var limits atomic.Pointer[Limits]
+
+// SetLimits sets the limits of the JS tracers created from now on.
+func SetLimits(l Limits) {
+	limits.Store(&l)
+}
+
+func currentLimits() Limits {
+	if l := limits.Load(); l != nil {
+		return *l
+	}
+	return Limits{}
+}
+
+// budget meters the JS calls of a tracer and interrupts the ones exceeding its Limits. The calls only
+// update atomics, the watchdog is armed once and checks the running call when the tracer may have run
+// out of time, or every budgetCheckInterval to have the heap sampled. It disarms when no call is running
+// and the next call arms it again.
+type budget struct {
+	limits   Limits
+	vm       *goja.Runtime
+	elapsed  atomic.Int64 // nanoseconds spent in the calls which returned
+	start    atomic.Int64 // unix nanoseconds at which the running call started, 0 when none is running
+	armed    atomic.Bool
+	exceeded atomic.Pointer[error]
+	heapDue  atomic.Bool // set by the watchdog to have the heap sampled after the running or next call
+
+	steps    uint64        // calls made, only used by the calls
+	root     *goja.Object  // the tracer object, the heap isn't sampled before it is set
+	heapSize goja.Callable // compiled heapSizeProgram, in the runtime of the tracer
+	heapBase uint64        // estimated heap of the globals, before the tracer object was created
+
+	mu       sync.Mutex // guards the watchdog against the checks
+	watchdog *time.Timer
+	stopped  bool
+}
+
+func newBudget(vm *goja.Runtime, limits Limits) *budget {
+	return &budget{limits: limits, vm: vm}
+}
+
+// run executes a JS call, failing with a clear error once the budget is exhausted.
+func (b *budget) run(call func() (goja.Value, error)) (goja.Value, error) {
+	if err := b.exceeded.Load(); err != nil {
+		return nil, *err
+	}
+	b.steps++
+	if b.limits.MaxSteps > 0 && b.steps > b.limits.MaxSteps {
+		b.exceed(fmt.Errorf("tracer exceeded its budget of %d steps", b.limits.MaxSteps), mxStepsExceeded)
+		return nil, *b.exceeded.Load()
+	}
+	start := time.Now()
+	// start is published before armed is read, and check clears armed before reading start, so either
+	// the check sees the call running or the call sees the watchdog disarmed
+	b.start.Store(start.UnixNano())
+	if (b.limits.MaxExecutionTime > 0 || b.limits.MaxHeapSize > 0) && !b.armed.Load() {
+		b.arm()
+	}
+
+	res, err := call()
+	// sampling the heap runs JS, it is part of the call for the time budget
+	if err == nil && b.root != nil && b.heapDue.CompareAndSwap(true, false) {
+		err = b.sampleHeap()
+	}
+
+	b.start.Store(0)
+	elapsed := time.Duration(b.elapsed.Add(int64(time.Since(start))))
+	if b.limits.MaxExecutionTime > 0 && elapsed >= b.limits.MaxExecutionTime {
+		b.exceed(b.timeErr(), mxTimeExceeded)
+	}
+	if exceeded := b.exceeded.Load(); err == nil && exceeded != nil {
+		err = *exceeded
+	}
+	return res, err
+}
+
+// setRoot starts sampling the heap of the tracer object root, the heap of the globals at this point is the base
+// which isn't counted against the budget.
+func (b *budget) setRoot(root *goja.Object) error {
+	if b.limits.MaxHeapSize == 0 {
+		return nil
+	}
+	heapSize, err := b.vm.RunProgram(heapSizeProgram)
+	if err != nil {
+		return err
+	}
+	b.heapSize, _ = goja.AssertFunction(heapSize)
+	if b.heapBase, err = b.measureHeap(b.vm.GlobalObject(), math.MaxInt64); err != nil {
+		return err
+	}
+	b.root = root
+	return nil
+}
+
+// sampleHeap fails the tracer once its object and the globals hold more than MaxHeapSize.
+func (b *budget) sampleHeap() error {
+	size, err := b.measureHeap(b.root, b.limits.MaxHeapSize)
+	if err != nil {
+		return err
+	}
+	if size <= b.limits.MaxHeapSize {
+		globals, err := b.measureHeap(b.vm.GlobalObject(), b.heapBase+b.limits.MaxHeapSize-size)
+		if err != nil {
+			return err
+		}
+		if globals > b.heapBase {
+			size += globals - b.heapBase
+		}
+	}
+	if size > b.limits.MaxHeapSize {
+		b.exceed(fmt.Errorf("tracer exceeded its heap budget of %d bytes", b.limits.MaxHeapSize), mxHeapExceeded)
+		return *b.exceeded.Load()
+	}
+	return nil
+}
+
+// measureHeap estimates the bytes held by the values reachable from root, stopping once they exceed limit.
+func (b *budget) measureHeap(root *goja.Object, limit uint64) (uint64, error) {
+	size, err := b.heapSize(goja.Undefined(), root, b.vm.ToValue(limit))
+	if err != nil {
+		return 0, err
+	}
+	return uint64(size.ToInteger()), nil
+}
+
+// sampleHeapNext has the heap sampled after the next call, whether or not the watchdog found it due.
+func (b *budget) sampleHeapNext() {
+	b.heapDue.Store(true)
+}
+
+func (b *budget) arm() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.stopped {
+		return
+	}
+	b.armed.Store(true)
+	if b.watchdog == nil {
+		b.watchdog = time.AfterFunc(b.left(b.start.Load()), b.check)
+	} else {
+		b.watchdog.Reset(b.left(b.start.Load()))
+	}
+}
+
+// left returns when the call started at start has to be checked again, not sooner than budgetCheckInterval,
+// and not later if the heap has to be sampled.
+func (b *budget) left(start int64) time.Duration {
+	if b.limits.MaxExecutionTime == 0 {
+		return budgetCheckInterval
+	}
+	left := b.limits.MaxExecutionTime - b.used(start)
+	if left < budgetCheckInterval || b.limits.MaxHeapSize > 0 {
+		return budgetCheckInterval
+	}
+	return left
+}
+
+// used returns the time spent running JS, including the call started at start, if any.
+func (b *budget) used(start int64) time.Duration {
+	used := time.Duration(b.elapsed.Load())
+	if start != 0 {
+		used += time.Since(time.Unix(0, start))
+	}
+	return used
+}
+
+// check interrupts the running call if the tracer ran out of time, and has the heap sampled.
+func (b *budget) check() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.stopped {
+		return
+	}
+	b.armed.Store(false)
+	if b.limits.MaxHeapSize > 0 {
+		b.heapDue.Store(true)
+	}
+	start := b.start.Load()
+	if start == 0 {
+		return
+	}
+	if b.limits.MaxExecutionTime > 0 && b.used(start) >= b.limits.MaxExecutionTime {
+		b.exceed(b.timeErr(), mxTimeExceeded)
+		b.vm.Interrupt(*b.exceeded.Load())
+		return
+	}
+	b.armed.Store(true)
+	b.watchdog.Reset(b.left(start))
+}
+
+// exceed records the first budget the tracer exceeded.
+func (b *budget) exceed(err error, mx erigonmetrics.Counter) {
+	if b.exceeded.CompareAndSwap(nil, &err) {
+		mx.Inc()
+	}
+}
+
+func (b *budget) timeErr() error {
+	return fmt.Errorf("tracer exceeded its execution time budget of %v", b.limits.MaxExecutionTime)
+}
+
+// report records the time the tracer spent running JS and stops its watchdog.
+func (b *budget) report() {
+	mxRuntime.Observe(time.Duration(b.elapsed.Load()).Seconds())
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stopped = true
+	if b.watchdog != nil {
+		b.watchdog.Stop()
+	}
+}
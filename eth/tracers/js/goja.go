@@ -106,6 +106,7 @@ type jsTracer struct {
 	gasLimit          uint64                // Amount of gas bought for the whole tx
 	err               error                 // Any error that should stop tracing
 	obj               *goja.Object          // Trace object
+	budget            *budget               // Meters the JS calls against the Limits
 
 	// Methods exposed by tracer
 	result goja.Callable
@@ -156,7 +157,12 @@ func newJsTracer(code string, ctx *tracers.Context, cfg json.RawMessage) (tracer
 
 	t.setTypeConverters()
 	t.setBuiltinFunctions()
-	ret, err := vm.RunString("(" + code + ")")
+	program, err := compile(code)
+	if err != nil {
+		return nil, err
+	}
+	t.budget = newBudget(vm, currentLimits())
+	ret, err := t.budget.run(func() (goja.Value, error) { return vm.RunProgram(program) })
 	if err != nil {
 		return nil, err
 	}
@@ -179,6 +185,9 @@ func newJsTracer(code string, ctx *tracers.Context, cfg json.RawMessage) (tracer
 	}
 	t.traceFrame = hasEnter
 	t.obj = obj
+	if err := t.budget.setRoot(obj); err != nil {
+		return nil, err
+	}
 	t.step = step
 	t.enter = enter
 	t.exit = exit
@@ -191,7 +200,7 @@ func newJsTracer(code string, ctx *tracers.Context, cfg json.RawMessage) (tracer
 		if cfg != nil {
 			cfgStr = string(cfg)
 		}
-		if _, err := setup(obj, vm.ToValue(cfgStr)); err != nil {
+		if _, err := t.call(setup, vm.ToValue(cfgStr)); err != nil {
 			return nil, err
 		}
 	}
@@ -270,7 +279,7 @@ func (t *jsTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope
 	log.refund = t.env.IntraBlockState().GetRefund()
 	log.depth = depth
 	log.err = err
-	if _, err := t.step(t.obj, t.logValue, t.dbValue); err != nil {
+	if _, err := t.call(t.step, t.logValue, t.dbValue); err != nil {
 		t.onError("step", err)
 	}
 }
@@ -282,7 +291,7 @@ func (t *jsTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope
 	}
 	// Other log fields have been already set as part of the last CaptureState.
 	t.log.err = err
-	if _, err := t.fault(t.obj, t.logValue, t.dbValue); err != nil {
+	if _, err := t.call(t.fault, t.logValue, t.dbValue); err != nil {
 		t.onError("fault", err)
 	}
 }
@@ -314,7 +323,7 @@ func (t *jsTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcom
 		t.frame.value = value.ToBig()
 	}
 
-	if _, err := t.enter(t.obj, t.frameValue); err != nil {
+	if _, err := t.call(t.enter, t.frameValue); err != nil {
 		t.onError("enter", err)
 	}
 }
@@ -330,7 +339,7 @@ func (t *jsTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
 	t.frameResult.output = libcommon.CopyBytes(output)
 	t.frameResult.err = err
 
-	if _, err := t.exit(t.obj, t.frameResultValue); err != nil {
+	if _, err := t.call(t.exit, t.frameResultValue); err != nil {
 		t.onError("exit", err)
 	}
 }
@@ -338,7 +347,9 @@ func (t *jsTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
 // GetResult calls the Javascript 'result' function and returns its value, or any accumulated error
 func (t *jsTracer) GetResult() (json.RawMessage, error) {
 	ctx := t.vm.ToValue(t.ctx)
-	res, err := t.result(t.obj, ctx, t.dbValue)
+	t.budget.sampleHeapNext()
+	res, err := t.call(t.result, ctx, t.dbValue)
+	t.budget.report()
 	if err != nil {
 		return nil, wrapError("result", err)
 	}
@@ -349,6 +360,11 @@ func (t *jsTracer) GetResult() (json.RawMessage, error) {
 	return json.RawMessage(encoded), t.err
 }
 
+// call invokes a method of the tracer object, within the budget of the tracer.
+func (t *jsTracer) call(method goja.Callable, args ...goja.Value) (goja.Value, error) {
+	return t.budget.run(func() (goja.Value, error) { return method(t.obj, args...) })
+}
+
 // Stop terminates execution of the tracer at the first opportune moment.
 func (t *jsTracer) Stop(err error) {
 	t.vm.Interrupt(err)
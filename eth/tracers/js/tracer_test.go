@@ -31,6 +31,7 @@ import (
 	"github.com/ledgerwatch/erigon/core/state"
 	"github.com/ledgerwatch/erigon/core/vm"
 	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
+	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/eth/tracers"
 	"github.com/ledgerwatch/erigon/params"
 )
@@ -199,6 +200,94 @@ func TestHaltBetweenSteps(t *testing.T) {
 	}
 }
 
+func TestTimeBudget(t *testing.T) {
+	tracer, err := newJsTracer("{step: function() { while(1); }, result: function() { return null; }, fault: function(){}}", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jst := tracer.(*jsTracer)
+	jst.budget = newBudget(jst.vm, Limits{MaxExecutionTime: 100 * time.Millisecond})
+	if _, err = runTrace(tracer, testCtx(), params.TestChainConfig, nil); err == nil || !strings.Contains(err.Error(), "execution time budget of 100ms") {
+		t.Errorf("Expected time budget error, got %v", err)
+	}
+}
+
+func TestStepBudget(t *testing.T) {
+	SetLimits(Limits{MaxSteps: 10})
+	defer SetLimits(Limits{})
+	tracer, err := newJsTracer("{step: function() {}, result: function() { return null; }, fault: function(){}}", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loop := []byte{byte(vm.JUMPDEST), byte(vm.PUSH1), 0, byte(vm.JUMP)}
+	if _, err = runTrace(tracer, testCtx(), params.TestChainConfig, loop); err == nil || !strings.Contains(err.Error(), "budget of 10 steps") {
+		t.Errorf("Expected step budget error, got %v", err)
+	}
+}
+
+func TestHeapBudget(t *testing.T) {
+	SetLimits(Limits{MaxHeapSize: 1 << 20})
+	defer SetLimits(Limits{})
+	// runs out of gas after 2500 steps, the heap has to be sampled while it runs
+	loop := []byte{byte(vm.JUMPDEST), byte(vm.PUSH1), 0, byte(vm.JUMP)}
+	// stops after 3000 steps, the heap is sampled at the latest when the result is built
+	var pushPop []byte
+	for i := 0; i < 1500; i++ {
+		pushPop = append(pushPop, byte(vm.PUSH1), 0, byte(vm.POP))
+	}
+	for i, tt := range []struct {
+		code     string
+		contract []byte
+		fail     bool
+	}{
+		{ // the state of the tracer object grows by 10KB per step, which takes long enough for the watchdog
+			code:     "{data: [], step: function() { for (var i = 0; i < 1000; i++); this.data.push('x'.repeat(10000)); }, result: function() { return this.data.length; }, fault: function(){}}",
+			contract: loop,
+			fail:     true,
+		},
+		{ // so does a global
+			code:     "{step: function() { if (typeof leak === 'undefined') { leak = []; } leak.push('x'.repeat(1000)); }, result: function() { return null; }, fault: function(){}}",
+			contract: pushPop,
+			fail:     true,
+		},
+		{ // a few steps are within the budget
+			code: "{data: [], step: function() { this.data.push('x'.repeat(1000)); }, result: function() { return this.data.length; }, fault: function(){}}",
+		},
+	} {
+		tracer, err := newJsTracer(tt.code, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = runTrace(tracer, testCtx(), params.TestChainConfig, tt.contract)
+		if tt.fail && (err == nil || !strings.Contains(err.Error(), "heap budget of 1048576 bytes")) {
+			t.Errorf("testcase %d: expected heap budget error, got %v", i, err)
+		}
+		if !tt.fail && err != nil {
+			t.Errorf("testcase %d: unexpected error %v", i, err)
+		}
+	}
+}
+
+func TestProgramCache(t *testing.T) {
+	code := "{count: 0, step: function() { this.count++ }, fault: function() {}, result: function() { return this.count }}"
+	for i := 0; i < 2; i++ {
+		tracer, err := newJsTracer(code, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret, err := runTrace(tracer, testCtx(), params.TestChainConfig, []byte{byte(vm.PUSH1), 1, byte(vm.POP)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(ret) != "3" {
+			t.Errorf("trace %d: expected 3 steps, got %s", i, ret)
+		}
+	}
+	if !programCache.Contains(crypto.Keccak256Hash([]byte(code))) {
+		t.Errorf("Expected the program of the tracer to be cached")
+	}
+}
+
 // testNoStepExec tests a regular value transfer (no exec), and accessing the statedb
 // in 'result'
 func TestNoStepExec(t *testing.T) {
@@ -0,0 +1,193 @@
+// Package live runs a tracer on the blocks as the execution stage executes them,
+// so that indexers can follow the chain without re-executing it.
+package live
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/log/v3"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+	_ "github.com/ledgerwatch/erigon/eth/tracers/js"
+	_ "github.com/ledgerwatch/erigon/eth/tracers/native"
+)
+
+// Tracer follows the chain as it is executed. It is passed as the vm.Config tracer
+// of the executed transactions, and told which block and transaction the EVM
+// callbacks belong to.
+type Tracer interface {
+	vm.EVMLogger
+	tracing.StateHooks
+	// OnBlockStart is called before the block is executed.
+	OnBlockStart(block *types.Block)
+	// OnTxStart is called before the transaction at txIndex of the current block is executed.
+	OnTxStart(txIndex int, tx types.Transaction)
+	// OnBlockEnd is called once the current block was executed, err is the reason it is invalid if not nil.
+	OnBlockEnd(err error)
+	// OnUnwind is called when the blocks above unwindPoint were unwound, e.g. by a reorg.
+	OnUnwind(unwindPoint uint64)
+	// Close releases the sink of the tracer.
+	Close() error
+}
+
+// blockTracer runs a transaction tracer, as served by debug_traceTransaction, on every
+// transaction of the executed blocks, and writes the results of each block to a Sink.
+type blockTracer struct {
+	name   string
+	config json.RawMessage
+	sink   Sink
+	logger log.Logger
+
+	trace *BlockTrace
+	tx    tracers.Tracer // tracer of the transaction being executed, nil outside of transactions
+}
+
+// New returns a live Tracer running the tracer registered as name, e.g. "callTracer",
+// with the given configuration and writing the traces to sink.
+func New(name string, config json.RawMessage, sink Sink, logger log.Logger) (Tracer, error) {
+	// fail early rather than on the first transaction
+	if _, err := tracers.New(name, new(tracers.Context), config); err != nil {
+		return nil, fmt.Errorf("live tracer %s: %w", name, err)
+	}
+	return &blockTracer{name: name, config: config, sink: sink, logger: logger}, nil
+}
+
+func (t *blockTracer) OnBlockStart(block *types.Block) {
+	t.trace = &BlockTrace{
+		Number:     block.NumberU64(),
+		Hash:       block.Hash(),
+		ParentHash: block.ParentHash(),
+		Txs:        make([]TxTrace, 0, len(block.Transactions())),
+	}
+}
+
+func (t *blockTracer) OnTxStart(txIndex int, tx types.Transaction) {
+	if t.trace == nil {
+		return
+	}
+	t.tx = nil
+	txHash := tx.Hash()
+	tracer, err := tracers.New(t.name, &tracers.Context{BlockHash: t.trace.Hash, TxIndex: txIndex, TxHash: txHash}, t.config)
+	if err != nil {
+		t.trace.Txs = append(t.trace.Txs, TxTrace{TxHash: txHash, Error: err.Error()})
+		return
+	}
+	t.tx = tracer
+	t.trace.Txs = append(t.trace.Txs, TxTrace{TxHash: txHash})
+}
+
+func (t *blockTracer) OnBlockEnd(err error) {
+	if t.trace == nil {
+		return
+	}
+	if err != nil {
+		t.trace.Error = err.Error()
+	}
+	if err := t.sink.WriteBlock(t.trace); err != nil {
+		t.logger.Warn("[live tracer] failed to write block trace", "block", t.trace.Number, "err", err)
+	}
+	t.trace, t.tx = nil, nil
+}
+
+func (t *blockTracer) OnUnwind(unwindPoint uint64) {
+	if err := t.sink.WriteUnwind(unwindPoint); err != nil {
+		t.logger.Warn("[live tracer] failed to write unwind", "unwindPoint", unwindPoint, "err", err)
+	}
+}
+
+func (t *blockTracer) Close() error {
+	return t.sink.Close()
+}
+
+func (t *blockTracer) CaptureTxStart(gasLimit uint64) {
+	if t.tx != nil {
+		t.tx.CaptureTxStart(gasLimit)
+	}
+}
+
+func (t *blockTracer) CaptureTxEnd(restGas uint64) {
+	if t.tx == nil {
+		return
+	}
+	t.tx.CaptureTxEnd(restGas)
+	txTrace := &t.trace.Txs[len(t.trace.Txs)-1]
+	if res, err := t.tx.GetResult(); err != nil {
+		txTrace.Error = err.Error()
+	} else {
+		txTrace.Result = res
+	}
+	t.tx = nil
+}
+
+func (t *blockTracer) CaptureStart(env *vm.EVM, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	if t.tx != nil {
+		t.tx.CaptureStart(env, from, to, precompile, create, input, gas, value, code)
+	}
+}
+
+func (t *blockTracer) CaptureEnd(output []byte, usedGas uint64, err error) {
+	if t.tx != nil {
+		t.tx.CaptureEnd(output, usedGas, err)
+	}
+}
+
+func (t *blockTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	if t.tx != nil {
+		t.tx.CaptureEnter(typ, from, to, precompile, create, input, gas, value, code)
+	}
+}
+
+func (t *blockTracer) CaptureExit(output []byte, usedGas uint64, err error) {
+	if t.tx != nil {
+		t.tx.CaptureExit(output, usedGas, err)
+	}
+}
+
+func (t *blockTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if t.tx != nil {
+		t.tx.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+	}
+}
+
+func (t *blockTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if t.tx != nil {
+		t.tx.CaptureFault(pc, op, gas, cost, scope, depth, err)
+	}
+}
+
+func (t *blockTracer) OnBalanceChange(addr libcommon.Address, prev, new *uint256.Int, reason tracing.BalanceChangeReason) {
+	if hooks, ok := t.tx.(tracing.StateHooks); ok {
+		hooks.OnBalanceChange(addr, prev, new, reason)
+	}
+}
+
+func (t *blockTracer) OnNonceChange(addr libcommon.Address, prev, new uint64) {
+	if hooks, ok := t.tx.(tracing.StateHooks); ok {
+		hooks.OnNonceChange(addr, prev, new)
+	}
+}
+
+func (t *blockTracer) OnCodeChange(addr libcommon.Address, prevCodeHash libcommon.Hash, prevCode []byte, codeHash libcommon.Hash, code []byte) {
+	if hooks, ok := t.tx.(tracing.StateHooks); ok {
+		hooks.OnCodeChange(addr, prevCodeHash, prevCode, codeHash, code)
+	}
+}
+
+func (t *blockTracer) OnStorageChange(addr libcommon.Address, slot *libcommon.Hash, prev, new uint256.Int) {
+	if hooks, ok := t.tx.(tracing.StateHooks); ok {
+		hooks.OnStorageChange(addr, slot, prev, new)
+	}
+}
+
+func (t *blockTracer) OnLog(log *types.Log) {
+	if hooks, ok := t.tx.(tracing.StateHooks); ok {
+		hooks.OnLog(log)
+	}
+}
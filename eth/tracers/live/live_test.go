@@ -0,0 +1,86 @@
+package live
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/core/types"
+)
+
+func TestLiveTracer(t *testing.T) {
+	var out bytes.Buffer
+	tracer, err := New("callTracer", json.RawMessage(`{"withLog":true}`), NewJSONSink(&out), log.New())
+	require.NoError(t, err)
+
+	from, to := libcommon.HexToAddress("0x1"), libcommon.HexToAddress("0x2")
+	txs := []types.Transaction{
+		types.NewTransaction(0, to, uint256.NewInt(1), 21000, uint256.NewInt(1), nil),
+		types.NewTransaction(1, to, uint256.NewInt(2), 21000, uint256.NewInt(1), nil),
+	}
+	block := types.NewBlock(&types.Header{Number: big.NewInt(7)}, txs, nil, nil, nil)
+
+	tracer.OnBlockStart(block)
+	for i, tx := range txs {
+		tracer.OnTxStart(i, tx)
+		tracer.CaptureTxStart(21000)
+		tracer.CaptureStart(nil, from, to, false, false, nil, 0, tx.GetValue(), nil)
+		tracer.OnLog(&types.Log{Address: to, Topics: []libcommon.Hash{{1}}})
+		tracer.CaptureEnd(nil, 0, nil)
+		tracer.CaptureTxEnd(0)
+	}
+	// the hooks of the system calls after the last transaction are ignored
+	tracer.OnLog(&types.Log{Address: to})
+	tracer.OnBlockEnd(nil)
+
+	tracer.OnBlockStart(block)
+	tracer.OnBlockEnd(errors.New("bad block"))
+	tracer.OnUnwind(6)
+	require.NoError(t, tracer.Close())
+
+	var records []record
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		var r record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.Len(t, records, 3)
+
+	trace := records[0].Block
+	require.NotNil(t, trace)
+	require.Equal(t, uint64(7), trace.Number)
+	require.Equal(t, block.Hash(), trace.Hash)
+	require.Empty(t, trace.Error)
+	require.Len(t, trace.Txs, 2)
+	for i, txTrace := range trace.Txs {
+		require.Equal(t, txs[i].Hash(), txTrace.TxHash)
+		var frame struct {
+			From  libcommon.Address
+			To    libcommon.Address
+			Value string
+			Logs  []json.RawMessage
+		}
+		require.NoError(t, json.Unmarshal(txTrace.Result, &frame))
+		require.Equal(t, from, frame.From)
+		require.Equal(t, to, frame.To)
+		require.Equal(t, txs[i].GetValue().Hex(), frame.Value)
+		require.Len(t, frame.Logs, 1)
+	}
+
+	require.Equal(t, "bad block", records[1].Block.Error)
+	require.Empty(t, records[1].Block.Txs)
+	require.Equal(t, uint64(6), *records[2].UnwindTo)
+
+	_, err = New("noSuchTracer", nil, NewJSONSink(&out), log.New())
+	require.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package live
+
+import (
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+)
+
+// mux runs several loggers on the same transaction, typically the call tracer used
+// to index the call traces and a live Tracer.
+type mux struct {
+	loggers []vm.EVMLogger
+}
+
+// NewMux returns a vm.FlushableTracer forwarding the EVM callbacks, the state hooks
+// and Flush to the loggers supporting them.
+func NewMux(loggers ...vm.EVMLogger) vm.FlushableTracer {
+	return &mux{loggers: loggers}
+}
+
+func (m *mux) CaptureTxStart(gasLimit uint64) {
+	for _, l := range m.loggers {
+		l.CaptureTxStart(gasLimit)
+	}
+}
+
+func (m *mux) CaptureTxEnd(restGas uint64) {
+	for _, l := range m.loggers {
+		l.CaptureTxEnd(restGas)
+	}
+}
+
+func (m *mux) CaptureStart(env *vm.EVM, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	for _, l := range m.loggers {
+		l.CaptureStart(env, from, to, precompile, create, input, gas, value, code)
+	}
+}
+
+func (m *mux) CaptureEnd(output []byte, usedGas uint64, err error) {
+	for _, l := range m.loggers {
+		l.CaptureEnd(output, usedGas, err)
+	}
+}
+
+func (m *mux) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	for _, l := range m.loggers {
+		l.CaptureEnter(typ, from, to, precompile, create, input, gas, value, code)
+	}
+}
+
+func (m *mux) CaptureExit(output []byte, usedGas uint64, err error) {
+	for _, l := range m.loggers {
+		l.CaptureExit(output, usedGas, err)
+	}
+}
+
+func (m *mux) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	for _, l := range m.loggers {
+		l.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+	}
+}
+
+func (m *mux) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	for _, l := range m.loggers {
+		l.CaptureFault(pc, op, gas, cost, scope, depth, err)
+	}
+}
+
+func (m *mux) Flush(tx types.Transaction) {
+	for _, l := range m.loggers {
+		if f, ok := l.(vm.FlushableTracer); ok {
+			f.Flush(tx)
+		}
+	}
+}
+
+func (m *mux) OnBalanceChange(addr libcommon.Address, prev, new *uint256.Int, reason tracing.BalanceChangeReason) {
+	for _, l := range m.loggers {
+		if hooks, ok := l.(tracing.StateHooks); ok {
+			hooks.OnBalanceChange(addr, prev, new, reason)
+		}
+	}
+}
+
+func (m *mux) OnNonceChange(addr libcommon.Address, prev, new uint64) {
+	for _, l := range m.loggers {
+		if hooks, ok := l.(tracing.StateHooks); ok {
+			hooks.OnNonceChange(addr, prev, new)
+		}
+	}
+}
+
+func (m *mux) OnCodeChange(addr libcommon.Address, prevCodeHash libcommon.Hash, prevCode []byte, codeHash libcommon.Hash, code []byte) {
+	for _, l := range m.loggers {
+		if hooks, ok := l.(tracing.StateHooks); ok {
+			hooks.OnCodeChange(addr, prevCodeHash, prevCode, codeHash, code)
+		}
+	}
+}
+
+func (m *mux) OnStorageChange(addr libcommon.Address, slot *libcommon.Hash, prev, new uint256.Int) {
+	for _, l := range m.loggers {
+		if hooks, ok := l.(tracing.StateHooks); ok {
+			hooks.OnStorageChange(addr, slot, prev, new)
+		}
+	}
+}
+
+func (m *mux) OnLog(log *types.Log) {
+	for _, l := range m.loggers {
+		if hooks, ok := l.(tracing.StateHooks); ok {
+			hooks.OnLog(log)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package live
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+)
+
+// BlockTrace holds the traces of the transactions of an executed block.
+type BlockTrace struct {
+	Number     uint64         `json:"number"`
+	Hash       libcommon.Hash `json:"hash"`
+	ParentHash libcommon.Hash `json:"parentHash"`
+	Error      string         `json:"error,omitempty"` // set if the block turned out to be invalid
+	Txs        []TxTrace      `json:"txs"`
+}
+
+// TxTrace is the result of the tracer for a single transaction.
+type TxTrace struct {
+	TxHash libcommon.Hash  `json:"txHash"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// Sink receives the output of a live Tracer. Implementations may write it to a file,
+// stream it to a remote consumer etc. Blocks are written in execution order and an
+// unwind invalidates the blocks above the unwind point written before it.
+type Sink interface {
+	WriteBlock(trace *BlockTrace) error
+	WriteUnwind(unwindPoint uint64) error
+	Close() error
+}
+
+// record is a line of the output of a JSONSink, only one of the fields is set.
+type record struct {
+	Block    *BlockTrace `json:"block,omitempty"`
+	UnwindTo *uint64     `json:"unwindTo,omitempty"`
+}
+
+// JSONSink writes one JSON object per line, either {"block":{...}} or {"unwindTo":N}.
+type JSONSink struct {
+	lock   sync.Mutex
+	w      *bufio.Writer
+	closer io.Closer
+}
+
+// NewJSONSink returns a Sink writing to w, which is closed along with the sink if it is an io.Closer.
+func NewJSONSink(w io.Writer) *JSONSink {
+	s := &JSONSink{w: bufio.NewWriter(w)}
+	if closer, ok := w.(io.Closer); ok {
+		s.closer = closer
+	}
+	return s
+}
+
+// NewFileSink returns a JSONSink appending to the file at path, "-" stands for the standard output.
+func NewFileSink(path string) (*JSONSink, error) {
+	if path == "-" {
+		return &JSONSink{w: bufio.NewWriter(os.Stdout)}, nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewJSONSink(f), nil
+}
+
+func (s *JSONSink) WriteBlock(trace *BlockTrace) error {
+	return s.write(&record{Block: trace})
+}
+
+func (s *JSONSink) WriteUnwind(unwindPoint uint64) error {
+	return s.write(&record{UnwindTo: &unwindPoint})
+}
+
+func (s *JSONSink) write(r *record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := json.NewEncoder(s.w).Encode(r); err != nil {
+		return err
+	}
+	// consumers tail the output, do not hold back the blocks
+	return s.w.Flush()
+}
+
+func (s *JSONSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	err := s.w.Flush()
+	if s.closer != nil {
+		if cerr := s.closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 
 	"github.com/ledgerwatch/erigon/accounts/abi"
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm"
 	"github.com/ledgerwatch/erigon/eth/tracers"
 )
@@ -111,6 +112,7 @@ type callTracer struct {
 	reason    error  // Textual reason for the interruption
 	logIndex  uint64
 	logGaps   map[uint64]int
+	depth     int // number of call frames being executed, 0 outside of the EVM
 }
 
 type callTracerConfig struct {
@@ -147,56 +149,40 @@ func (t *callTracer) CaptureStart(env *vm.EVM, from libcommon.Address, to libcom
 	if create {
 		t.callstack[0].Type = vm.CREATE
 	}
+	t.depth = 1
 }
 
 // CaptureEnd is called after the call finishes to finalize the tracing.
 func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
 	t.callstack[0].processOutput(output, err)
+	t.depth = 0
 }
 
-// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
-func (t *callTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
-	// Only logs need to be captured via opcode processing
+// OnLog implements the tracing.StateHooks interface to collect the logs emitted by the call frames.
+func (t *callTracer) OnLog(log *types.Log) {
 	if !t.config.WithLog {
 		return
 	}
+	// Skip the logs emitted outside of the EVM, e.g. the fee transfer logs of Bor
+	if t.depth == 0 {
+		return
+	}
 	// Avoid processing nested calls when only caring about top call
-	if t.config.OnlyTopCall && depth > 0 {
+	if t.config.OnlyTopCall && t.depth > 1 {
 		return
 	}
 	// Skip if tracing was interrupted
 	if atomic.LoadUint32(&t.interrupt) > 0 {
 		return
 	}
-	switch op {
-	case vm.LOG0, vm.LOG1, vm.LOG2, vm.LOG3, vm.LOG4:
-		size := int(op - vm.LOG0)
-
-		stack := scope.Stack
-		stackData := stack.Data
-		stackSize := len(stackData)
-		if stackSize < 2 {
-			return
-		}
-		// Don't modify the stack
-		mStart := stackData[stackSize-1]
-		mSize := stackData[stackSize-2]
-		topics := make([]libcommon.Hash, size)
-		dataStart := stackSize - 3
-		for i := 0; i < size && dataStart-i >= 0; i++ {
-			topic := stackData[dataStart-i]
-			topics[i] = libcommon.Hash(topic.Bytes32())
-		}
-
-		data := scope.Memory.GetCopy(int64(mStart.Uint64()), int64(mSize.Uint64()))
-		log := callLog{Address: scope.Contract.Address(), Topics: topics, Data: hexutility.Bytes(data), Index: t.logIndex}
-		t.logIndex++
-		t.callstack[len(t.callstack)-1].Logs = append(t.callstack[len(t.callstack)-1].Logs, log)
-	}
+	l := callLog{Address: log.Address, Topics: log.Topics, Data: libcommon.CopyBytes(log.Data), Index: t.logIndex}
+	t.logIndex++
+	t.callstack[len(t.callstack)-1].Logs = append(t.callstack[len(t.callstack)-1].Logs, l)
 }
 
 // CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
 func (t *callTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	t.depth++
 	if t.config.OnlyTopCall {
 		return
 	}
@@ -221,6 +207,7 @@ func (t *callTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libc
 // CaptureExit is called when EVM exits a scope, even if the scope didn't
 // execute any code.
 func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.depth--
 	if t.config.OnlyTopCall {
 		return
 	}
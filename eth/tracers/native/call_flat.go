@@ -0,0 +1,273 @@
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+)
+
+func init() {
+	register("flatCallTracer", newFlatCallTracer)
+}
+
+// Trace types of the Parity-style traces, as returned by the trace_ namespace.
+const (
+	flatCall         = "call"
+	flatCallCode     = "callcode"
+	flatDelegateCall = "delegatecall"
+	flatStaticCall   = "staticcall"
+	flatCreate       = "create"
+	flatSuicide      = "suicide"
+)
+
+// flatCallFrame is a call frame in the Parity format, the JSON encoding is the one of trace_transaction.
+type flatCallFrame struct {
+	Action              interface{}     `json:"action"` // one of flatCallAction, flatCreateAction and flatSuicideAction
+	BlockHash           *libcommon.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64         `json:"blockNumber,omitempty"`
+	Error               string          `json:"error,omitempty"`
+	Result              interface{}     `json:"result"` // one of flatCallResult and flatCreateResult, nil if the frame failed
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *libcommon.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64         `json:"transactionPosition,omitempty"`
+	Type                string          `json:"type"`
+	value               *uint256.Int    // value of the frame, inherited by delegate calls
+}
+
+type flatCallAction struct {
+	From     libcommon.Address `json:"from"`
+	CallType string            `json:"callType"`
+	Gas      hexutil.Big       `json:"gas"`
+	Input    hexutility.Bytes  `json:"input"`
+	To       libcommon.Address `json:"to"`
+	Value    hexutil.Big       `json:"value"`
+}
+
+type flatCreateAction struct {
+	From  libcommon.Address `json:"from"`
+	Gas   hexutil.Big       `json:"gas"`
+	Init  hexutility.Bytes  `json:"init"`
+	Value hexutil.Big       `json:"value"`
+}
+
+type flatSuicideAction struct {
+	Address       libcommon.Address `json:"address"`
+	RefundAddress libcommon.Address `json:"refundAddress"`
+	Balance       hexutil.Big       `json:"balance"`
+}
+
+type flatCallResult struct {
+	GasUsed *hexutil.Big     `json:"gasUsed"`
+	Output  hexutility.Bytes `json:"output"`
+}
+
+type flatCreateResult struct {
+	Address *libcommon.Address `json:"address,omitempty"`
+	Code    hexutility.Bytes   `json:"code"`
+	GasUsed *hexutil.Big       `json:"gasUsed"`
+}
+
+// flatCallTracer returns the call frames of a transaction as a flat list, in the
+// same format as trace_transaction, so that debug_trace* can be used in place of
+// the trace_ namespace.
+type flatCallTracer struct {
+	noopTracer
+	ctx         *tracers.Context
+	config      flatCallTracerConfig
+	traces      []*flatCallFrame
+	stack       []*flatCallFrame // frames being executed
+	traceAddr   []int
+	blockNumber uint64
+	precompile  bool   // set while a skipped call to a precompile is executed
+	interrupt   uint32 // Atomic flag to signal execution interruption
+	reason      error  // Textual reason for the interruption
+}
+
+type flatCallTracerConfig struct {
+	IncludePrecompiles bool `json:"includePrecompiles"` // If true, the calls to precompiles without value are reported, as opposed to trace_
+}
+
+// newFlatCallTracer returns a native go tracer which reports the call frames
+// of a tx as Parity-style traces, and implements vm.EVMLogger.
+func newFlatCallTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config flatCallTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	if ctx == nil {
+		ctx = new(tracers.Context)
+	}
+	return &flatCallTracer{ctx: ctx, config: config, traces: []*flatCallFrame{}}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *flatCallTracer) CaptureStart(env *vm.EVM, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	if env != nil {
+		t.blockNumber = env.Context.BlockNumber
+	}
+	t.enter(false /* deep */, vm.CALL, from, to, precompile, create, input, gas, value)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *flatCallTracer) CaptureEnd(output []byte, usedGas uint64, err error) {
+	t.exit(false /* deep */, output, usedGas, err)
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *flatCallTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	t.enter(true /* deep */, typ, from, to, precompile, create, input, gas, value)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *flatCallTracer) CaptureExit(output []byte, usedGas uint64, err error) {
+	t.exit(true /* deep */, output, usedGas, err)
+}
+
+func (t *flatCallTracer) enter(deep bool, typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+	// Like trace_, the calls to precompiles are omitted unless they transfer value
+	if deep && precompile && (value == nil || value.IsZero()) && !t.config.IncludePrecompiles {
+		t.precompile = true
+		return
+	}
+	if value == nil {
+		value = new(uint256.Int)
+	}
+	// Same adjustment of the gas passed to the calls as trace_, see OeTracer
+	if gas > 500000000 {
+		gas = 500000001 - (0x8000000000000000 - gas)
+	}
+	frame := new(flatCallFrame)
+	if deep {
+		parent := t.stack[len(t.stack)-1]
+		t.traceAddr = append(t.traceAddr, parent.Subtraces)
+		parent.Subtraces++
+		switch typ {
+		case vm.DELEGATECALL:
+			value = parent.value
+		case vm.STATICCALL:
+			value = new(uint256.Int)
+		}
+	}
+	frame.value = value
+	frame.TraceAddress = make([]int, len(t.traceAddr))
+	copy(frame.TraceAddress, t.traceAddr)
+
+	switch {
+	case create:
+		frame.Type = flatCreate
+		action := &flatCreateAction{From: from, Init: libcommon.CopyBytes(input)}
+		action.Gas.ToInt().SetUint64(gas)
+		action.Value.ToInt().Set(value.ToBig())
+		frame.Action = action
+		address := to
+		frame.Result = &flatCreateResult{Address: &address}
+	case typ == vm.SELFDESTRUCT:
+		frame.Type = flatSuicide
+		action := &flatSuicideAction{Address: from, RefundAddress: to}
+		action.Balance.ToInt().Set(value.ToBig())
+		frame.Action = action
+	default:
+		frame.Type = flatCall
+		callType := flatCall
+		switch typ {
+		case vm.CALLCODE:
+			callType = flatCallCode
+		case vm.DELEGATECALL:
+			callType = flatDelegateCall
+		case vm.STATICCALL:
+			callType = flatStaticCall
+		}
+		action := &flatCallAction{From: from, CallType: callType, To: to, Input: libcommon.CopyBytes(input)}
+		action.Gas.ToInt().SetUint64(gas)
+		action.Value.ToInt().Set(value.ToBig())
+		frame.Action = action
+		frame.Result = &flatCallResult{}
+	}
+	t.fillContext(frame)
+	t.traces = append(t.traces, frame)
+	t.stack = append(t.stack, frame)
+}
+
+func (t *flatCallTracer) exit(deep bool, output []byte, usedGas uint64, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+	if t.precompile {
+		t.precompile = false
+		return
+	}
+	if len(t.stack) == 0 {
+		return
+	}
+	frame := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	if deep {
+		t.traceAddr = t.traceAddr[:len(t.traceAddr)-1]
+	}
+
+	gasUsed := new(hexutil.Big)
+	gasUsed.ToInt().SetUint64(usedGas)
+	switch {
+	case err != nil && !errors.Is(err, vm.ErrExecutionReverted):
+		frame.Error = err.Error()
+		frame.Result = nil
+		return
+	case err != nil:
+		frame.Error = "Reverted"
+	}
+	switch result := frame.Result.(type) {
+	case *flatCallResult:
+		result.GasUsed = gasUsed
+		result.Output = libcommon.CopyBytes(output)
+	case *flatCreateResult:
+		result.GasUsed = gasUsed
+		result.Code = libcommon.CopyBytes(output)
+	}
+}
+
+// fillContext sets the block and transaction the frame belongs to, when known.
+func (t *flatCallTracer) fillContext(frame *flatCallFrame) {
+	if t.ctx.TxHash == (libcommon.Hash{}) {
+		// a call which is not part of a block
+		return
+	}
+	txHash, txIndex, blockNumber := t.ctx.TxHash, uint64(t.ctx.TxIndex), t.blockNumber
+	frame.TransactionHash, frame.TransactionPosition, frame.BlockNumber = &txHash, &txIndex, &blockNumber
+	if t.ctx.BlockHash != (libcommon.Hash{}) {
+		blockHash := t.ctx.BlockHash
+		frame.BlockHash = &blockHash
+	}
+}
+
+// GetResult returns the json-encoded list of call frames, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *flatCallTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.traces)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *flatCallTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
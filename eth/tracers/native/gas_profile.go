@@ -0,0 +1,390 @@
+package native
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/holiman/uint256"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+)
+
+func init() {
+	register("gasProfileTracer", newGasProfileTracer)
+}
+
+type opcodeGas struct {
+	Count uint64 `json:"count"`
+	Gas   uint64 `json:"gas"`
+}
+
+type functionGas struct {
+	Calls   uint64 `json:"calls"`
+	Gas     uint64 `json:"gas"`     // gas used by the calls, sub calls included
+	SelfGas uint64 `json:"selfGas"` // gas used by the code of the function itself
+}
+
+type contractGas struct {
+	functionGas
+	Opcodes   map[string]*opcodeGas   `json:"opcodes"`
+	Functions map[string]*functionGas `json:"functions,omitempty"` // by selector, absent for the calls with less than 4 bytes of input
+}
+
+type gasProfile struct {
+	GasUsed   uint64                             `json:"gasUsed"`
+	Opcodes   map[string]*opcodeGas              `json:"opcodes"`
+	Contracts map[libcommon.Address]*contractGas `json:"contracts"`
+	Pprof     []byte                             `json:"pprof,omitempty"` // gzipped pprof profile of the gas, base64 encoded in JSON
+}
+
+// gasProfileFrame is a call frame being executed.
+type gasProfileFrame struct {
+	contract  libcommon.Address // address of the executed code, the callee of delegate calls
+	selector  string
+	stack     []string // pprof stack of the frame, outermost first
+	startGas  uint64
+	childGas  uint64 // gas used by the sub calls
+	opGas     uint64 // gas charged to the opcodes of the frame
+	skip      bool   // selfdestructs are reported as frames, but do not execute code
+	pending   bool   // whether op was executed and not yet charged
+	op        vm.OpCode
+	opGasLeft uint64 // gas available before op
+	opChild   uint64 // gas used by the sub calls of op
+}
+
+// gasProfileTracer reports where the gas of a tx is spent: per opcode, per contract
+// (the code executed, i.e. the callee of delegate calls) and per function selector
+// of each contract. The gas of an opcode excludes the gas used by the calls it
+// makes, which is charged to the opcodes of the callee.
+//
+// The results of several transactions, e.g. of debug_traceBlockByNumber, can be
+// summed up. With {"pprof": true} the result includes the profile in the pprof
+// format, for e.g. `go tool pprof -http :8080 profile.pb.gz`, where the stacks
+// are the call frames and the opcodes.
+type gasProfileTracer struct {
+	noopTracer
+	config    gasProfileTracerConfig
+	profile   gasProfile
+	frames    []*gasProfileFrame
+	gasLimit  uint64
+	samples   map[string]*gasSample // pprof samples by stack
+	interrupt uint32                // Atomic flag to signal execution interruption
+	reason    error                 // Textual reason for the interruption
+}
+
+type gasProfileTracerConfig struct {
+	Pprof bool `json:"pprof"` // If true, the profile is returned in the pprof format as well
+}
+
+type gasSample struct {
+	stack []string
+	gas   uint64
+}
+
+// newGasProfileTracer returns a native go tracer which aggregates the gas spent
+// by a tx, and implements vm.EVMLogger.
+func newGasProfileTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config gasProfileTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	return &gasProfileTracer{
+		config: config,
+		profile: gasProfile{
+			Opcodes:   make(map[string]*opcodeGas),
+			Contracts: make(map[libcommon.Address]*contractGas),
+		},
+		samples: make(map[string]*gasSample),
+	}, nil
+}
+
+func (t *gasProfileTracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+func (t *gasProfileTracer) CaptureTxEnd(restGas uint64) {
+	t.profile.GasUsed = t.gasLimit - restGas
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *gasProfileTracer) CaptureStart(env *vm.EVM, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	t.enter(vm.CALL, to, create, input, gas)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *gasProfileTracer) CaptureEnd(output []byte, usedGas uint64, err error) {
+	t.exit(usedGas)
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *gasProfileTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	t.enter(typ, to, create, input, gas)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *gasProfileTracer) CaptureExit(output []byte, usedGas uint64, err error) {
+	t.exit(usedGas)
+}
+
+func (t *gasProfileTracer) enter(typ vm.OpCode, to libcommon.Address, create bool, input []byte, gas uint64) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+	frame := &gasProfileFrame{contract: to, startGas: gas, skip: typ == vm.SELFDESTRUCT}
+	name := to.Hex()
+	switch {
+	case create:
+		name += " (create)"
+	case len(input) >= 4:
+		frame.selector = hexutility.Encode(input[:4])
+		name += " " + frame.selector
+	}
+	if len(t.frames) > 0 {
+		frame.stack = append(frame.stack, t.frames[len(t.frames)-1].stack...)
+	}
+	frame.stack = append(frame.stack, name)
+	t.frames = append(t.frames, frame)
+}
+
+func (t *gasProfileTracer) exit(usedGas uint64) {
+	if atomic.LoadUint32(&t.interrupt) > 0 || len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if frame.skip {
+		return
+	}
+	// the last opcode, e.g. RETURN, or the one which failed and consumed the remaining gas
+	if frame.pending {
+		var gasLeft uint64
+		if usedGas < frame.startGas {
+			gasLeft = frame.startGas - usedGas
+		}
+		t.chargePending(frame, gasLeft)
+	}
+	var selfGas uint64
+	if usedGas > frame.childGas {
+		selfGas = usedGas - frame.childGas
+	}
+	// the gas used outside of the opcodes, by the precompiles and the code deposit of failed creations
+	if selfGas > frame.opGas {
+		t.sample(frame.stack, selfGas-frame.opGas)
+	}
+
+	contract := t.contract(frame.contract)
+	contract.Calls++
+	contract.Gas += usedGas
+	contract.SelfGas += selfGas
+	if frame.selector != "" {
+		if contract.Functions == nil {
+			contract.Functions = make(map[string]*functionGas)
+		}
+		function := contract.Functions[frame.selector]
+		if function == nil {
+			function = new(functionGas)
+			contract.Functions[frame.selector] = function
+		}
+		function.Calls++
+		function.Gas += usedGas
+		function.SelfGas += selfGas
+	}
+	if len(t.frames) > 0 {
+		parent := t.frames[len(t.frames)-1]
+		parent.childGas += usedGas
+		parent.opChild += usedGas
+	}
+}
+
+// CaptureState charges the gas of the previous opcode of the frame, which is known once the next one starts.
+func (t *gasProfileTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 || len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if frame.pending {
+		t.chargePending(frame, gas)
+	}
+	frame.pending, frame.op, frame.opGasLeft, frame.opChild = true, op, gas, 0
+}
+
+// chargePending charges the pending opcode of the frame, given the gas left after it.
+func (t *gasProfileTracer) chargePending(frame *gasProfileFrame, gasLeft uint64) {
+	frame.pending = false
+	var gas uint64
+	if spent := frame.opGasLeft - gasLeft; gasLeft <= frame.opGasLeft && spent > frame.opChild {
+		gas = spent - frame.opChild
+	}
+	name := frame.op.String()
+	charge := func(opcodes map[string]*opcodeGas) {
+		o := opcodes[name]
+		if o == nil {
+			o = new(opcodeGas)
+			opcodes[name] = o
+		}
+		o.Count++
+		o.Gas += gas
+	}
+	charge(t.profile.Opcodes)
+	charge(t.contract(frame.contract).Opcodes)
+	frame.opGas += gas
+	if gas > 0 {
+		t.sample(append(frame.stack[:len(frame.stack):len(frame.stack)], name), gas)
+	}
+}
+
+func (t *gasProfileTracer) contract(addr libcommon.Address) *contractGas {
+	c := t.profile.Contracts[addr]
+	if c == nil {
+		c = &contractGas{Opcodes: make(map[string]*opcodeGas)}
+		t.profile.Contracts[addr] = c
+	}
+	return c
+}
+
+func (t *gasProfileTracer) sample(stack []string, gas uint64) {
+	if !t.config.Pprof {
+		return
+	}
+	key := strings.Join(stack, "\n")
+	s := t.samples[key]
+	if s == nil {
+		s = &gasSample{stack: stack}
+		t.samples[key] = s
+	}
+	s.gas += gas
+}
+
+// GetResult returns the json-encoded gas profile, and any error arising
+// from the encoding or forceful termination (via `Stop`).
+func (t *gasProfileTracer) GetResult() (json.RawMessage, error) {
+	if t.config.Pprof {
+		profile, err := t.pprof()
+		if err != nil {
+			return nil, err
+		}
+		t.profile.Pprof = profile
+	}
+	res, err := json.Marshal(t.profile)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *gasProfileTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// Field numbers of the messages of profile.proto, see github.com/google/pprof/proto/profile.proto
+const (
+	pprofProfileSampleType  = 1
+	pprofProfileSample      = 2
+	pprofProfileLocation    = 4
+	pprofProfileFunction    = 5
+	pprofProfileStringTable = 6
+	pprofValueTypeType      = 1
+	pprofValueTypeUnit      = 2
+	pprofSampleLocationID   = 1
+	pprofSampleValue        = 2
+	pprofLocationID         = 1
+	pprofLocationLine       = 4
+	pprofLineFunctionID     = 1
+	pprofFunctionID         = 1
+	pprofFunctionName       = 2
+)
+
+// pprof encodes the samples as a gzipped pprof profile, where every distinct frame
+// name is a function with a location of the same id.
+func (t *gasProfileTracer) pprof() ([]byte, error) {
+	strs := []string{""}
+	strIDs := map[string]uint64{"": 0}
+	str := func(s string) uint64 {
+		id, ok := strIDs[s]
+		if !ok {
+			id = uint64(len(strs))
+			strs = append(strs, s)
+			strIDs[s] = id
+		}
+		return id
+	}
+	var funcs []string
+	funcIDs := make(map[string]uint64)
+	fn := func(name string) uint64 {
+		id, ok := funcIDs[name]
+		if !ok {
+			funcs = append(funcs, name)
+			id = uint64(len(funcs))
+			funcIDs[name] = id
+		}
+		return id
+	}
+	message := func(b []byte, num protowire.Number, m []byte) []byte {
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, m)
+	}
+	varint := func(b []byte, num protowire.Number, v uint64) []byte {
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		return protowire.AppendVarint(b, v)
+	}
+
+	var p, m []byte
+	m = varint(m, pprofValueTypeType, str("gas"))
+	m = varint(m, pprofValueTypeUnit, str("gas"))
+	p = message(p, pprofProfileSampleType, m)
+
+	keys := make([]string, 0, len(t.samples))
+	for key := range t.samples {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := t.samples[key]
+		var locations, values []byte
+		// leaf first
+		for i := len(s.stack) - 1; i >= 0; i-- {
+			locations = protowire.AppendVarint(locations, fn(s.stack[i]))
+		}
+		values = protowire.AppendVarint(values, s.gas)
+		m = message(nil, pprofSampleLocationID, locations)
+		m = message(m, pprofSampleValue, values)
+		p = message(p, pprofProfileSample, m)
+	}
+	for i, name := range funcs {
+		id := uint64(i + 1)
+		m = varint(nil, pprofLocationID, id)
+		m = message(m, pprofLocationLine, varint(nil, pprofLineFunctionID, id))
+		p = message(p, pprofProfileLocation, m)
+		m = varint(nil, pprofFunctionID, id)
+		m = varint(m, pprofFunctionName, str(name))
+		p = message(p, pprofProfileFunction, m)
+	}
+	for _, s := range strs {
+		p = protowire.AppendTag(p, pprofProfileStringTable, protowire.BytesType)
+		p = protowire.AppendString(p, s)
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(p); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
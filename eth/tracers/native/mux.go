@@ -22,6 +22,8 @@ import (
 	"github.com/holiman/uint256"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm"
 	"github.com/ledgerwatch/erigon/eth/tracers"
 )
@@ -35,6 +37,7 @@ func init() {
 type muxTracer struct {
 	names   []string
 	tracers []tracers.Tracer
+	hooks   []tracing.StateHooks // the tracers which observe the state changes
 }
 
 // newMuxTracer returns a new mux tracer.
@@ -47,6 +50,7 @@ func newMuxTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, er
 	}
 	objects := make([]tracers.Tracer, 0, len(config))
 	names := make([]string, 0, len(config))
+	var hooks []tracing.StateHooks
 	for k, v := range config {
 		t, err := tracers.New(k, ctx, v)
 		if err != nil {
@@ -54,9 +58,12 @@ func newMuxTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, er
 		}
 		objects = append(objects, t)
 		names = append(names, k)
+		if h, ok := t.(tracing.StateHooks); ok {
+			hooks = append(hooks, h)
+		}
 	}
 
-	return &muxTracer{names: names, tracers: objects}, nil
+	return &muxTracer{names: names, tracers: objects, hooks: hooks}, nil
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
@@ -114,6 +121,41 @@ func (t *muxTracer) CaptureTxEnd(restGas uint64) {
 	}
 }
 
+// OnBalanceChange implements the tracing.StateHooks interface.
+func (t *muxTracer) OnBalanceChange(addr libcommon.Address, prev, new *uint256.Int, reason tracing.BalanceChangeReason) {
+	for _, h := range t.hooks {
+		h.OnBalanceChange(addr, prev, new, reason)
+	}
+}
+
+// OnNonceChange implements the tracing.StateHooks interface.
+func (t *muxTracer) OnNonceChange(addr libcommon.Address, prev, new uint64) {
+	for _, h := range t.hooks {
+		h.OnNonceChange(addr, prev, new)
+	}
+}
+
+// OnCodeChange implements the tracing.StateHooks interface.
+func (t *muxTracer) OnCodeChange(addr libcommon.Address, prevCodeHash libcommon.Hash, prevCode []byte, codeHash libcommon.Hash, code []byte) {
+	for _, h := range t.hooks {
+		h.OnCodeChange(addr, prevCodeHash, prevCode, codeHash, code)
+	}
+}
+
+// OnStorageChange implements the tracing.StateHooks interface.
+func (t *muxTracer) OnStorageChange(addr libcommon.Address, slot *libcommon.Hash, prev, new uint256.Int) {
+	for _, h := range t.hooks {
+		h.OnStorageChange(addr, slot, prev, new)
+	}
+}
+
+// OnLog implements the tracing.StateHooks interface.
+func (t *muxTracer) OnLog(log *types.Log) {
+	for _, h := range t.hooks {
+		h.OnLog(log)
+	}
+}
+
 // GetResult returns an empty json object.
 func (t *muxTracer) GetResult() (json.RawMessage, error) {
 	resObject := make(map[string]json.RawMessage)
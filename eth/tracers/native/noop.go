@@ -22,6 +22,8 @@ import (
 	"github.com/holiman/uint256"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm"
 	"github.com/ledgerwatch/erigon/eth/tracers"
 )
@@ -68,6 +70,24 @@ func (*noopTracer) CaptureTxStart(gasLimit uint64) {}
 
 func (*noopTracer) CaptureTxEnd(restGas uint64) {}
 
+// OnBalanceChange implements the tracing.StateHooks interface.
+func (*noopTracer) OnBalanceChange(addr libcommon.Address, prev, new *uint256.Int, reason tracing.BalanceChangeReason) {
+}
+
+// OnNonceChange implements the tracing.StateHooks interface.
+func (*noopTracer) OnNonceChange(addr libcommon.Address, prev, new uint64) {}
+
+// OnCodeChange implements the tracing.StateHooks interface.
+func (*noopTracer) OnCodeChange(addr libcommon.Address, prevCodeHash libcommon.Hash, prevCode []byte, codeHash libcommon.Hash, code []byte) {
+}
+
+// OnStorageChange implements the tracing.StateHooks interface.
+func (*noopTracer) OnStorageChange(addr libcommon.Address, slot *libcommon.Hash, prev, new uint256.Int) {
+}
+
+// OnLog implements the tracing.StateHooks interface.
+func (*noopTracer) OnLog(log *types.Log) {}
+
 // GetResult returns an empty json object.
 func (t *noopTracer) GetResult() (json.RawMessage, error) {
 	return json.RawMessage(`{}`), nil
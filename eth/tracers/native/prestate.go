@@ -28,6 +28,7 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 
+	"github.com/ledgerwatch/erigon/core/tracing"
 	"github.com/ledgerwatch/erigon/core/vm"
 	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/eth/tracers"
@@ -64,12 +65,22 @@ type prestateTracer struct {
 	post      state
 	create    bool
 	to        libcommon.Address
-	gasLimit  uint64 // Amount of gas bought for the whole tx
 	config    prestateTracerConfig
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
 	created   map[libcommon.Address]bool
 	deleted   map[libcommon.Address]bool
+	original  map[libcommon.Address]*originalAccount
+	txEnded   bool
+}
+
+// originalAccount holds the values of an account changed before the EVM started,
+// i.e. by the gas purchase, the nonce increment or the EIP-7702 authorizations.
+// The nil fields were not changed.
+type originalAccount struct {
+	balance *big.Int
+	nonce   *uint64
+	code    *[]byte
 }
 
 type prestateTracerConfig struct {
@@ -84,11 +95,12 @@ func newPrestateTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Trace
 		}
 	}
 	return &prestateTracer{
-		pre:     state{},
-		post:    state{},
-		config:  config,
-		created: make(map[libcommon.Address]bool),
-		deleted: make(map[libcommon.Address]bool),
+		pre:      state{},
+		post:     state{},
+		config:   config,
+		created:  make(map[libcommon.Address]bool),
+		deleted:  make(map[libcommon.Address]bool),
+		original: make(map[libcommon.Address]*originalAccount),
 	}, nil
 }
 
@@ -98,25 +110,14 @@ func (t *prestateTracer) CaptureStart(env *vm.EVM, from libcommon.Address, to li
 	t.create = create
 	t.to = to
 
+	// The values changed so far are known from the state hooks
+	for addr := range t.original {
+		t.lookupAccount(addr)
+	}
 	t.lookupAccount(from)
 	t.lookupAccount(to)
 	t.lookupAccount(env.Context.Coinbase)
 
-	// The recipient balance includes the value transferred.
-	toBal := new(big.Int).Sub(t.pre[to].Balance, value.ToBig())
-	t.pre[to].Balance = toBal
-
-	// The sender balance is after reducing: value and gasLimit.
-	// We need to re-add them to get the pre-tx balance.
-	fromBal := new(big.Int).Set(t.pre[from].Balance)
-	gasPrice := env.GasPrice
-	consumedGas := new(big.Int).Mul(gasPrice.ToBig(), new(big.Int).SetUint64(t.gasLimit))
-	fromBal.Add(fromBal, new(big.Int).Add(value.ToBig(), consumedGas))
-	t.pre[from].Balance = fromBal
-	if t.pre[from].Nonce > 0 {
-		t.pre[from].Nonce--
-	}
-
 	if create && t.config.DiffMode {
 		t.created[to] = true
 	}
@@ -173,11 +174,8 @@ func (t *prestateTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64,
 	}
 }
 
-func (t *prestateTracer) CaptureTxStart(gasLimit uint64) {
-	t.gasLimit = gasLimit
-}
-
 func (t *prestateTracer) CaptureTxEnd(restGas uint64) {
+	t.txEnded = true
 	if !t.config.DiffMode {
 		return
 	}
@@ -266,6 +264,55 @@ func (t *prestateTracer) Stop(err error) {
 	atomic.StoreUint32(&t.interrupt, 1)
 }
 
+// OnBalanceChange implements the tracing.StateHooks interface to record the balance before it changes.
+func (t *prestateTracer) OnBalanceChange(addr libcommon.Address, prev, new *uint256.Int, reason tracing.BalanceChangeReason) {
+	if orig := t.onChange(addr); orig != nil && orig.balance == nil {
+		orig.balance = prev.ToBig()
+	}
+}
+
+// OnNonceChange implements the tracing.StateHooks interface to record the nonce before it changes.
+func (t *prestateTracer) OnNonceChange(addr libcommon.Address, prev, new uint64) {
+	if orig := t.onChange(addr); orig != nil && orig.nonce == nil {
+		orig.nonce = &prev
+	}
+}
+
+// OnCodeChange implements the tracing.StateHooks interface to record the code before it changes.
+func (t *prestateTracer) OnCodeChange(addr libcommon.Address, prevCodeHash libcommon.Hash, prevCode []byte, codeHash libcommon.Hash, code []byte) {
+	if orig := t.onChange(addr); orig != nil && orig.code == nil {
+		prevCode = libcommon.CopyBytes(prevCode)
+		orig.code = &prevCode
+	}
+}
+
+// OnStorageChange implements the tracing.StateHooks interface to record the slot before it changes.
+func (t *prestateTracer) OnStorageChange(addr libcommon.Address, slot *libcommon.Hash, prev, new uint256.Int) {
+	if t.env != nil && !t.txEnded {
+		t.lookupAccount(addr)
+		t.lookupStorage(addr, *slot)
+	}
+}
+
+// onChange adds the account about to change to the prestate. As the hooks are invoked before
+// the change is applied, the account can be looked up once the EVM has started, otherwise
+// the returned originalAccount is to be filled with the previous value.
+func (t *prestateTracer) onChange(addr libcommon.Address) *originalAccount {
+	if t.txEnded {
+		return nil
+	}
+	if t.env != nil {
+		t.lookupAccount(addr)
+		return nil
+	}
+	orig, ok := t.original[addr]
+	if !ok {
+		orig = &originalAccount{}
+		t.original[addr] = orig
+	}
+	return orig
+}
+
 // lookupAccount fetches details of an account and adds it to the prestate
 // if it doesn't exist there.
 func (t *prestateTracer) lookupAccount(addr libcommon.Address) {
@@ -273,12 +320,24 @@ func (t *prestateTracer) lookupAccount(addr libcommon.Address) {
 		return
 	}
 
-	t.pre[addr] = &account{
+	acc := &account{
 		Balance: t.env.IntraBlockState().GetBalance(addr).ToBig(),
 		Nonce:   t.env.IntraBlockState().GetNonce(addr),
 		Code:    t.env.IntraBlockState().GetCode(addr),
 		Storage: make(map[libcommon.Hash]libcommon.Hash),
 	}
+	if orig, ok := t.original[addr]; ok {
+		if orig.balance != nil {
+			acc.Balance = orig.balance
+		}
+		if orig.nonce != nil {
+			acc.Nonce = *orig.nonce
+		}
+		if orig.code != nil {
+			acc.Code = *orig.code
+		}
+	}
+	t.pre[addr] = acc
 }
 
 // lookupStorage fetches the requested storage slot and adds
@@ -0,0 +1,222 @@
+package native
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+)
+
+func init() {
+	register("transferTracer", newTransferTracer)
+}
+
+// Kinds of transfers reported by the transferTracer.
+const (
+	transferETH     = "eth"
+	transferERC20   = "erc20"
+	transferERC721  = "erc721"
+	transferERC1155 = "erc1155"
+)
+
+var (
+	// Transfer(address,address,uint256) of ERC-20, and ERC-721 with the token id indexed
+	transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// TransferSingle(address,address,address,uint256,uint256) of ERC-1155
+	transferSingleEventTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	// TransferBatch(address,address,address,uint256[],uint256[]) of ERC-1155
+	transferBatchEventTopic = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+)
+
+// transfer is a movement of ether or of a token.
+type transfer struct {
+	Type     string             `json:"type"`
+	Token    *libcommon.Address `json:"token,omitempty"` // contract of the token, nil for ether
+	Operator *libcommon.Address `json:"operator,omitempty"`
+	From     libcommon.Address  `json:"from"`
+	To       libcommon.Address  `json:"to"`
+	TokenID  *hexutil.Big       `json:"tokenId,omitempty"`
+	Value    *hexutil.Big       `json:"value,omitempty"`
+}
+
+// transferTracer reports the ether moved by the call frames of a tx and the tokens
+// transferred according to the ERC-20, ERC-721 and ERC-1155 Transfer events, in
+// execution order. The transfers of the frames which failed are dropped, fees are
+// not reported.
+//
+// Example:
+//
+//	> debug.traceTransaction("0x...", {tracer: "transferTracer"})
+//	[
+//	  {"type": "eth", "from": "0x...", "to": "0x...", "value": "0xde0b6b3a7640000"},
+//	  {"type": "erc20", "token": "0x...", "from": "0x...", "to": "0x...", "value": "0x64"}
+//	]
+type transferTracer struct {
+	noopTracer
+	frames    [][]transfer // transfers of the frames being executed
+	transfers []transfer
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newTransferTracer returns a native go tracer which collects the ether and
+// token transfers of a tx, and implements vm.EVMLogger.
+func newTransferTracer(ctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	return &transferTracer{transfers: []transfer{}}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *transferTracer) CaptureStart(env *vm.EVM, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	t.frames = [][]transfer{nil}
+	t.transferETH(from, to, value)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *transferTracer) CaptureEnd(output []byte, usedGas uint64, err error) {
+	if len(t.frames) != 1 {
+		return
+	}
+	if err == nil {
+		t.transfers = append(t.transfers, t.frames[0]...)
+	}
+	t.frames = nil
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *transferTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	if atomic.LoadUint32(&t.interrupt) > 0 || len(t.frames) == 0 {
+		return
+	}
+	t.frames = append(t.frames, nil)
+	switch typ {
+	case vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		// the value, if any, stays with the caller
+	default:
+		t.transferETH(from, to, value)
+	}
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *transferTracer) CaptureExit(output []byte, usedGas uint64, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 || len(t.frames) < 2 {
+		return
+	}
+	size := len(t.frames)
+	frame := t.frames[size-1]
+	t.frames = t.frames[:size-1]
+	if err == nil {
+		t.frames[size-2] = append(t.frames[size-2], frame...)
+	}
+}
+
+func (t *transferTracer) transferETH(from, to libcommon.Address, value *uint256.Int) {
+	if value == nil || value.IsZero() {
+		return
+	}
+	t.add(transfer{Type: transferETH, From: from, To: to, Value: (*hexutil.Big)(value.ToBig())})
+}
+
+func (t *transferTracer) add(tr transfer) {
+	t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], tr)
+}
+
+// OnLog implements the tracing.StateHooks interface to decode the token transfer events.
+func (t *transferTracer) OnLog(log *types.Log) {
+	// Skip the logs emitted outside of the EVM, and when tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 || len(t.frames) == 0 || len(log.Topics) == 0 {
+		return
+	}
+	token := log.Address
+	switch log.Topics[0] {
+	case transferEventTopic:
+		switch {
+		case len(log.Topics) == 3 && len(log.Data) == 32:
+			t.add(transfer{Type: transferERC20, Token: &token, From: topicAddress(log.Topics[1]), To: topicAddress(log.Topics[2]),
+				Value: wordToBig(log.Data)})
+		case len(log.Topics) == 4 && len(log.Data) == 0:
+			t.add(transfer{Type: transferERC721, Token: &token, From: topicAddress(log.Topics[1]), To: topicAddress(log.Topics[2]),
+				TokenID: wordToBig(log.Topics[3][:])})
+		}
+	case transferSingleEventTopic:
+		if len(log.Topics) != 4 || len(log.Data) != 64 {
+			return
+		}
+		operator := topicAddress(log.Topics[1])
+		t.add(transfer{Type: transferERC1155, Token: &token, Operator: &operator, From: topicAddress(log.Topics[2]), To: topicAddress(log.Topics[3]),
+			TokenID: wordToBig(log.Data[:32]), Value: wordToBig(log.Data[32:])})
+	case transferBatchEventTopic:
+		if len(log.Topics) != 4 {
+			return
+		}
+		ids, ok := decodeUint256Array(log.Data, 0)
+		if !ok {
+			return
+		}
+		values, ok := decodeUint256Array(log.Data, 32)
+		if !ok || len(ids) != len(values) {
+			return
+		}
+		operator := topicAddress(log.Topics[1])
+		for i := range ids {
+			t.add(transfer{Type: transferERC1155, Token: &token, Operator: &operator, From: topicAddress(log.Topics[2]), To: topicAddress(log.Topics[3]),
+				TokenID: wordToBig(ids[i]), Value: wordToBig(values[i])})
+		}
+	}
+}
+
+// topicAddress returns the address held by an indexed event parameter.
+func topicAddress(topic libcommon.Hash) libcommon.Address {
+	return libcommon.BytesToAddress(topic[12:])
+}
+
+func wordToBig(word []byte) *hexutil.Big {
+	return (*hexutil.Big)(new(uint256.Int).SetBytes32(word).ToBig())
+}
+
+// decodeUint256Array decodes the ABI encoded uint256[] whose offset is at headPos
+// in data, and returns its elements as 32 bytes words.
+func decodeUint256Array(data []byte, headPos int) ([][]byte, bool) {
+	if len(data) < headPos+32 {
+		return nil, false
+	}
+	offset, overflow := new(uint256.Int).SetBytes32(data[headPos : headPos+32]).Uint64WithOverflow()
+	if overflow || offset > uint64(len(data)) || uint64(len(data))-offset < 32 {
+		return nil, false
+	}
+	length, overflow := new(uint256.Int).SetBytes32(data[offset : offset+32]).Uint64WithOverflow()
+	start := offset + 32
+	if overflow || length > (uint64(len(data))-start)/32 {
+		return nil, false
+	}
+	words := make([][]byte, length)
+	for i := range words {
+		pos := start + uint64(i)*32
+		words[i] = data[pos : pos+32]
+	}
+	return words, true
+}
+
+// GetResult returns the json-encoded list of transfers, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *transferTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.transfers)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *transferTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
@@ -173,6 +173,18 @@ const (
 // EIP-4788: Beacon block root in the EVM
 var BeaconRootsAddress = common.HexToAddress("0x000F3df6D732807Ef1319fB7B8bB8522d0Beac02")
 
+// EIP-2935: Serve historical block hashes from state
+var HistoryStorageAddress = common.HexToAddress("0x0000F90827F1C53a10cb7A02335B175320002935")
+
+// BlockHashHistoryServeWindow is the number of block hashes kept in the ring buffer of the history storage contract
+const BlockHashHistoryServeWindow uint64 = 8191
+
+// EIP-7069: Revamped CALL instructions
+const (
+	ExtCallMinRetainedGas uint64 = 5000 // Minimum gas retained by the caller of EXTCALL, EXTDELEGATECALL and EXTSTATICCALL
+	ExtCallMinCalleeGas   uint64 = 2300 // Minimum gas passed to the callee, otherwise the call fails without execution
+)
+
 // Gas discount table for BLS12-381 G1 and G2 multi exponentiation operations
 var Bls12381MultiExpDiscountTable = [128]uint64{1200, 888, 764, 641, 594, 547, 500, 453, 438, 423, 408, 394, 379, 364, 349, 334, 330, 326, 322, 318, 314, 310, 306, 302, 298, 294, 289, 285, 281, 277, 273, 269, 268, 266, 265, 263, 262, 260, 259, 257, 256, 254, 253, 251, 250, 248, 247, 245, 244, 242, 241, 239, 238, 236, 235, 233, 232, 231, 229, 228, 226, 225, 223, 222, 221, 220, 219, 219, 218, 217, 216, 216, 215, 214, 213, 213, 212, 211, 211, 210, 209, 208, 208, 207, 206, 205, 205, 204, 203, 202, 202, 201, 200, 199, 199, 198, 197, 196, 196, 195, 194, 193, 193, 192, 191, 191, 190, 189, 188, 188, 187, 186, 185, 185, 184, 183, 182, 182, 181, 180, 179, 179, 178, 177, 176, 176, 175, 174}
 
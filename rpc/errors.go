@@ -26,6 +26,7 @@ var (
 	_ Error = new(invalidMessageError)
 	_ Error = new(InvalidParamsError)
 	_ Error = new(CustomError)
+	_ Error = new(rateLimitedError)
 )
 
 const defaultErrorCode = -32000
@@ -46,6 +47,15 @@ func (e *subscriptionNotFoundError) Error() string {
 	return fmt.Sprintf("no %q subscription in %s namespace", e.subscription, e.namespace)
 }
 
+// the client went over one of the rate limits of the server
+type rateLimitedError struct{ method, group string }
+
+func (e *rateLimitedError) ErrorCode() int { return -32005 }
+
+func (e *rateLimitedError) Error() string {
+	return fmt.Sprintf("rate limit of %s exceeded by %s", e.group, e.method)
+}
+
 // Invalid JSON was received by the server.
 type parseError struct{ message string }
 
@@ -284,6 +284,35 @@ func validateRequest(r *http.Request) (int, error) {
 }
 
 func CheckJwtSecret(w http.ResponseWriter, r *http.Request, jwtSecret []byte) bool {
+	_, ok := checkJwtSecret(w, r, jwtSecret)
+	return ok
+}
+
+// AuthenticateJwt is CheckJwtSecret which also returns the request with the subject of the token in its context, the
+// rate limits of the server are then counted against the subject instead of the IP address of the client.
+func AuthenticateJwt(w http.ResponseWriter, r *http.Request, jwtSecret []byte) (*http.Request, bool) {
+	claims, ok := checkJwtSecret(w, r, jwtSecret)
+	if !ok {
+		return r, false
+	}
+	return r.WithContext(context.WithValue(r.Context(), jwtSubjectKey{}, claims.Subject)), true
+}
+
+// OptionalJwtHandler authenticates the requests which carry a JWT, so that their subject identifies the client for the
+// rate limits. The requests without a token are served as they are.
+func OptionalJwtHandler(next http.Handler, jwtSecret []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			var ok bool
+			if r, ok = AuthenticateJwt(w, r, jwtSecret); !ok {
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func checkJwtSecret(w http.ResponseWriter, r *http.Request, jwtSecret []byte) (*jwt.RegisteredClaims, bool) {
 	var tokenStr string
 	// Check if JWT signature is correct
 	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
@@ -292,7 +321,7 @@ func CheckJwtSecret(w http.ResponseWriter, r *http.Request, jwtSecret []byte) bo
 
 	if len(tokenStr) == 0 {
 		http.Error(w, "missing token", http.StatusForbidden)
-		return false
+		return nil, false
 	}
 
 	keyFunc := func(token *jwt.Token) (interface{}, error) {
@@ -320,8 +349,8 @@ func CheckJwtSecret(w http.ResponseWriter, r *http.Request, jwtSecret []byte) bo
 	case time.Until(claims.IssuedAt.Time) > jwtTokenExpiry:
 		http.Error(w, "future token", http.StatusForbidden)
 	default:
-		return true
+		return &claims, true
 	}
 
-	return false
+	return nil, false
 }
@@ -92,3 +92,13 @@ func newRPCServingTimerMS(method string, valid bool) metrics.Summary {
 
 	return metrics.GetOrCreateSummary(label)
 }
+
+// newRateLimitedCounter counts the calls rejected by the rate limit of a method group
+func newRateLimitedCounter(group string) metrics.Counter {
+	return metrics.GetOrCreateCounter(fmt.Sprintf(`rpc_rate_limited{group="%s"}`, group))
+}
+
+// newRateLimitRunningGauge tracks the calls of a rate limited method group which are running
+func newRateLimitRunningGauge(group string) metrics.Gauge {
+	return metrics.GetOrCreateGauge(fmt.Sprintf(`rpc_rate_limit_running{group="%s"}`, group))
+}
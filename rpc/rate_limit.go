@@ -0,0 +1,181 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/time/rate"
+
+	"github.com/ledgerwatch/erigon-lib/metrics"
+)
+
+// rateLimitedClients is the number of clients whose limits are tracked, the least recently seen ones are forgotten.
+const rateLimitedClients = 10_000
+
+// RateLimitRule limits how much of a group of methods a single client can use.
+type RateLimitRule struct {
+	// Name of the group, used in the errors and metrics.
+	Name string `json:"name"`
+	// Methods of the group, a name ending with "*" matches all the methods with that prefix, e.g. "debug_trace*".
+	Methods []string `json:"methods"`
+	// Rate is the number of units per second refilling the token bucket of a client, 0 disables the bucket.
+	Rate float64 `json:"rate"`
+	// Burst is the size of the token bucket, it defaults to Rate.
+	Burst int `json:"burst"`
+	// Cost is the number of units a call takes from the bucket, it defaults to 1 which makes Rate a number of
+	// requests per second. Costs overrides it for single methods, so the units can be used as compute units.
+	Cost  int            `json:"cost"`
+	Costs map[string]int `json:"costs"`
+	// MaxConcurrent is the number of calls of the group a client can run at the same time, 0 means unlimited.
+	MaxConcurrent int `json:"max_concurrent"`
+}
+
+func (r *RateLimitRule) matches(method string) bool {
+	for _, m := range r.Methods {
+		if prefix, ok := strings.CutSuffix(m, "*"); ok {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+		} else if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RateLimitRule) cost(method string) int {
+	if cost, ok := r.Costs[method]; ok {
+		return cost
+	}
+	return r.Cost
+}
+
+// RateLimiter applies the rate limit rules to each client, identified by its IP address or by the subject of its JWT.
+type RateLimiter struct {
+	rules   []RateLimitRule
+	clients *lru.Cache[string, *clientRateLimits]
+
+	limitedCounters  []metrics.Counter
+	concurrentGauges []metrics.Gauge
+}
+
+// clientRateLimits holds the token bucket and the number of running calls of a client for each rule.
+type clientRateLimits struct {
+	mu      sync.Mutex
+	buckets []*rate.Limiter
+	running []int
+}
+
+func NewRateLimiter(rules []RateLimitRule) (*RateLimiter, error) {
+	clients, err := lru.New[string, *clientRateLimits](rateLimitedClients)
+	if err != nil {
+		return nil, err
+	}
+	l := &RateLimiter{clients: clients}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("rate limit rule for %v has no name", rule.Methods)
+		}
+		if rule.Rate < 0 || rule.Burst < 0 || rule.Cost < 0 || rule.MaxConcurrent < 0 {
+			return nil, fmt.Errorf("rate limit rule %s has negative values", rule.Name)
+		}
+		if rule.Cost == 0 {
+			rule.Cost = 1
+		}
+		if rule.Burst == 0 {
+			rule.Burst = int(rule.Rate)
+		}
+		if rule.Rate > 0 {
+			maxCost := rule.Cost
+			for _, cost := range rule.Costs {
+				if cost > maxCost {
+					maxCost = cost
+				}
+			}
+			if rule.Burst < maxCost {
+				return nil, fmt.Errorf("rate limit rule %s has a burst of %d, smaller than the cost %d of a call", rule.Name, rule.Burst, maxCost)
+			}
+		}
+		l.rules = append(l.rules, rule)
+		l.limitedCounters = append(l.limitedCounters, newRateLimitedCounter(rule.Name))
+		l.concurrentGauges = append(l.concurrentGauges, newRateLimitRunningGauge(rule.Name))
+	}
+	return l, nil
+}
+
+func (l *RateLimiter) newClientRateLimits() *clientRateLimits {
+	c := &clientRateLimits{buckets: make([]*rate.Limiter, len(l.rules)), running: make([]int, len(l.rules))}
+	for i, rule := range l.rules {
+		if rule.Rate > 0 {
+			c.buckets[i] = rate.NewLimiter(rate.Limit(rule.Rate), rule.Burst)
+		}
+	}
+	return c
+}
+
+// acquire takes a call of method from the limits of client. When none of the limits is exceeded, the returned
+// release function must be called once the call is done.
+func (l *RateLimiter) acquire(client, method string) (release func(), err error) {
+	limits, ok := l.clients.Get(client)
+	if !ok {
+		limits = l.newClientRateLimits()
+		if previous, ok, _ := l.clients.PeekOrAdd(client, limits); ok {
+			limits = previous
+		}
+	}
+
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+	now := time.Now()
+	var matched []int
+	for i := range l.rules {
+		rule := &l.rules[i]
+		if !rule.matches(method) {
+			continue
+		}
+		if rule.MaxConcurrent > 0 && limits.running[i] >= rule.MaxConcurrent {
+			l.limitedCounters[i].Inc()
+			return nil, &rateLimitedError{method: method, group: rule.Name}
+		}
+		if bucket := limits.buckets[i]; bucket != nil && bucket.TokensAt(now) < float64(rule.cost(method)) {
+			l.limitedCounters[i].Inc()
+			return nil, &rateLimitedError{method: method, group: rule.Name}
+		}
+		matched = append(matched, i)
+	}
+	// only take from the limits once the call is allowed by all of them
+	for _, i := range matched {
+		if bucket := limits.buckets[i]; bucket != nil {
+			bucket.AllowN(now, l.rules[i].cost(method))
+		}
+		limits.running[i]++
+		l.concurrentGauges[i].Inc()
+	}
+	return func() {
+		limits.mu.Lock()
+		defer limits.mu.Unlock()
+		for _, i := range matched {
+			limits.running[i]--
+			l.concurrentGauges[i].Dec()
+		}
+	}, nil
+}
+
+type jwtSubjectKey struct{}
+
+// rateLimitedClient returns the identity the rate limits of a call are counted against: the subject of the JWT the
+// request was authenticated with, or the IP address of the client.
+func rateLimitedClient(ctx context.Context, remoteAddr string) string {
+	if subject, ok := ctx.Value(jwtSubjectKey{}).(string); ok && subject != "" {
+		return "jwt:" + subject
+	}
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+	return remoteAddr
+}
@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitedCalls(t *testing.T) {
+	logger := log.New()
+	for name, dial := range map[string]func(server *Server) (*Client, func(), error){
+		"http": func(server *Server) (*Client, func(), error) {
+			ts := httptest.NewServer(server)
+			client, err := DialHTTP(ts.URL, logger)
+			return client, ts.Close, err
+		},
+		"ws": func(server *Server) (*Client, func(), error) {
+			ts := httptest.NewServer(server.WebsocketHandler([]string{"*"}, nil, false, logger))
+			client, err := DialWebsocket(context.Background(), "ws:"+strings.TrimPrefix(ts.URL, "http:"), "", logger)
+			return client, ts.Close, err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := newTestServer(logger)
+			defer server.Stop()
+			rateLimiter, err := NewRateLimiter([]RateLimitRule{{Name: "echo", Methods: []string{"test_echo*"}, Rate: 0.001, Burst: 3, Costs: map[string]int{"test_echoWithCtx": 2}}})
+			require.NoError(t, err)
+			server.SetRateLimiter(rateLimiter)
+			client, closeServer, err := dial(server)
+			defer closeServer()
+			require.NoError(t, err)
+			defer client.Close()
+
+			var resp echoResult
+			require.NoError(t, client.Call(&resp, "test_echo", "hello", 10, &echoArgs{"world"}))
+			require.NoError(t, client.Call(&resp, "test_echoWithCtx", "hello", 10, &echoArgs{"world"}))
+			err = client.Call(&resp, "test_echo", "hello", 10, &echoArgs{"world"})
+			require.Error(t, err)
+			rpcErr, ok := err.(Error)
+			require.True(t, ok, "client did not return rpc.Error, got %#v", err)
+			require.Equal(t, -32005, rpcErr.ErrorCode())
+
+			// the methods outside of the group are not limited
+			var rets string
+			require.NoError(t, client.Call(&rets, "test_rets"))
+		})
+	}
+}
+
+func TestRateLimiterConcurrency(t *testing.T) {
+	rateLimiter, err := NewRateLimiter([]RateLimitRule{
+		{Name: "trace", Methods: []string{"debug_trace*", "trace_filter"}, MaxConcurrent: 1},
+		{Name: "all", Methods: []string{"*"}, MaxConcurrent: 2},
+	})
+	require.NoError(t, err)
+
+	release, err := rateLimiter.acquire("10.0.0.1", "debug_traceTransaction")
+	require.NoError(t, err)
+	_, err = rateLimiter.acquire("10.0.0.1", "trace_filter")
+	require.Error(t, err)
+	// another client has its own limits
+	releaseOther, err := rateLimiter.acquire("10.0.0.2", "trace_filter")
+	require.NoError(t, err)
+	releaseOther()
+
+	releaseCall, err := rateLimiter.acquire("10.0.0.1", "eth_call")
+	require.NoError(t, err)
+	// the limit of all the methods is reached, a rejected call does not take from the trace group
+	_, err = rateLimiter.acquire("10.0.0.1", "eth_chainId")
+	require.Error(t, err)
+	releaseCall()
+	release()
+
+	release, err = rateLimiter.acquire("10.0.0.1", "trace_filter")
+	require.NoError(t, err)
+	release()
+
+	_, err = NewRateLimiter([]RateLimitRule{{Name: "logs", Methods: []string{"eth_getLogs"}, Rate: 10, Cost: 20}})
+	require.Error(t, err)
+}
+
+func TestRateLimitedClient(t *testing.T) {
+	require.Equal(t, "10.0.0.1", rateLimitedClient(context.Background(), "10.0.0.1:3456"))
+	require.Equal(t, "::1", rateLimitedClient(context.Background(), "[::1]:3456"))
+	ctx := context.WithValue(context.Background(), jwtSubjectKey{}, "alice")
+	require.Equal(t, "jwt:alice", rateLimitedClient(ctx, "10.0.0.1:3456"))
+}
@@ -47,6 +47,7 @@ const (
 type Server struct {
 	services        serviceRegistry
 	methodAllowList AllowList
+	rateLimiter     *RateLimiter
 	idgen           func() ID
 	run             int32
 	codecs          mapset.Set // mapset.Set[ServerCodec] requires go 1.20
@@ -75,6 +76,11 @@ func (s *Server) SetAllowList(allowList AllowList) {
 	s.methodAllowList = allowList
 }
 
+// SetRateLimiter sets the limits of the calls each client can make to this server
+func (s *Server) SetRateLimiter(rateLimiter *RateLimiter) {
+	s.rateLimiter = rateLimiter
+}
+
 // SetBatchLimit sets limit of number of requests in a batch
 func (s *Server) SetBatchLimit(limit int) {
 	s.batchLimit = limit
@@ -105,7 +111,7 @@ func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
 	s.codecs.Add(codec)
 	defer s.codecs.Remove(codec)
 
-	c := initClient(codec, s.idgen, &s.services, s.logger)
+	c := initClient(codec, s.idgen, &s.services, s.rateLimiter, s.logger)
 	<-codec.closed()
 	c.Close()
 }
@@ -119,7 +125,7 @@ func (s *Server) serveSingleRequest(ctx context.Context, codec ServerCodec, stre
 		return
 	}
 
-	h := newHandler(ctx, codec, s.idgen, &s.services, s.methodAllowList, s.batchConcurrency, s.traceRequests, s.logger, s.rpcSlowLogThreshold)
+	h := newHandler(ctx, codec, s.idgen, &s.services, s.methodAllowList, s.rateLimiter, s.batchConcurrency, s.traceRequests, s.logger, s.rpcSlowLogThreshold)
 	h.allowSubscribe = false
 	defer h.close(io.EOF, nil)
 
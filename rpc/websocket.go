@@ -255,6 +255,8 @@ func NewWebsocketCodec(conn *websocket.Conn) ServerCodec {
 		conn:      conn,
 		pingReset: make(chan struct{}, 1),
 	}
+	// the rate limits of the server are counted per remote address
+	wc.remote = conn.RemoteAddr().String()
 	wc.wg.Add(1)
 	go wc.pingLoop()
 	return wc
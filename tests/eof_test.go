@@ -0,0 +1,20 @@
+package tests
+
+import (
+	"os"
+	"testing"
+)
+
+func TestEOF(t *testing.T) {
+	// the vectors are checked in, so that they always run
+	if _, err := os.Stat(eofTestDir); err != nil {
+		t.Fatal(err)
+	}
+	tm := new(testMatcher)
+
+	tm.walk(t, eofTestDir, func(t *testing.T, name string, test *EOFTest) {
+		if err := tm.checkFailure(t, test.Run("Osaka")); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"fmt"
+
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/ledgerwatch/erigon/core/vm"
+)
+
+// EOFTest checks the validation of EOF containers.
+type EOFTest struct {
+	Vectors map[string]eofVector `json:"vectors"`
+}
+
+type eofVector struct {
+	Code          hexutility.Bytes     `json:"code"`
+	ContainerKind string               `json:"containerKind"`
+	Results       map[string]eofResult `json:"results"`
+}
+
+type eofResult struct {
+	Result    bool   `json:"result"`
+	Exception string `json:"exception"`
+}
+
+// Run validates the containers of the test against the expected results of the given fork, which every vector
+// has to have.
+func (t *EOFTest) Run(fork string) error {
+	if len(t.Vectors) == 0 {
+		return fmt.Errorf("no vectors")
+	}
+	names := maps.Keys(t.Vectors)
+	slices.Sort(names)
+	for _, name := range names {
+		vector := t.Vectors[name]
+		want, ok := vector.Results[fork]
+		if !ok {
+			return fmt.Errorf("vector %s: no result for %s", name, fork)
+		}
+		_, err := vm.ParseAndValidateContainer(vector.Code, vector.ContainerKind == "INITCODE")
+		if want.Result && err != nil {
+			return fmt.Errorf("vector %s: unexpected error: %w", name, err)
+		}
+		if !want.Result && err == nil {
+			return fmt.Errorf("vector %s: expected error %s", name, want.Exception)
+		}
+	}
+	return nil
+}
@@ -40,6 +40,7 @@ var (
 	transactionTestDir = filepath.Join(baseDir, "TransactionTests")
 	rlpTestDir         = filepath.Join(baseDir, "RLPTests")
 	difficultyTestDir  = filepath.Join(baseDir, "DifficultyTests")
+	eofTestDir         = filepath.Join(".", "eof-tests") // checked in, in the format of the EOFTests of the tests submodule
 )
 
 func readJSON(reader io.Reader, value interface{}) error {
@@ -169,6 +169,8 @@ type Account struct {
 	Balance   **hexutil.Big                   `json:"balance"`
 	State     *map[libcommon.Hash]uint256.Int `json:"state"`
 	StateDiff *map[libcommon.Hash]uint256.Int `json:"stateDiff"`
+	// MovePrecompileTo relocates the precompiled contract at this address, only honoured by eth_simulateV1
+	MovePrecompileTo *libcommon.Address `json:"movePrecompileToAddress"`
 }
 
 func NewRevertError(result *core.ExecutionResult) *RevertError {
@@ -405,7 +407,8 @@ type RPCTransaction struct {
 	R                *hexutil.Big       `json:"r"`
 	S                *hexutil.Big       `json:"s"`
 
-	BlobVersionedHashes []libcommon.Hash `json:"blobVersionedHashes,omitempty"`
+	BlobVersionedHashes []libcommon.Hash      `json:"blobVersionedHashes,omitempty"`
+	Authorizations      []types.Authorization `json:"authorizationList,omitempty"`
 }
 
 // newRPCTransaction returns a transaction that will serialize to the RPC
@@ -468,6 +471,18 @@ func newRPCTransaction(tx types.Transaction, blockHash libcommon.Hash, blockNumb
 		result.GasPrice = computeGasPrice(tx, blockHash, baseFee)
 		result.MaxFeePerBlobGas = (*hexutil.Big)(t.MaxFeePerBlobGas.ToBig())
 		result.BlobVersionedHashes = t.GetBlobHashes()
+	case *types.SetCodeTransaction:
+		chainId.Set(t.ChainID)
+		result.ChainID = (*hexutil.Big)(chainId.ToBig())
+		result.Tip = (*hexutil.Big)(t.Tip.ToBig())
+		result.FeeCap = (*hexutil.Big)(t.FeeCap.ToBig())
+		result.V = (*hexutil.Big)(t.V.ToBig())
+		result.R = (*hexutil.Big)(t.R.ToBig())
+		result.S = (*hexutil.Big)(t.S.ToBig())
+		result.Accesses = &t.AccessList
+		// if the transaction has been mined, compute the effective gas price
+		result.GasPrice = computeGasPrice(tx, blockHash, baseFee)
+		result.Authorizations = t.Authorizations
 	}
 	signer := types.LatestSignerForChainID(chainId.ToBig())
 	var err error
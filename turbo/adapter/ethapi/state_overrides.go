@@ -8,6 +8,8 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
 	"github.com/ledgerwatch/erigon/core/state"
+	"github.com/ledgerwatch/erigon/core/tracing"
+	"github.com/ledgerwatch/erigon/core/vm"
 )
 
 type StateOverrides map[libcommon.Address]Account
@@ -29,7 +31,7 @@ func (overrides *StateOverrides) Override(state *state.IntraBlockState) error {
 			if overflow {
 				return fmt.Errorf("account.Balance higher than 2^256-1")
 			}
-			state.SetBalance(addr, balance)
+			state.SetBalance(addr, balance, tracing.BalanceChangeUnspecified)
 		}
 		if account.State != nil && account.StateDiff != nil {
 			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
@@ -49,3 +51,31 @@ func (overrides *StateOverrides) Override(state *state.IntraBlockState) error {
 
 	return nil
 }
+
+// OverridePrecompiles moves the precompiled contracts requested via MovePrecompileTo to their new addresses.
+// The original address then behaves like a regular account, so its code can be overridden as well.
+func (overrides *StateOverrides) OverridePrecompiles(precompiles map[libcommon.Address]vm.PrecompiledContract) error {
+	moved := make(map[libcommon.Address]vm.PrecompiledContract)
+	for addr, account := range *overrides {
+		if account.MovePrecompileTo == nil {
+			continue
+		}
+		p, ok := precompiles[addr]
+		if !ok {
+			return fmt.Errorf("account %s is not a precompile", addr.Hex())
+		}
+		if _, ok := moved[*account.MovePrecompileTo]; ok {
+			return fmt.Errorf("account %s is already overridden", account.MovePrecompileTo.Hex())
+		}
+		moved[*account.MovePrecompileTo] = p
+	}
+	for addr, account := range *overrides {
+		if account.MovePrecompileTo != nil {
+			delete(precompiles, addr)
+		}
+	}
+	for addr, p := range moved {
+		precompiles[addr] = p
+	}
+	return nil
+}
@@ -26,6 +26,12 @@ var DefaultFlags = []cli.Flag{
 	&utils.TxPoolGlobalQueueFlag,
 	&utils.TxPoolLifetimeFlag,
 	&utils.TxPoolTraceSendersFlag,
+	&utils.TxPoolOrderingFlag,
+	&utils.TxPoolPrioritySendersFlag,
+	&utils.TxPoolMaxBestPerSenderFlag,
+	&utils.TxPoolPrivateTxLifetimeFlag,
+	&utils.TxPoolJournalFlag,
+	&utils.TxPoolRebroadcastEveryFlag,
 	&utils.TxPoolCommitEveryFlag,
 	&PruneFlag,
 	&PruneHistoryFlag,
@@ -72,12 +78,19 @@ var DefaultFlags = []cli.Flag{
 	&utils.RpcStreamingDisableFlag,
 	&utils.DBReadConcurrencyFlag,
 	&utils.RpcAccessListFlag,
+	&utils.RpcRateLimitFlag,
 	&utils.RpcTraceCompatFlag,
 	&utils.RpcGasCapFlag,
 	&utils.RpcBatchLimit,
 	&utils.RpcReturnDataLimit,
 	&utils.AllowUnprotectedTxs,
 	&utils.RpcMaxGetProofRewindBlockCount,
+	&utils.RpcLogsMaxBlockRange,
+	&utils.RpcLogsMaxResults,
+	&utils.RpcTraceWorkers,
+	&utils.RpcTracerJsTimeBudget,
+	&utils.RpcTracerJsStepBudget,
+	&utils.RpcTracerJsHeapBudget,
 	&utils.RPCGlobalTxFeeCapFlag,
 	&utils.TxpoolApiAddrFlag,
 	&utils.TraceMaxtracesFlag,
@@ -168,6 +181,10 @@ var DefaultFlags = []cli.Flag{
 	&utils.SilkwormRpcDaemonFlag,
 	&utils.SilkwormSentryFlag,
 
+	&utils.LiveTracerFlag,
+	&utils.LiveTracerConfigFlag,
+	&utils.LiveTracerOutputFlag,
+
 	&utils.BeaconAPIFlag,
 	&utils.BeaconApiAddrFlag,
 	&utils.BeaconApiAllowMethodsFlag,
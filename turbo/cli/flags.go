@@ -461,6 +461,7 @@ func setEmbeddedRpcDaemon(ctx *cli.Context, cfg *nodecfg.Config, logger log.Logg
 		RpcStreamingDisable:         ctx.Bool(utils.RpcStreamingDisableFlag.Name),
 		DBReadConcurrency:           ctx.Int(utils.DBReadConcurrencyFlag.Name),
 		RpcAllowListFilePath:        ctx.String(utils.RpcAccessListFlag.Name),
+		RpcRateLimitFilePath:        ctx.String(utils.RpcRateLimitFlag.Name),
 		Gascap:                      ctx.Uint64(utils.RpcGasCapFlag.Name),
 		MaxTraces:                   ctx.Uint64(utils.TraceMaxtracesFlag.Name),
 		TraceCompatibility:          ctx.Bool(utils.RpcTraceCompatFlag.Name),
@@ -468,6 +469,11 @@ func setEmbeddedRpcDaemon(ctx *cli.Context, cfg *nodecfg.Config, logger log.Logg
 		ReturnDataLimit:             ctx.Int(utils.RpcReturnDataLimit.Name),
 		AllowUnprotectedTxs:         ctx.Bool(utils.AllowUnprotectedTxs.Name),
 		MaxGetProofRewindBlockCount: ctx.Int(utils.RpcMaxGetProofRewindBlockCount.Name),
+		LogsMaxBlockRange:           ctx.Uint64(utils.RpcLogsMaxBlockRange.Name),
+		LogsMaxResults:              ctx.Int(utils.RpcLogsMaxResults.Name),
+		TraceWorkers:                ctx.Int(utils.RpcTraceWorkers.Name),
+		TracerJsTimeBudget:          ctx.Duration(utils.RpcTracerJsTimeBudget.Name),
+		TracerJsStepBudget:          ctx.Uint64(utils.RpcTracerJsStepBudget.Name),
 
 		OtsMaxPageSize: ctx.Uint64(utils.OtsSearchMaxCapFlag.Name),
 
@@ -502,6 +508,11 @@ func setEmbeddedRpcDaemon(ctx *cli.Context, cfg *nodecfg.Config, logger log.Logg
 		utils.Fatalf("Invalid state.cache value provided")
 	}
 
+	err = c.TracerJsHeapBudget.UnmarshalText([]byte(ctx.String(utils.RpcTracerJsHeapBudget.Name)))
+	if err != nil {
+		utils.Fatalf("Invalid %s value provided", utils.RpcTracerJsHeapBudget.Name)
+	}
+
 	/*
 		rootCmd.PersistentFlags().BoolVar(&cfg.GRPCServerEnabled, "grpc", false, "Enable GRPC server")
 		rootCmd.PersistentFlags().StringVar(&cfg.GRPCListenAddress, "grpc.addr", node.DefaultGRPCHost, "GRPC server listening interface")
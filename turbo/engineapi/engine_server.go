@@ -51,6 +51,7 @@ type EngineServer struct {
 	proposing        bool
 	test             bool
 	executionService execution.ExecutionClient
+	blobsGetter      BlobsGetter
 
 	chainRW eth1_chain_reader.ChainReaderWriterEth1
 	ctx     context.Context
@@ -62,7 +63,7 @@ const fcuTimeout = 1000 // according to mathematics: 1000 millisecods = 1 second
 
 func NewEngineServer(ctx context.Context, logger log.Logger, config *chain.Config, executionService execution.ExecutionClient,
 	hd *headerdownload.HeaderDownload,
-	blockDownloader *engine_block_downloader.EngineBlockDownloader, test bool, proposing bool) *EngineServer {
+	blockDownloader *engine_block_downloader.EngineBlockDownloader, blobsGetter BlobsGetter, test bool, proposing bool) *EngineServer {
 	chainRW := eth1_chain_reader.NewChainReaderEth1(ctx, config, executionService, fcuTimeout)
 	return &EngineServer{
 		ctx:              ctx,
@@ -70,6 +71,7 @@ func NewEngineServer(ctx context.Context, logger log.Logger, config *chain.Confi
 		config:           config,
 		executionService: executionService,
 		blockDownloader:  blockDownloader,
+		blobsGetter:      blobsGetter,
 		chainRW:          chainRW,
 		proposing:        proposing,
 		hd:               hd,
@@ -79,7 +81,7 @@ func NewEngineServer(ctx context.Context, logger log.Logger, config *chain.Confi
 func (e *EngineServer) Start(httpConfig *httpcfg.HttpCfg, db kv.RoDB, blockReader services.FullBlockReader,
 	filters *rpchelper.Filters, stateCache kvcache.Cache, agg *libstate.AggregatorV3, engineReader consensus.EngineReader,
 	eth rpchelper.ApiBackend, txPool txpool.TxpoolClient, mining txpool.MiningClient) {
-	base := jsonrpc.NewBaseApi(filters, stateCache, blockReader, agg, httpConfig.WithDatadir, httpConfig.EvmCallTimeout, engineReader, httpConfig.Dirs)
+	base := jsonrpc.NewBaseApi(filters, stateCache, blockReader, agg, httpConfig.WithDatadir, httpConfig.EvmCallTimeout, httpConfig.LogsMaxBlockRange, httpConfig.LogsMaxResults, engineReader, httpConfig.Dirs)
 
 	ethImpl := jsonrpc.NewEthAPI(base, db, eth, txPool, mining, httpConfig.Gascap, httpConfig.ReturnDataLimit, httpConfig.AllowUnprotectedTxs, httpConfig.MaxGetProofRewindBlockCount, e.logger)
 
@@ -668,6 +670,27 @@ func (e *EngineServer) GetPayloadBodiesByRangeV1(ctx context.Context, start, cou
 	return e.getPayloadBodiesByRange(ctx, uint64(start), uint64(count), clparams.CapellaVersion)
 }
 
+// Returns the blobs, and their proofs, of the given versioned hashes which are held in the transaction pool, null is
+// returned in place of the unknown ones.
+// See https://github.com/ethereum/execution-apis/blob/main/src/engine/cancun.md#engine_getblobsv1
+func (e *EngineServer) GetBlobsV1(ctx context.Context, blobHashes []libcommon.Hash) ([]*engine_types.BlobAndProofV1, error) {
+	if len(blobHashes) > 128 {
+		return nil, &engine_helpers.TooLargeRequestErr
+	}
+	res := make([]*engine_types.BlobAndProofV1, len(blobHashes))
+	if e.blobsGetter == nil {
+		return res, nil
+	}
+	blobs, proofs := e.blobsGetter.GetBlobs(blobHashes)
+	for i := range blobs {
+		if blobs[i] == nil {
+			continue
+		}
+		res[i] = &engine_types.BlobAndProofV1{Blob: blobs[i], Proof: proofs[i][:]}
+	}
+	return res, nil
+}
+
 var ourCapabilities = []string{
 	"engine_forkchoiceUpdatedV1",
 	"engine_forkchoiceUpdatedV2",
@@ -681,6 +704,7 @@ var ourCapabilities = []string{
 	"engine_exchangeTransitionConfigurationV1",
 	"engine_getPayloadBodiesByHashV1",
 	"engine_getPayloadBodiesByRangeV1",
+	"engine_getBlobsV1",
 }
 
 func (e *EngineServer) ExchangeCapabilities(fromCl []string) []string {
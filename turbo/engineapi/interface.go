@@ -2,6 +2,8 @@ package engineapi
 
 import (
 	"context"
+
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	"github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
@@ -22,4 +24,10 @@ type EngineAPI interface {
 	ExchangeTransitionConfigurationV1(ctx context.Context, transitionConfiguration *engine_types.TransitionConfiguration) (*engine_types.TransitionConfiguration, error)
 	GetPayloadBodiesByHashV1(ctx context.Context, hashes []common.Hash) ([]*engine_types.ExecutionPayloadBodyV1, error)
 	GetPayloadBodiesByRangeV1(ctx context.Context, start, count hexutil.Uint64) ([]*engine_types.ExecutionPayloadBodyV1, error)
+	GetBlobsV1(ctx context.Context, blobHashes []common.Hash) ([]*engine_types.BlobAndProofV1, error)
+}
+
+// BlobsGetter looks up blobs, and their proofs, by versioned hash. It is implemented by the transaction pool.
+type BlobsGetter interface {
+	GetBlobs(blobHashes []common.Hash) ([][]byte, []gokzg4844.KZGProof)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/gointerfaces/execution"
 	types2 "github.com/ledgerwatch/erigon-lib/gointerfaces/types"
 	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_types"
 	"github.com/ledgerwatch/erigon/turbo/execution/eth1/eth1_utils"
 	"github.com/ledgerwatch/log/v3"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -320,3 +321,37 @@ func (c ChainReaderWriterEth1) GetForkchoice() (headHash, finalizedHash, safeHas
 	return gointerfaces.ConvertH256ToHash(resp.HeadBlockHash), gointerfaces.ConvertH256ToHash(resp.FinalizedBlockHash),
 		gointerfaces.ConvertH256ToHash(resp.SafeBlockHash), nil
 }
+
+func (c ChainReaderWriterEth1) AssembleBlock(baseHash libcommon.Hash, attributes *engine_types.PayloadAttributes) (id uint64, busy bool, err error) {
+	request := &execution.AssembleBlockRequest{
+		Timestamp:             uint64(attributes.Timestamp),
+		PrevRandao:            gointerfaces.ConvertHashToH256(attributes.PrevRandao),
+		SuggestedFeeRecipient: gointerfaces.ConvertAddressToH160(attributes.SuggestedFeeRecipient),
+		Withdrawals:           eth1_utils.ConvertWithdrawalsToRpc(attributes.Withdrawals),
+		ParentHash:            gointerfaces.ConvertHashToH256(baseHash),
+	}
+	if attributes.ParentBeaconBlockRoot != nil {
+		request.ParentBeaconBlockRoot = gointerfaces.ConvertHashToH256(*attributes.ParentBeaconBlockRoot)
+	}
+	resp, err := c.executionModule.AssembleBlock(c.ctx, request)
+	if err != nil {
+		return 0, false, err
+	}
+	return resp.Id, resp.Busy, nil
+}
+
+func (c ChainReaderWriterEth1) GetAssembledBlock(id uint64) (*types2.ExecutionPayload, *types2.BlobsBundleV1, *big.Int, bool, error) {
+	resp, err := c.executionModule.GetAssembledBlock(c.ctx, &execution.GetAssembledBlockRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	if resp.Busy {
+		return nil, nil, nil, true, nil
+	}
+	if resp.Data == nil {
+		return nil, nil, nil, false, fmt.Errorf("no assembled block for payload id %d", id)
+	}
+	return resp.Data.ExecutionPayload, resp.Data.BlobsBundle, eth1_utils.ConvertBigIntFromRpc(resp.Data.BlockValue), false, nil
+}
@@ -12,10 +12,12 @@ import (
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/order"
 	"github.com/ledgerwatch/erigon-lib/kv/rawdbv3"
+	"golang.org/x/sync/semaphore"
 
 	"github.com/ledgerwatch/erigon/common/changeset"
 	"github.com/ledgerwatch/erigon/core/rawdb"
 	"github.com/ledgerwatch/erigon/core/state"
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/types/accounts"
 	"github.com/ledgerwatch/erigon/eth/stagedsync/stages"
 	"github.com/ledgerwatch/erigon/eth/tracers"
@@ -42,21 +44,28 @@ type PrivateDebugAPI interface {
 	AccountAt(ctx context.Context, blockHash common.Hash, txIndex uint64, account common.Address) (*AccountResult, error)
 	GetRawHeader(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (hexutility.Bytes, error)
 	GetRawBlock(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (hexutility.Bytes, error)
+	GetBlockAccessList(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.BlockAccessList, error)
+	ValidateBlockAccessList(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, accessList types.BlockAccessList) (*BlockAccessListValidation, error)
 }
 
 // PrivateDebugAPIImpl is implementation of the PrivateDebugAPI interface based on remote Db access
 type PrivateDebugAPIImpl struct {
 	*BaseAPI
-	db     kv.RoDB
-	GasCap uint64
+	db           kv.RoDB
+	GasCap       uint64
+	traceWorkers int // number of workers tracing the transactions of a block, 1 or less traces them sequentially
+	// traceWorkerSlots bounds the workers of all the requests together, each worker holds a database transaction
+	traceWorkerSlots *semaphore.Weighted
 }
 
 // NewPrivateDebugAPI returns PrivateDebugAPIImpl instance
-func NewPrivateDebugAPI(base *BaseAPI, db kv.RoDB, gascap uint64) *PrivateDebugAPIImpl {
+func NewPrivateDebugAPI(base *BaseAPI, db kv.RoDB, gascap uint64, traceWorkers int) *PrivateDebugAPIImpl {
 	return &PrivateDebugAPIImpl{
-		BaseAPI: base,
-		db:      db,
-		GasCap:  gascap,
+		BaseAPI:          base,
+		db:               db,
+		GasCap:           gascap,
+		traceWorkers:     traceWorkers,
+		traceWorkerSlots: semaphore.NewWeighted(int64(traceWorkers)),
 	}
 }
 
@@ -403,3 +412,58 @@ func (api *PrivateDebugAPIImpl) GetRawBlock(ctx context.Context, blockNrOrHash r
 	}
 	return rlp.EncodeToBytes(block)
 }
+
+// GetBlockAccessList implements debug_getBlockAccessList. Returns the accounts and storage slots read and written by each transaction of the block.
+func (api *PrivateDebugAPIImpl) GetBlockAccessList(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.BlockAccessList, error) {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	return api.blockAccessList(ctx, tx, blockNrOrHash)
+}
+
+// BlockAccessListValidation is the result of debug_validateBlockAccessList.
+type BlockAccessListValidation struct {
+	Valid    bool   `json:"valid"`
+	Mismatch string `json:"mismatch,omitempty"` // first difference with the access list computed for the block
+}
+
+// ValidateBlockAccessList implements debug_validateBlockAccessList. Checks the access list of a block against the one computed by re-executing it.
+func (api *PrivateDebugAPIImpl) ValidateBlockAccessList(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, accessList types.BlockAccessList) (*BlockAccessListValidation, error) {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	computed, err := api.blockAccessList(ctx, tx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.VerifyBlockAccessList(computed, &accessList); err != nil {
+		return &BlockAccessListValidation{Mismatch: err.Error()}, nil
+	}
+	return &BlockAccessListValidation{Valid: true}, nil
+}
+
+func (api *PrivateDebugAPIImpl) blockAccessList(ctx context.Context, tx kv.Tx, blockNrOrHash rpc.BlockNumberOrHash) (*types.BlockAccessList, error) {
+	n, h, _, err := rpchelper.GetBlockNumber(blockNrOrHash, tx, api.filters)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.BaseAPI.checkPruneHistory(tx, n); err != nil {
+		return nil, err
+	}
+	block, err := api.blockWithSenders(tx, h, n)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block not found")
+	}
+	chainConfig, err := api.chainConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+	return transactions.ComputeBlockAccessList(ctx, api.engine(), block, chainConfig, api._blockReader, tx, api.historyV3(tx))
+}
@@ -51,9 +51,9 @@ func TestTraceBlockByNumber(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
 	agg := m.HistoryV3Components()
 	stateCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	baseApi := NewBaseApi(nil, stateCache, m.BlockReader, agg, false, rpccfg.DefaultEvmCallTimeout, m.Engine, m.Dirs)
+	baseApi := NewBaseApi(nil, stateCache, m.BlockReader, agg, false, rpccfg.DefaultEvmCallTimeout, 0, 0, m.Engine, m.Dirs)
 	ethApi := NewEthAPI(baseApi, m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, log.New())
-	api := NewPrivateDebugAPI(baseApi, m.DB, 0)
+	api := NewPrivateDebugAPI(baseApi, m.DB, 0, 1)
 	for _, tt := range debugTraceTransactionTests {
 		var buf bytes.Buffer
 		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
@@ -98,7 +98,7 @@ func TestTraceBlockByNumber(t *testing.T) {
 func TestTraceBlockByHash(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
 	ethApi := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, log.New())
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
 	for _, tt := range debugTraceTransactionTests {
 		var buf bytes.Buffer
 		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
@@ -127,9 +127,39 @@ func TestTraceBlockByHash(t *testing.T) {
 	}
 }
 
+// TestTraceBlockParallel checks that the blocks traced in parallel match the ones traced sequentially.
+func TestTraceBlockParallel(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	ethApi := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, log.New())
+	sequential := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
+	parallel := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 4)
+	head, err := ethApi.BlockNumber(m.Ctx)
+	require.NoError(t, err)
+	trace := func(api *PrivateDebugAPIImpl, blockNum rpc.BlockNumber, tracer string) string {
+		var buf bytes.Buffer
+		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
+		require.NoError(t, api.TraceBlockByNumber(m.Ctx, blockNum, &tracers.TraceConfig{Tracer: &tracer}, stream))
+		require.NoError(t, stream.Flush())
+		return buf.String()
+	}
+	for _, tracer := range []string{"callTracer", "prestateTracer"} {
+		for blockNum := rpc.BlockNumber(1); blockNum <= rpc.BlockNumber(head); blockNum++ {
+			want := trace(sequential, blockNum, tracer)
+			require.True(t, json.Valid([]byte(want)), want)
+			require.Equal(t, want, trace(parallel, blockNum, tracer), "%s of block %d", tracer, blockNum)
+		}
+	}
+	// the workers are all back, and the requests finding them taken by others trace sequentially
+	require.True(t, parallel.traceWorkerSlots.TryAcquire(4))
+	require.Equal(t, 0, parallel.acquireTraceWorkers(4))
+	require.Equal(t, trace(sequential, rpc.BlockNumber(head), "callTracer"), trace(parallel, rpc.BlockNumber(head), "callTracer"))
+	parallel.traceWorkerSlots.Release(3)
+	require.Equal(t, 3, parallel.acquireTraceWorkers(4))
+}
+
 func TestTraceTransaction(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
 	for _, tt := range debugTraceTransactionTests {
 		var buf bytes.Buffer
 		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
@@ -158,7 +188,7 @@ func TestTraceTransaction(t *testing.T) {
 
 func TestTraceTransactionNoRefund(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
 	for _, tt := range debugTraceTransactionNoRefundTests {
 		var buf bytes.Buffer
 		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
@@ -188,7 +218,7 @@ func TestTraceTransactionNoRefund(t *testing.T) {
 
 func TestStorageRangeAt(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
 	t.Run("invalid addr", func(t *testing.T) {
 		var block4 *types.Block
 		var err error
@@ -282,7 +312,7 @@ func TestStorageRangeAt(t *testing.T) {
 
 func TestAccountRange(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
 
 	t.Run("valid account", func(t *testing.T) {
 		addr := common.HexToAddress("0x537e697c7ab75a26f9ecf0ce810e3154dfcaaf55")
@@ -341,7 +371,7 @@ func TestAccountRange(t *testing.T) {
 
 func TestGetModifiedAccountsByNumber(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
 
 	t.Run("correct input", func(t *testing.T) {
 		n, n2 := rpc.BlockNumber(1), rpc.BlockNumber(2)
@@ -443,7 +473,7 @@ func TestMapTxNum2BlockNum(t *testing.T) {
 
 func TestAccountAt(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
 
 	var blockHash0, blockHash1, blockHash3, blockHash10, blockHash12 common.Hash
 	_ = m.DB.View(m.Ctx, func(tx kv.Tx) error {
@@ -503,3 +533,38 @@ func TestAccountAt(t *testing.T) {
 		require.Equal(0, int(results.Nonce))
 	})
 }
+
+func TestGetBlockAccessList(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	ethApi := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, log.New())
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 1)
+	for _, tt := range debugTraceTransactionTests {
+		txn, err := ethApi.GetTransactionByHash(m.Ctx, common.HexToHash(tt.txHash))
+		require.NoError(t, err)
+		blockNrOrHash := rpc.BlockNumberOrHashWithHash(*txn.BlockHash, true)
+		accessList, err := api.GetBlockAccessList(m.Ctx, blockNrOrHash)
+		require.NoError(t, err)
+		require.Equal(t, *txn.BlockHash, accessList.BlockHash)
+
+		txAccessList := accessList.Transactions[*txn.TransactionIndex]
+		require.Equal(t, txn.Hash, txAccessList.TxHash)
+		var sender *types.AccountAccess
+		for i := range txAccessList.Accounts {
+			if txAccessList.Accounts[i].Address == txn.From {
+				sender = &txAccessList.Accounts[i]
+			}
+		}
+		require.NotNil(t, sender, "sender of %s", tt.txHash)
+		require.NotNil(t, sender.Nonce)
+		require.Equal(t, uint64(txn.Nonce)+1, uint64(*sender.Nonce))
+
+		validation, err := api.ValidateBlockAccessList(m.Ctx, blockNrOrHash, *accessList)
+		require.NoError(t, err)
+		require.True(t, validation.Valid, validation.Mismatch)
+		*sender.Nonce++
+		validation, err = api.ValidateBlockAccessList(m.Ctx, blockNrOrHash, *accessList)
+		require.NoError(t, err)
+		require.False(t, validation.Valid)
+		require.Contains(t, validation.Mismatch, "nonce")
+	}
+}
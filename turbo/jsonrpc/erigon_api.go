@@ -2,7 +2,9 @@ package jsonrpc
 
 import (
 	"context"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 
 	"github.com/ledgerwatch/erigon-lib/common"
 
@@ -32,6 +34,7 @@ type ErigonAPI interface {
 	GetLogsByHash(ctx context.Context, hash common.Hash) ([][]*types.Log, error)
 	//GetLogsByNumber(ctx context.Context, number rpc.BlockNumber) ([][]*types.Log, error)
 	GetLogs(ctx context.Context, crit filters.FilterCriteria) (types.ErigonLogs, error)
+	GetLogsPage(ctx context.Context, crit filters.FilterCriteria, cursor *hexutility.Bytes, pageSize *hexutil.Uint64, stream *jsoniter.Stream) error
 	GetLatestLogs(ctx context.Context, crit filters.FilterCriteria, logOptions filters.LogFilterOptions) (types.ErigonLogs, error)
 	// Gets cannonical block receipt through hash. If the block is not cannonical returns error
 	GetBlockReceiptsByBlockHash(ctx context.Context, cannonicalBlockHash common.Hash) ([]map[string]interface{}, error)
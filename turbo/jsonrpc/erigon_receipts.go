@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	"github.com/RoaringBitmap/roaring"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/bitmapdb"
@@ -54,7 +56,6 @@ func (api *ErigonImpl) GetLogsByHash(ctx context.Context, hash common.Hash) ([][
 
 // GetLogs implements erigon_getLogs. Returns an array of logs matching a given filter object.
 func (api *ErigonImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria) (types.ErigonLogs, error) {
-	var begin, end uint64
 	erigonLogs := types.ErigonLogs{}
 
 	tx, beginErr := api.db.BeginRo(ctx)
@@ -63,19 +64,62 @@ func (api *ErigonImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria)
 	}
 	defer tx.Rollback()
 
+	begin, end, found, err := api.logsBlockRange(ctx, tx, crit)
+	if err != nil || !found {
+		return nil, err
+	}
+	if err = api.checkLogsBlockRange(begin, end); err != nil {
+		return nil, err
+	}
+
+	err = api.forEachBlockLogs(ctx, tx, begin, end, crit, func(blockNumber uint64, blockLogs types.Logs) (bool, error) {
+		if api.logsMaxResults > 0 && len(erigonLogs)+len(blockLogs) > api.logsMaxResults {
+			return false, newLogsMaxResultsError(begin, blockNumber, api.logsMaxResults)
+		}
+		header, err := api._blockReader.HeaderByNumber(ctx, tx, blockNumber)
+		if err != nil {
+			return false, err
+		}
+		if header == nil {
+			return false, fmt.Errorf("block header not found: %d", blockNumber)
+		}
+		for _, log := range blockLogs {
+			erigonLogs = append(erigonLogs, &types.ErigonLog{
+				Address:     log.Address,
+				Topics:      log.Topics,
+				Data:        log.Data,
+				BlockNumber: log.BlockNumber,
+				TxHash:      log.TxHash,
+				TxIndex:     log.TxIndex,
+				BlockHash:   log.BlockHash,
+				Index:       log.Index,
+				Removed:     log.Removed,
+				Timestamp:   header.Time,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return erigonLogs, nil
+}
+
+// logsBlockRange returns the range of blocks [begin, end] of the filter, found is false when the block hash of the
+// filter is unknown.
+func (api *ErigonImpl) logsBlockRange(ctx context.Context, tx kv.Tx, crit filters.FilterCriteria) (begin, end uint64, found bool, err error) {
 	if crit.BlockHash != nil {
 		header, err := api._blockReader.HeaderByHash(ctx, tx, *crit.BlockHash)
 		if header == nil {
-			return nil, err
+			return 0, 0, false, err
 		}
 		begin = header.Number.Uint64()
 		end = header.Number.Uint64()
-
 	} else {
 		// Convert the RPC block numbers into internal representations
 		latest, err := rpchelper.GetLatestBlockNumber(tx)
 		if err != nil {
-			return nil, err
+			return 0, 0, false, err
 		}
 
 		begin = 0
@@ -83,7 +127,7 @@ func (api *ErigonImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria)
 			if crit.FromBlock.Sign() >= 0 {
 				begin = crit.FromBlock.Uint64()
 			} else if !crit.FromBlock.IsInt64() || crit.FromBlock.Int64() != int64(rpc.LatestBlockNumber) {
-				return nil, fmt.Errorf("negative value for FromBlock: %v", crit.FromBlock)
+				return 0, 0, false, fmt.Errorf("negative value for FromBlock: %v", crit.FromBlock)
 			}
 		}
 		end = latest
@@ -91,108 +135,125 @@ func (api *ErigonImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria)
 			if crit.ToBlock.Sign() >= 0 {
 				end = crit.ToBlock.Uint64()
 			} else if !crit.ToBlock.IsInt64() || crit.ToBlock.Int64() != int64(rpc.LatestBlockNumber) {
-				return nil, fmt.Errorf("negative value for ToBlock: %v", crit.ToBlock)
+				return 0, 0, false, fmt.Errorf("negative value for ToBlock: %v", crit.ToBlock)
 			}
 		}
 	}
 	if end < begin {
-		return nil, fmt.Errorf("end (%d) < begin (%d)", end, begin)
+		return 0, 0, false, fmt.Errorf("end (%d) < begin (%d)", end, begin)
 	}
 	if end > roaring.MaxUint32 {
-		return nil, fmt.Errorf("end (%d) > MaxUint32", end)
-	}
-	blockNumbers := bitmapdb.NewBitmap()
-	defer bitmapdb.ReturnToPool(blockNumbers)
-	if err := applyFilters(blockNumbers, tx, begin, end, crit); err != nil {
-		return nil, err
-	}
-	if blockNumbers.IsEmpty() {
-		return erigonLogs, nil
+		return 0, 0, false, fmt.Errorf("end (%d) > MaxUint32", end)
 	}
+	return begin, end, true, nil
+}
 
-	addrMap := make(map[common.Address]struct{}, len(crit.Addresses))
-	for _, v := range crit.Addresses {
-		addrMap[v] = struct{}{}
+// defaultLogsPageSize is the number of logs of a page of erigon_getLogsPage when the client does not ask for a size.
+const defaultLogsPageSize = 1_000
+
+// GetLogsPage implements erigon_getLogsPage. Returns a page of the logs matching a given filter object, and the cursor
+// to pass back with the same filter to get the next page, or null once all the logs were returned. The size of the
+// pages is capped by --rpc.logs.maxresults and a page looks at most --rpc.logs.maxrange blocks, so a page can be empty
+// while the cursor is not null.
+func (api *ErigonImpl) GetLogsPage(ctx context.Context, crit filters.FilterCriteria, cursor *hexutility.Bytes, pageSize *hexutil.Uint64, stream *jsoniter.Stream) error {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return err
 	}
-	iter := blockNumbers.Iterator()
-	for iter.HasNext() {
-		if err := ctx.Err(); err != nil {
-			return nil, err
-		}
+	defer tx.Rollback()
 
-		blockNumber := uint64(iter.Next())
-		var logIndex uint
-		var txIndex uint
-		var blockLogs []*types.Log
-		it, err := tx.Prefix(kv.Log, hexutility.EncodeTs(blockNumber))
+	begin, end, found, err := api.logsBlockRange(ctx, tx, crit)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("block not found: %x", *crit.BlockHash)
+	}
+	var skip uint
+	if cursor != nil {
+		cursorBlock, cursorSkip, err := decodeLogsCursor(*cursor)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		for it.HasNext() {
-			k, v, err := it.Next()
-			if err != nil {
-				return erigonLogs, err
-			}
-			var logs types.Logs
-			if err := cbor.Unmarshal(&logs, bytes.NewReader(v)); err != nil {
-				return erigonLogs, fmt.Errorf("receipt unmarshal failed:  %w", err)
+		if cursorBlock < begin || cursorBlock > end {
+			return fmt.Errorf("cursor block %d is outside of the block range [%d, %d] of the filter", cursorBlock, begin, end)
+		}
+		begin, skip = cursorBlock, cursorSkip
+	}
+
+	limit := defaultLogsPageSize
+	if pageSize != nil && *pageSize > 0 && *pageSize < hexutil.Uint64(limit) {
+		limit = int(*pageSize)
+	}
+	if api.logsMaxResults > 0 && limit > api.logsMaxResults {
+		limit = api.logsMaxResults
+	}
+	pageEnd := end
+	if api.logsMaxBlockRange > 0 && end-begin >= api.logsMaxBlockRange {
+		pageEnd = begin + api.logsMaxBlockRange - 1
+	}
+
+	var json = jsoniter.ConfigCompatibleWithStandardLibrary
+	var next hexutility.Bytes
+	count := 0
+	stream.WriteObjectStart()
+	stream.WriteObjectField("logs")
+	stream.WriteArrayStart()
+	err = api.forEachBlockLogs(ctx, tx, begin, pageEnd, crit, func(blockNumber uint64, blockLogs types.Logs) (bool, error) {
+		for i, log := range blockLogs {
+			if blockNumber == begin && uint(i) < skip {
+				continue
 			}
-			for _, log := range logs {
-				log.Index = logIndex
-				logIndex++
+			if count == limit {
+				next = encodeLogsCursor(blockNumber, uint(i))
+				return false, nil
 			}
-			filtered := logs.Filter(addrMap, crit.Topics)
-			if len(filtered) == 0 {
-				continue
+			b, err := json.Marshal(log)
+			if err != nil {
+				return false, err
 			}
-			txIndex = uint(binary.BigEndian.Uint32(k[8:]))
-			for _, log := range filtered {
-				log.TxIndex = txIndex
+			if count > 0 {
+				stream.WriteMore()
 			}
-			blockLogs = append(blockLogs, filtered...)
-		}
-		if len(blockLogs) == 0 {
-			continue
+			stream.Write(b)
+			count++
 		}
+		return true, nil
+	})
+	stream.WriteArrayEnd()
+	if err != nil {
+		stream.WriteObjectEnd()
+		return err
+	}
+	if next == nil && pageEnd < end {
+		next = encodeLogsCursor(pageEnd+1, 0)
+	}
+	stream.WriteMore()
+	stream.WriteObjectField("cursor")
+	if next == nil {
+		stream.WriteNil()
+	} else {
+		stream.WriteString(next.String())
+	}
+	stream.WriteObjectEnd()
+	return stream.Flush()
+}
 
-		header, err := api._blockReader.HeaderByNumber(ctx, tx, blockNumber)
-		if err != nil {
-			return nil, err
-		}
-		if header == nil {
-			return nil, fmt.Errorf("block header not found: %d", blockNumber)
-		}
-		timestamp := header.Time
+// encodeLogsCursor encodes the position of the first log of the next page of erigon_getLogsPage: its block number
+// and the number of logs of the block matching the filter which were already returned. The index of the logs in
+// their block is not used, as history v3 does not know it.
+func encodeLogsCursor(blockNumber uint64, skip uint) hexutility.Bytes {
+	cursor := make(hexutility.Bytes, 12)
+	binary.BigEndian.PutUint64(cursor, blockNumber)
+	binary.BigEndian.PutUint32(cursor[8:], uint32(skip))
+	return cursor
+}
 
-		blockHash := header.Hash()
-		body, err := api._blockReader.BodyWithTransactions(ctx, tx, blockHash, blockNumber)
-		if err != nil {
-			return nil, err
-		}
-		if body == nil {
-			return nil, fmt.Errorf("block not found %d", blockNumber)
-		}
-		for _, log := range blockLogs {
-			erigonLog := &types.ErigonLog{}
-			erigonLog.BlockNumber = blockNumber
-			erigonLog.BlockHash = blockHash
-			if log.TxIndex == uint(len(body.Transactions)) {
-				erigonLog.TxHash = types.ComputeBorTxHash(blockNumber, blockHash)
-			} else {
-				erigonLog.TxHash = body.Transactions[log.TxIndex].Hash()
-			}
-			erigonLog.Timestamp = timestamp
-			erigonLog.Address = log.Address
-			erigonLog.Topics = log.Topics
-			erigonLog.Data = log.Data
-			erigonLog.Index = log.Index
-			erigonLog.Removed = log.Removed
-			erigonLog.TxIndex = log.TxIndex
-			erigonLogs = append(erigonLogs, erigonLog)
-		}
+func decodeLogsCursor(cursor hexutility.Bytes) (blockNumber uint64, skip uint, err error) {
+	if len(cursor) != 12 {
+		return 0, 0, fmt.Errorf("invalid cursor %s", cursor)
 	}
-
-	return erigonLogs, nil
+	return binary.BigEndian.Uint64(cursor), uint(binary.BigEndian.Uint32(cursor[8:])), nil
 }
 
 // GetLatestLogs implements erigon_getLatestLogs.
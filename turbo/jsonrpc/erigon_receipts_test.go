@@ -1,13 +1,17 @@
 package jsonrpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"math/big"
 	"testing"
 
 	"github.com/holiman/uint256"
+	jsoniter "github.com/json-iterator/go"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,9 +59,90 @@ func TestGetLogs(t *testing.T) {
 	}
 }
 
+func TestGetLogsLimits(t *testing.T) {
+	m := mockWithLogs(t)
+	base := newBaseApiForTest(m)
+	ethApi := NewEthAPI(base, m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, log.New())
+	erigonApi := NewErigonAPI(base, m.DB, nil)
+	crit := filters.FilterCriteria{FromBlock: big.NewInt(0)}
+	allLogs, err := ethApi.GetLogs(m.Ctx, crit)
+	require.NoError(t, err)
+	require.NotEmpty(t, allLogs)
+
+	base.logsMaxBlockRange = 4
+	_, err = ethApi.GetLogs(m.Ctx, crit)
+	var limitErr *logsLimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, -32005, limitErr.ErrorCode())
+	require.Equal(t, hexutil.Uint64(3), *limitErr.ToBlock)
+	require.Equal(t, hexutil.Uint64(4), limitErr.ResumeBlock)
+	_, err = erigonApi.GetLogs(m.Ctx, crit)
+	require.ErrorAs(t, err, &limitErr)
+
+	base.logsMaxBlockRange = 0
+	base.logsMaxResults = len(allLogs) - 1
+	_, err = ethApi.GetLogs(m.Ctx, crit)
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, hexutil.Uint64(allLogs[len(allLogs)-1].BlockNumber), limitErr.ResumeBlock)
+	_, err = erigonApi.GetLogs(m.Ctx, crit)
+	require.ErrorAs(t, err, &limitErr)
+
+	base.logsMaxResults = len(allLogs)
+	logs, err := ethApi.GetLogs(m.Ctx, crit)
+	require.NoError(t, err)
+	require.Equal(t, allLogs, logs)
+}
+
+func TestErigonGetLogsPage(t *testing.T) {
+	m := mockWithLogs(t)
+	base := newBaseApiForTest(m)
+	ethApi := NewEthAPI(base, m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, log.New())
+	api := NewErigonAPI(base, m.DB, nil)
+	crit := filters.FilterCriteria{FromBlock: big.NewInt(0)}
+	allLogs, err := ethApi.GetLogs(m.Ctx, crit)
+	require.NoError(t, err)
+
+	base.logsMaxBlockRange = 3
+	pageSize := hexutil.Uint64(3)
+	var logs types.Logs
+	var cursor *hexutility.Bytes
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 100)
+		var buf bytes.Buffer
+		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
+		require.NoError(t, api.GetLogsPage(m.Ctx, crit, cursor, &pageSize, stream))
+		var page struct {
+			Logs   types.Logs        `json:"logs"`
+			Cursor *hexutility.Bytes `json:"cursor"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &page))
+		require.LessOrEqual(t, len(page.Logs), int(pageSize))
+		logs = append(logs, page.Logs...)
+		if page.Cursor == nil {
+			break
+		}
+		cursor = page.Cursor
+	}
+	require.Equal(t, 30, len(allLogs))
+	require.Equal(t, len(allLogs), len(logs))
+	for i := range allLogs {
+		require.Equal(t, allLogs[i].BlockNumber, logs[i].BlockNumber)
+		require.Equal(t, allLogs[i].Index, logs[i].Index)
+		require.Equal(t, allLogs[i].TxHash, logs[i].TxHash)
+	}
+
+	var buf bytes.Buffer
+	stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
+	invalid := hexutility.Bytes{1}
+	require.Error(t, api.GetLogsPage(m.Ctx, crit, &invalid, nil, stream))
+}
+
 func TestErigonGetLatestLogs(t *testing.T) {
 	assert := assert.New(t)
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	if m.HistoryV3 {
+		t.Skip("erigon_getLatestLogs is not supported by Erigon3")
+	}
 	db := m.DB
 	api := NewErigonAPI(newBaseApiForTest(m), db, nil)
 	expectedLogs, _ := api.GetLogs(m.Ctx, filters.FilterCriteria{FromBlock: big.NewInt(0), ToBlock: big.NewInt(rpc.LatestBlockNumber.Int64())})
@@ -90,6 +175,9 @@ func TestErigonGetLatestLogs(t *testing.T) {
 func TestErigonGetLatestLogsIgnoreTopics(t *testing.T) {
 	assert := assert.New(t)
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	if m.HistoryV3 {
+		t.Skip("erigon_getLatestLogs is not supported by Erigon3")
+	}
 	db := m.DB
 	api := NewErigonAPI(newBaseApiForTest(m), db, nil)
 	expectedLogs, _ := api.GetLogs(m.Ctx, filters.FilterCriteria{FromBlock: big.NewInt(0), ToBlock: big.NewInt(rpc.LatestBlockNumber.Int64())})
@@ -219,3 +307,26 @@ func mockWithGenerator(t *testing.T, blocks int, generator func(int, *core.Block
 	}
 	return m
 }
+
+// mockWithLogs creates a chain where the block i has i transactions emitting two logs each.
+func mockWithLogs(t *testing.T) *mock.MockSentry {
+	logger := libcommon.HexToAddress("0x1000")
+	m := mock.MockWithGenesis(t, &types.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: types.GenesisAlloc{
+			testAddr: {Balance: big.NewInt(1000000)},
+			// LOG0 LOG0 STOP
+			logger: {Code: libcommon.FromHex("60006000a060006000a000"), Balance: new(big.Int)},
+		},
+	}, testKey, false)
+	signer := types.LatestSignerForChainID(nil)
+	chain, err := core.GenerateChain(m.ChainConfig, m.Genesis, m.Engine, m.DB, 5, func(i int, block *core.BlockGen) {
+		for j := 0; j <= i; j++ {
+			tx, _ := types.SignTx(types.NewTransaction(block.TxNonce(testAddr), logger, uint256.NewInt(0), 50_000, nil, nil), *signer, testKey)
+			block.AddTx(tx)
+		}
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.InsertChain(chain))
+	return m
+}
@@ -15,9 +15,13 @@ import (
 
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon-lib/common/length"
 	"github.com/ledgerwatch/erigon-lib/gointerfaces"
 	txpool_proto "github.com/ledgerwatch/erigon-lib/gointerfaces/txpool"
 	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/kv/dbutils"
+	"github.com/ledgerwatch/erigon-lib/kv/order"
+	"github.com/ledgerwatch/erigon-lib/kv/rawdbv3"
 	types2 "github.com/ledgerwatch/erigon-lib/types"
 
 	"github.com/ledgerwatch/erigon/core"
@@ -307,17 +311,11 @@ func (api *APIImpl) EstimateGas(ctx context.Context, argsOrNil *ethapi2.CallArgs
 	return hexutil.Uint64(hi), nil
 }
 
-// maxGetProofRewindBlockCount limits the number of blocks into the past that
-// GetProof will allow computing proofs.  Because we must rewind the hash state
-// and re-compute the state trie, the further back in time the request, the more
-// computationally intensive the operation becomes.  The staged sync code
-// assumes that if more than 100_000 blocks are skipped, that the entire trie
-// should be re-computed. Re-computing the entire trie will currently take ~15
-// minutes on mainnet.  The current limit has been chosen arbitrarily as
-// 'useful' without likely being overly computationally intense.
-
-// GetProof is partially implemented; no Storage proofs, and proofs must be for
-// blocks within maxGetProofRewindBlockCount blocks of the head.
+// GetProof implements eth_getProof. It works for any block whose state history is still kept. Erigon2 computes the
+// proof of an older block by unwinding the hashed state and the intermediate hashes of the trie in memory, from the
+// state history, up to the requested block: the cost grows with the number of state changes since that block.
+// Erigon3 keeps neither of them, so the trie is computed from the whole state of the block, read from history.
+// MaxGetProofRewindBlockCount can limit how far back the proofs go.
 func (api *APIImpl) GetProof(ctx context.Context, address libcommon.Address, storageKeys []libcommon.Hash, blockNrOrHash rpc.BlockNumberOrHash) (*accounts.AccProofResult, error) {
 
 	tx, err := api.db.BeginRo(ctx)
@@ -325,9 +323,6 @@ func (api *APIImpl) GetProof(ctx context.Context, address libcommon.Address, sto
 		return nil, err
 	}
 	defer tx.Rollback()
-	if api.historyV3(tx) {
-		return nil, fmt.Errorf("not supported by Erigon3")
-	}
 
 	blockNr, _, _, err := rpchelper.GetBlockNumber(blockNrOrHash, tx, api.filters)
 	if err != nil {
@@ -338,6 +333,9 @@ func (api *APIImpl) GetProof(ctx context.Context, address libcommon.Address, sto
 	if err != nil {
 		return nil, err
 	}
+	if header == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
 
 	latestBlock, err := rpchelper.GetLatestBlockNumber(tx)
 	if err != nil {
@@ -348,52 +346,67 @@ func (api *APIImpl) GetProof(ctx context.Context, address libcommon.Address, sto
 		// shouldn't happen, but check anyway
 		return nil, fmt.Errorf("block number is in the future latest=%d requested=%d", latestBlock, blockNr)
 	}
+	if blockNr < latestBlock {
+		if api.MaxGetProofRewindBlockCount >= 0 && latestBlock-blockNr > uint64(api.MaxGetProofRewindBlockCount) {
+			return nil, fmt.Errorf("requested block is too old, block must be within %d blocks of the head block number (currently %d)", uint64(api.MaxGetProofRewindBlockCount), latestBlock)
+		}
+		if err := api.checkPruneHistory(tx, blockNr); err != nil {
+			return nil, err
+		}
+	}
+
+	reader, err := rpchelper.CreateStateReader(ctx, tx, blockNrOrHash, 0, api.filters, api.stateCache, api.historyV3(tx), "")
+	if err != nil {
+		return nil, err
+	}
+	a, err := reader.ReadAccountData(address)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		a = &accounts.Account{}
+	}
 
 	rl := trie.NewRetainList(0)
 	var loader *trie.FlatDBTrieLoader
-	if blockNr < latestBlock {
-		if latestBlock-blockNr > uint64(api.MaxGetProofRewindBlockCount) {
-			return nil, fmt.Errorf("requested block is too old, block must be within %d blocks of the head block number (currently %d)", uint64(api.MaxGetProofRewindBlockCount), latestBlock)
+	trieTx := kv.Tx(tx)
+	if api.historyV3(tx) {
+		batch := membatchwithdb.NewMemoryBatch(tx, api.dirs.Tmp, api.logger)
+		defer batch.Rollback()
+		if err := writeHashedStateAsOf(ctx, tx.(kv.TemporalTx), blockNr, batch); err != nil {
+			return nil, err
 		}
+		loader = trie.NewFlatDBTrieLoader("eth_getProof", rl, nil, nil, false)
+		trieTx = batch
+	} else if blockNr < latestBlock {
 		batch := membatchwithdb.NewMemoryBatch(tx, api.dirs.Tmp, api.logger)
 		defer batch.Rollback()
 
 		unwindState := &stagedsync.UnwindState{UnwindPoint: blockNr}
 		stageState := &stagedsync.StageState{BlockNumber: latestBlock}
 
-		hashStageCfg := stagedsync.StageHashStateCfg(nil, api.dirs, api.historyV3(batch))
+		hashStageCfg := stagedsync.StageHashStateCfg(nil, api.dirs, false)
 		if err := stagedsync.UnwindHashStateStage(unwindState, stageState, batch, hashStageCfg, ctx, api.logger); err != nil {
 			return nil, err
 		}
 
-		interHashStageCfg := stagedsync.StageTrieCfg(nil, false, false, false, api.dirs.Tmp, api._blockReader, nil, api.historyV3(batch), api._agg)
+		interHashStageCfg := stagedsync.StageTrieCfg(nil, false, false, false, api.dirs.Tmp, api._blockReader, nil, false, api._agg)
 		loader, err = stagedsync.UnwindIntermediateHashesForTrieLoader("eth_getProof", rl, unwindState, stageState, batch, interHashStageCfg, nil, nil, ctx.Done(), api.logger)
 		if err != nil {
 			return nil, err
 		}
-		tx = batch
+		trieTx = batch
 	} else {
 		loader = trie.NewFlatDBTrieLoader("eth_getProof", rl, nil, nil, false)
 	}
 
-	reader, err := rpchelper.CreateStateReader(ctx, tx, blockNrOrHash, 0, api.filters, api.stateCache, api.historyV3(tx), "")
-	if err != nil {
-		return nil, err
-	}
-	a, err := reader.ReadAccountData(address)
-	if err != nil {
-		return nil, err
-	}
-	if a == nil {
-		a = &accounts.Account{}
-	}
 	pr, err := trie.NewProofRetainer(address, a, storageKeys, rl)
 	if err != nil {
 		return nil, err
 	}
 
 	loader.SetProofRetainer(pr)
-	root, err := loader.CalcTrieRoot(tx, nil)
+	root, err := loader.CalcTrieRoot(trieTx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -404,6 +417,81 @@ func (api *APIImpl) GetProof(ctx context.Context, address libcommon.Address, sto
 	return pr.ProofResult()
 }
 
+// maxHashedStateAsOfEntries bounds the accounts and storage slots writeHashedStateAsOf reads, as it goes through
+// the whole state.
+const maxHashedStateAsOfEntries = 1_000_000
+
+// writeHashedStateAsOf writes the state of the end of block blockNr, read from the state history, to the
+// HashedAccounts and HashedStorage tables of batch. It fails once more than maxHashedStateAsOfEntries accounts
+// and storage slots were written.
+func writeHashedStateAsOf(ctx context.Context, tx kv.TemporalTx, blockNr uint64, batch kv.RwTx) error {
+	entries := 0
+	tooLarge := fmt.Errorf("state has more than %d accounts and storage slots, eth_getProof is not supported on it with history v3", maxHashedStateAsOfEntries)
+	txNum, err := rawdbv3.TxNums.Min(tx, blockNr+1)
+	if err != nil {
+		return err
+	}
+	accs, err := tx.DomainRange(kv.AccountsDomain, nil, nil, txNum, order.Asc, kv.Unlim)
+	if err != nil {
+		return err
+	}
+	var acc accounts.Account
+	for accs.HasNext() {
+		addr, v, err := accs.Next()
+		if err != nil {
+			return err
+		}
+		if len(v) == 0 {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entries++; entries > maxHashedStateAsOfEntries {
+			return tooLarge
+		}
+		addrHash, err := libcommon.HashData(addr)
+		if err != nil {
+			return err
+		}
+		if err := batch.Put(kv.HashedAccounts, addrHash[:], v); err != nil {
+			return err
+		}
+		if err := acc.DecodeForStorage(v); err != nil {
+			return err
+		}
+		if acc.Incarnation == 0 {
+			continue
+		}
+
+		to, _ := kv.NextSubtree(addr)
+		storage, err := tx.DomainRange(kv.StorageDomain, addr, to, txNum, order.Asc, kv.Unlim)
+		if err != nil {
+			return err
+		}
+		for storage.HasNext() {
+			k, v, err := storage.Next()
+			if err != nil {
+				return err
+			}
+			if len(v) == 0 {
+				continue
+			}
+			if entries++; entries > maxHashedStateAsOfEntries {
+				return tooLarge
+			}
+			locHash, err := libcommon.HashData(k[length.Addr:])
+			if err != nil {
+				return err
+			}
+			if err := batch.Put(kv.HashedStorage, dbutils.GenerateCompositeStorageKey(addrHash, acc.Incarnation, locHash), v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (api *APIImpl) tryBlockFromLru(hash libcommon.Hash) *types.Block {
 	var block *types.Block
 	if api.blocksLRU != nil {
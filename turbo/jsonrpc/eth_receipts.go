@@ -161,17 +161,41 @@ func (api *APIImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria) (t
 		end = latest
 	}
 
+	if err := api.checkLogsBlockRange(begin, end); err != nil {
+		return nil, err
+	}
+
 	if api.historyV3(tx) {
 		return api.getLogsV3(ctx, tx.(kv.TemporalTx), begin, end, crit)
 	}
 
+	err := api.forEachBlockLogs(ctx, tx, begin, end, crit, func(blockNumber uint64, blockLogs types.Logs) (bool, error) {
+		if api.logsMaxResults > 0 && len(logs)+len(blockLogs) > api.logsMaxResults {
+			return false, newLogsMaxResultsError(begin, blockNumber, api.logsMaxResults)
+		}
+		logs = append(logs, blockLogs...)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// forEachBlockLogs calls fn, in order, with the logs matching crit of every block in [begin, end] that has some.
+// The blocks are found with the log index bitmaps, and the logs have all their fields set. It stops at the first
+// call of fn returning false or an error.
+func (api *BaseAPI) forEachBlockLogs(ctx context.Context, tx kv.Tx, begin, end uint64, crit filters.FilterCriteria, fn func(blockNumber uint64, blockLogs types.Logs) (bool, error)) error {
+	if api.historyV3(tx) {
+		return api.forEachBlockLogsV3(ctx, tx.(kv.TemporalTx), begin, end, crit, fn)
+	}
 	blockNumbers := bitmapdb.NewBitmap()
 	defer bitmapdb.ReturnToPool(blockNumbers)
 	if err := applyFilters(blockNumbers, tx, begin, end, crit); err != nil {
-		return logs, err
+		return err
 	}
 	if blockNumbers.IsEmpty() {
-		return logs, nil
+		return nil
 	}
 	addrMap := make(map[common.Address]struct{}, len(crit.Addresses))
 	for _, v := range crit.Addresses {
@@ -180,27 +204,27 @@ func (api *APIImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria) (t
 	iter := blockNumbers.Iterator()
 	for iter.HasNext() {
 		if err := ctx.Err(); err != nil {
-			return nil, err
+			return err
 		}
 
 		blockNumber := uint64(iter.Next())
 		var logIndex uint
 		var txIndex uint
-		var blockLogs []*types.Log
+		var blockLogs types.Logs
 
 		it, err := tx.Prefix(kv.Log, hexutility.EncodeTs(blockNumber))
 		if err != nil {
-			return nil, err
+			return err
 		}
 		for it.HasNext() {
 			k, v, err := it.Next()
 			if err != nil {
-				return logs, err
+				return err
 			}
 
 			var logs types.Logs
 			if err := cbor.Unmarshal(&logs, bytes.NewReader(v)); err != nil {
-				return logs, fmt.Errorf("receipt unmarshal failed:  %w", err)
+				return fmt.Errorf("receipt unmarshal failed:  %w", err)
 			}
 			for _, log := range logs {
 				log.Index = logIndex
@@ -222,15 +246,15 @@ func (api *APIImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria) (t
 
 		blockHash, err := api._blockReader.CanonicalHash(ctx, tx, blockNumber)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		body, err := api._blockReader.BodyWithTransactions(ctx, tx, blockHash, blockNumber)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if body == nil {
-			return nil, fmt.Errorf("block not found %d", blockNumber)
+			return fmt.Errorf("block not found %d", blockNumber)
 		}
 		for _, log := range blockLogs {
 			log.BlockNumber = blockNumber
@@ -242,12 +266,60 @@ func (api *APIImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria) (t
 				log.TxHash = body.Transactions[log.TxIndex].Hash()
 			}
 		}
-		logs = append(logs, blockLogs...)
+		if next, err := fn(blockNumber, blockLogs); err != nil || !next {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkLogsBlockRange returns an error when [begin, end] is wider than --rpc.logs.maxrange.
+func (api *BaseAPI) checkLogsBlockRange(begin, end uint64) error {
+	if api.logsMaxBlockRange == 0 || end-begin < api.logsMaxBlockRange {
+		return nil
+	}
+	to := begin + api.logsMaxBlockRange - 1
+	return &logsLimitError{
+		message:     fmt.Sprintf("block range of %d blocks exceeds the limit of %d. Try with this block range [0x%x, 0x%x]", end-begin+1, api.logsMaxBlockRange, begin, to),
+		FromBlock:   (*hexutil.Uint64)(&begin),
+		ToBlock:     (*hexutil.Uint64)(&to),
+		ResumeBlock: hexutil.Uint64(to + 1),
 	}
+}
 
-	return logs, nil
+// newLogsMaxResultsError is returned when the logs of a query starting at begin go over --rpc.logs.maxresults with
+// the logs of block resumeBlock.
+func newLogsMaxResultsError(begin, resumeBlock uint64, maxResults int) error {
+	if resumeBlock == begin {
+		return &logsLimitError{
+			message:     fmt.Sprintf("query returned more than %d results in block 0x%x. Use erigon_getLogsPage to page through them", maxResults, resumeBlock),
+			ResumeBlock: hexutil.Uint64(resumeBlock),
+		}
+	}
+	to := resumeBlock - 1
+	return &logsLimitError{
+		message:     fmt.Sprintf("query returned more than %d results. Try with this block range [0x%x, 0x%x]", maxResults, begin, to),
+		FromBlock:   (*hexutil.Uint64)(&begin),
+		ToBlock:     (*hexutil.Uint64)(&to),
+		ResumeBlock: hexutil.Uint64(resumeBlock),
+	}
+}
+
+// logsLimitError is returned when a logs query goes over one of the limits. Its data tells the client how to split
+// the query: the range [FromBlock, ToBlock] is within the limits, and the next query can start at ResumeBlock.
+type logsLimitError struct {
+	message     string
+	FromBlock   *hexutil.Uint64 `json:"fromBlock,omitempty"`
+	ToBlock     *hexutil.Uint64 `json:"toBlock,omitempty"`
+	ResumeBlock hexutil.Uint64  `json:"resumeBlock"`
 }
 
+func (e *logsLimitError) ErrorCode() int { return -32005 }
+
+func (e *logsLimitError) Error() string { return e.message }
+
+func (e *logsLimitError) ErrorData() interface{} { return e }
+
 // getLogsIsValidBlockNumber checks if block number is valid integer or "latest", "pending", "earliest" block number
 func getLogsIsValidBlockNumber(blockNum *big.Int) bool {
 	return blockNum.IsInt64() && blockNum.Int64() >= PendingBlockNumber
@@ -409,10 +481,28 @@ func applyFiltersV3(tx kv.TemporalTx, begin, end uint64, crit filters.FilterCrit
 
 func (api *APIImpl) getLogsV3(ctx context.Context, tx kv.TemporalTx, begin, end uint64, crit filters.FilterCriteria) ([]*types.Log, error) {
 	logs := []*types.Log{}
+	err := api.forEachBlockLogsV3(ctx, tx, begin, end, crit, func(blockNumber uint64, blockLogs types.Logs) (bool, error) {
+		logs = append(logs, blockLogs...)
+		if api.logsMaxResults > 0 && len(logs) > api.logsMaxResults {
+			return false, newLogsMaxResultsError(begin, blockNumber, api.logsMaxResults)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	//stats := api._agg.GetAndResetStats()
+	//log.Info("Finished", "duration", time.Since(start), "history queries", stats.HistoryQueries, "ef search duration", stats.EfSearchTime)
+	return logs, nil
+}
+
+// forEachBlockLogsV3 is forEachBlockLogs for history v3, where the logs are found by re-executing the transactions
+// of the inverted indices. Their index in the block is not known.
+func (api *BaseAPI) forEachBlockLogsV3(ctx context.Context, tx kv.TemporalTx, begin, end uint64, crit filters.FilterCriteria, fn func(blockNumber uint64, blockLogs types.Logs) (bool, error)) error {
 	txNumbers, err := applyFiltersV3(tx, begin, end, crit)
 	if err != nil {
-		return logs, err
+		return err
 	}
 
 	addrMap := make(map[common.Address]struct{}, len(crit.Addresses))
@@ -422,21 +512,22 @@ func (api *APIImpl) getLogsV3(ctx context.Context, tx kv.TemporalTx, begin, end
 
 	chainConfig, err := api.chainConfig(tx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	exec := txnExecutor(tx, chainConfig, api.engine(), api._blockReader, nil)
 
 	var blockHash common.Hash
 	var header *types.Header
+	var blockLogs types.Logs
 
 	iter := MapTxNum2BlockNum(tx, txNumbers)
 	for iter.HasNext() {
 		if err = ctx.Err(); err != nil {
-			return nil, err
+			return err
 		}
 		txNum, blockNum, txIndex, isFinalTxn, blockNumChanged, err := iter.Next()
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if isFinalTxn {
 			continue
@@ -444,8 +535,14 @@ func (api *APIImpl) getLogsV3(ctx context.Context, tx kv.TemporalTx, begin, end
 
 		// if block number changed, calculate all related field
 		if blockNumChanged {
+			if len(blockLogs) > 0 {
+				if next, err := fn(blockLogs[0].BlockNumber, blockLogs); err != nil || !next {
+					return err
+				}
+				blockLogs = nil
+			}
 			if header, err = api._blockReader.HeaderByNumber(ctx, tx, blockNum); err != nil {
-				return nil, err
+				return err
 			}
 			if header == nil {
 				log.Warn("[rpc] header is nil", "blockNum", blockNum)
@@ -458,14 +555,14 @@ func (api *APIImpl) getLogsV3(ctx context.Context, tx kv.TemporalTx, begin, end
 		//fmt.Printf("txNum=%d, blockNum=%d, txIndex=%d, maxTxNumInBlock=%d,mixTxNumInBlock=%d\n", txNum, blockNum, txIndex, maxTxNumInBlock, minTxNumInBlock)
 		txn, err := api._txnReader.TxnByIdxInBlock(ctx, tx, blockNum, txIndex)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if txn == nil {
 			continue
 		}
 		rawLogs, _, err := exec.execTx(txNum, txIndex, txn)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		//TODO: logIndex within the block! no way to calc it now
@@ -480,12 +577,14 @@ func (api *APIImpl) getLogsV3(ctx context.Context, tx kv.TemporalTx, begin, end
 			log.BlockHash = blockHash
 			log.TxHash = txn.Hash()
 		}
-		logs = append(logs, filtered...)
+		blockLogs = append(blockLogs, filtered...)
 	}
-
-	//stats := api._agg.GetAndResetStats()
-	//log.Info("Finished", "duration", time.Since(start), "history queries", stats.HistoryQueries, "ef search duration", stats.EfSearchTime)
-	return logs, nil
+	if len(blockLogs) > 0 {
+		if _, err := fn(blockLogs[0].BlockNumber, blockLogs); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type intraBlockExec struct {
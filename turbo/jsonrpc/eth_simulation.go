@@ -0,0 +1,429 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/log/v3"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+
+	"github.com/ledgerwatch/erigon/consensus/misc"
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/state"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/rpc"
+	"github.com/ledgerwatch/erigon/turbo/adapter/ethapi"
+	"github.com/ledgerwatch/erigon/turbo/rpchelper"
+)
+
+const (
+	// maxSimulateBlocks limits the number of blocks a single eth_simulateV1 request can produce
+	maxSimulateBlocks = 256
+	// simulateTimestampIncrement is the default time distance between consecutive simulated blocks
+	simulateTimestampIncrement = 12
+)
+
+// SimulationBlock is a single block of calls executed by eth_simulateV1 on top of the previous one.
+type SimulationBlock struct {
+	BlockOverrides *BlockOverrides        `json:"blockOverrides"`
+	StateOverrides *ethapi.StateOverrides `json:"stateOverrides"`
+	Calls          []ethapi.CallArgs      `json:"calls"`
+}
+
+// SimulationOpts are the options accepted by eth_simulateV1.
+type SimulationOpts struct {
+	BlockStateCalls        []SimulationBlock `json:"blockStateCalls"`
+	Validation             bool              `json:"validation"`
+	ReturnFullTransactions bool              `json:"returnFullTransactions"`
+}
+
+// SimulationCallResult is the outcome of a single simulated call.
+type SimulationCallResult struct {
+	ReturnValue hexutility.Bytes     `json:"returnData"`
+	Logs        []*types.Log         `json:"logs"`
+	GasUsed     hexutil.Uint64       `json:"gasUsed"`
+	Status      hexutil.Uint64       `json:"status"`
+	Error       *SimulationCallError `json:"error,omitempty"`
+}
+
+// SimulationCallError describes why a simulated call failed. Reverts carry the revert data.
+type SimulationCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// simulationVMErrorCode is returned for calls which failed inside of the EVM for a reason other than a revert
+const simulationVMErrorCode = -32015
+
+// SimulateV1 implements eth_simulateV1. Executes a sequence of blocks of calls on top of the given block
+// and returns the synthetic blocks together with the result, logs and used gas of every call.
+// Every block may override the header fields (number, timestamp, baseFee, coinbase, ...) and the state,
+// including relocation of precompiles. Skipped block numbers are filled with empty blocks, and every block runs
+// the EIP-4788 and EIP-2935 system calls before its calls, like a real block does. With validation enabled nonces, balances and fees are checked
+// like for real transactions. Signatures are never checked: the calls are unsigned, the sender is taken
+// from their "from" field, so a call may be sent from any account with or without validation.
+// Note: the state root of the simulated blocks is not computed.
+func (api *APIImpl) SimulateV1(ctx context.Context, opts SimulationOpts, blockNrOrHash *rpc.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	bNrOrHash := latestNumOrHash
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	chainConfig, err := api.chainConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(start time.Time) { log.Trace("Executing EVM simulateV1 finished", "runtime", time.Since(start)) }(time.Now())
+
+	blockNum, hash, _, err := rpchelper.GetCanonicalBlockNumber(bNrOrHash, tx, api.filters)
+	if err != nil {
+		return nil, err
+	}
+	block, err := api.blockWithSenders(tx, hash, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d(%x) not found", blockNum, hash)
+	}
+	stateReader, err := rpchelper.CreateStateReader(ctx, tx, bNrOrHash, 0, api.filters, api.stateCache, api.historyV3(tx), chainConfig.ChainName)
+	if err != nil {
+		return nil, err
+	}
+	ibs := state.New(stateReader)
+
+	simBlocks, err := simulationBlocks(opts.BlockStateCalls, block.NumberU64())
+	if err != nil {
+		return nil, err
+	}
+
+	// Setup context so it may be cancelled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if api.evmCallTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, api.evmCallTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	overrideBlockHash := make(map[uint64]common.Hash)
+	simulatedHashes := make(map[uint64]common.Hash)
+	getHash := func(i uint64) common.Hash {
+		if hash, ok := overrideBlockHash[i]; ok {
+			return hash
+		}
+		if hash, ok := simulatedHashes[i]; ok {
+			return hash
+		}
+		hash, err := api._blockReader.CanonicalHash(ctx, tx, i)
+		if err != nil {
+			log.Debug("Can't get block hash by number", "number", i, "only-canonical", true)
+		}
+		return hash
+	}
+
+	vmConfig := vm.Config{NoBaseFee: !opts.Validation}
+	parent := block.Header()
+	evm := vm.NewEVM(core.NewEVMBlockContext(parent, getHash, api.engine(), &parent.Coinbase), core.NewEVMTxContext(types.Message{}), ibs, chainConfig, vmConfig)
+	// Wait for the context to be done and cancel the evm. Even if the
+	// EVM has finished, cancelling may be done (repeatedly)
+	go func() {
+		<-ctx.Done()
+		evm.Cancel()
+	}()
+
+	results := make([]map[string]interface{}, 0, len(simBlocks))
+	for _, simBlock := range simBlocks {
+		header, err := simulationHeader(chainConfig, parent, simBlock.BlockOverrides, opts.Validation)
+		if err != nil {
+			return nil, err
+		}
+		if simBlock.BlockOverrides != nil && simBlock.BlockOverrides.BlockHash != nil {
+			for blockNum, hash := range *simBlock.BlockOverrides.BlockHash {
+				overrideBlockHash[blockNum] = hash
+			}
+		}
+		rules := chainConfig.Rules(header.Number.Uint64(), header.Time)
+		precompiles := vm.ActivePrecompiledContracts(rules)
+		if simBlock.StateOverrides != nil {
+			if err := simBlock.StateOverrides.Override(ibs); err != nil {
+				return nil, err
+			}
+			if err := simBlock.StateOverrides.OverridePrecompiles(precompiles); err != nil {
+				return nil, err
+			}
+		}
+
+		blockCtx := core.NewEVMBlockContext(header, getHash, api.engine(), &header.Coinbase)
+		evm.ResetBetweenBlocks(blockCtx, evm.TxContext, ibs, vmConfig, rules)
+		evm.SetPrecompiles(precompiles)
+
+		// The system calls which block processing makes before the transactions
+		syscall := func(contract common.Address, data []byte) ([]byte, error) {
+			return core.SysCallContract(contract, data, chainConfig, ibs, header, api.engine(), false /* constCall */)
+		}
+		if rules.IsCancun && header.ParentBeaconBlockRoot != nil {
+			misc.ApplyBeaconRootEip4788(header.ParentBeaconBlockRoot, syscall)
+		}
+		if rules.IsPrague {
+			misc.ApplyParentBlockHashEip2935(header.ParentHash, syscall)
+		}
+		if err = ibs.FinalizeTx(rules, state.NewNoopWriter()); err != nil {
+			return nil, err
+		}
+
+		var (
+			gp          = new(core.GasPool).AddGas(header.GasLimit).AddBlobGas(chainConfig.GetMaxBlobGasPerBlock())
+			txs         = make(types.Transactions, 0, len(simBlock.Calls))
+			receipts    = make(types.Receipts, 0, len(simBlock.Calls))
+			callResults = make([]*SimulationCallResult, 0, len(simBlock.Calls))
+			senders     = make([]common.Address, 0, len(simBlock.Calls))
+			gasUsed     uint64
+			blobGasUsed uint64
+		)
+		for i, args := range simBlock.Calls {
+			if args.Gas == nil || *args.Gas == 0 {
+				remainingGas := hexutil.Uint64(gp.Gas())
+				args.Gas = &remainingGas
+			}
+			msg, err := args.ToMessage(api.GasCap, blockCtx.BaseFee)
+			if err != nil {
+				return nil, err
+			}
+			nonce := ibs.GetNonce(msg.From())
+			if args.Nonce != nil {
+				nonce = uint64(*args.Nonce)
+			}
+			msg.SetNonce(nonce)
+			msg.SetCheckNonce(opts.Validation)
+
+			txn := simulationTransaction(&msg, chainConfig.ChainID)
+			txHash := txn.Hash()
+			ibs.SetTxContext(txHash, common.Hash{}, i)
+			evm.Reset(core.NewEVMTxContext(msg), ibs)
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("execution aborted (timeout = %v)", api.evmCallTimeout)
+			}
+			result, err := core.ApplyMessage(evm, msg, gp, true /* refunds */, false /* gasBailout */)
+			if err != nil {
+				return nil, fmt.Errorf("block %d, call %d: %w", header.Number.Uint64(), i, err)
+			}
+			// If the timer caused an abort, return an appropriate error message
+			if evm.Cancelled() {
+				return nil, fmt.Errorf("execution aborted (timeout = %v)", api.evmCallTimeout)
+			}
+			if err = ibs.FinalizeTx(rules, state.NewNoopWriter()); err != nil {
+				return nil, err
+			}
+
+			gasUsed += result.UsedGas
+			blobGasUsed += msg.BlobGas()
+			receipt := &types.Receipt{
+				Type:              txn.Type(),
+				CumulativeGasUsed: gasUsed,
+				TxHash:            txHash,
+				GasUsed:           result.UsedGas,
+				Logs:              ibs.GetLogs(txHash),
+				BlockNumber:       header.Number,
+				TransactionIndex:  uint(i),
+			}
+			if result.Failed() {
+				receipt.Status = types.ReceiptStatusFailed
+			} else {
+				receipt.Status = types.ReceiptStatusSuccessful
+			}
+			if msg.To() == nil {
+				receipt.ContractAddress = crypto.CreateAddress(msg.From(), nonce)
+			}
+			receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+
+			callResult := &SimulationCallResult{
+				ReturnValue: result.Return(),
+				Logs:        receipt.Logs,
+				GasUsed:     hexutil.Uint64(result.UsedGas),
+				Status:      hexutil.Uint64(receipt.Status),
+			}
+			if result.Err != nil {
+				if len(result.Revert()) > 0 {
+					revertErr := ethapi.NewRevertError(result)
+					callResult.ReturnValue = result.Revert()
+					callResult.Error = &SimulationCallError{Code: revertErr.ErrorCode(), Message: revertErr.Error(), Data: revertErr.ErrorData().(string)}
+				} else {
+					callResult.Error = &SimulationCallError{Code: simulationVMErrorCode, Message: result.Err.Error()}
+				}
+			}
+			if callResult.Logs == nil {
+				callResult.Logs = []*types.Log{}
+			}
+
+			txs = append(txs, txn)
+			receipts = append(receipts, receipt)
+			callResults = append(callResults, callResult)
+			senders = append(senders, msg.From())
+		}
+
+		header.GasUsed = gasUsed
+		if rules.IsCancun {
+			header.BlobGasUsed = &blobGasUsed
+		}
+		header.TxHash = types.DeriveSha(txs)
+		header.ReceiptHash = types.DeriveSha(receipts)
+		header.Bloom = types.CreateBloom(receipts)
+		blockHash := header.Hash()
+		simulatedHashes[header.Number.Uint64()] = blockHash
+
+		var logIndex uint
+		for _, receipt := range receipts {
+			receipt.BlockHash = blockHash
+			for _, l := range receipt.Logs {
+				l.BlockHash = blockHash
+				l.BlockNumber = header.Number.Uint64()
+				l.TxIndex = receipt.TransactionIndex
+				l.Index = logIndex
+				logIndex++
+			}
+		}
+
+		fields := ethapi.RPCMarshalHeader(header)
+		transactions := make([]interface{}, 0, len(txs))
+		for i, txn := range txs {
+			if opts.ReturnFullTransactions {
+				rpcTx := NewRPCTransaction(txn, blockHash, header.Number.Uint64(), uint64(i), header.BaseFee)
+				rpcTx.From = senders[i]
+				transactions = append(transactions, rpcTx)
+			} else {
+				transactions = append(transactions, txn.Hash())
+			}
+		}
+		fields["transactions"] = transactions
+		fields["calls"] = callResults
+		results = append(results, fields)
+		parent = header
+	}
+	return results, nil
+}
+
+// simulationBlocks returns the blocks to simulate on top of the block baseNum. A block number override which
+// skips numbers gets the skipped blocks inserted before it as empty blocks, which count against maxSimulateBlocks.
+func simulationBlocks(blocks []SimulationBlock, baseNum uint64) ([]SimulationBlock, error) {
+	res := make([]SimulationBlock, 0, len(blocks))
+	prevNum := baseNum
+	for _, block := range blocks {
+		num := prevNum + 1
+		if block.BlockOverrides != nil && block.BlockOverrides.BlockNumber != nil {
+			num = uint64(*block.BlockOverrides.BlockNumber)
+			if num <= prevNum {
+				return nil, fmt.Errorf("block numbers must be in order: %d <= %d", num, prevNum)
+			}
+		}
+		if num-baseNum > maxSimulateBlocks {
+			return nil, fmt.Errorf("too many blocks: %d, max: %d", num-baseNum, maxSimulateBlocks)
+		}
+		for filler := prevNum + 1; filler < num; filler++ {
+			fillerNum := hexutil.Uint64(filler)
+			res = append(res, SimulationBlock{BlockOverrides: &BlockOverrides{BlockNumber: &fillerNum}})
+		}
+		res = append(res, block)
+		prevNum = num
+	}
+	return res, nil
+}
+
+// simulationHeader creates the header of the next simulated block on top of parent, applying the overrides.
+// Fields which depend on the block execution (gas used, roots, bloom) are filled in by the caller.
+func simulationHeader(chainConfig *chain.Config, parent *types.Header, overrides *BlockOverrides, validation bool) (*types.Header, error) {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		UncleHash:  types.EmptyUncleHash,
+		Coinbase:   parent.Coinbase,
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   parent.GasLimit,
+		Time:       parent.Time + simulateTimestampIncrement,
+		Difficulty: new(big.Int).Set(parent.Difficulty),
+		MixDigest:  parent.MixDigest,
+	}
+	if overrides != nil {
+		if overrides.BlockNumber != nil {
+			if uint64(*overrides.BlockNumber) <= parent.Number.Uint64() {
+				return nil, fmt.Errorf("block numbers must be in order: %d <= %d", uint64(*overrides.BlockNumber), parent.Number.Uint64())
+			}
+			header.Number.SetUint64(uint64(*overrides.BlockNumber))
+		}
+		if overrides.Timestamp != nil {
+			if uint64(*overrides.Timestamp) <= parent.Time {
+				return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", uint64(*overrides.Timestamp), parent.Time)
+			}
+			header.Time = uint64(*overrides.Timestamp)
+		}
+		if overrides.Coinbase != nil {
+			header.Coinbase = *overrides.Coinbase
+		}
+		if overrides.GasLimit != nil {
+			header.GasLimit = uint64(*overrides.GasLimit)
+		}
+		if overrides.Difficulty != nil {
+			header.Difficulty = big.NewInt(int64(*overrides.Difficulty))
+		}
+	}
+
+	rules := chainConfig.Rules(header.Number.Uint64(), header.Time)
+	if rules.IsLondon {
+		switch {
+		case overrides != nil && overrides.BaseFee != nil:
+			header.BaseFee = overrides.BaseFee.ToBig()
+		case validation:
+			header.BaseFee = misc.CalcBaseFee(chainConfig, parent)
+		default:
+			// Without validation calls are free unless the caller asks otherwise
+			header.BaseFee = new(big.Int)
+		}
+	}
+	if rules.IsShanghai {
+		header.WithdrawalsHash = &types.EmptyRootHash
+	}
+	if rules.IsCancun {
+		excessBlobGas := misc.CalcExcessBlobGas(chainConfig, parent)
+		header.ExcessBlobGas = &excessBlobGas
+		header.ParentBeaconBlockRoot = &common.Hash{}
+	}
+	return header, nil
+}
+
+// simulationTransaction wraps a simulated call into an unsigned transaction, so that it can be hashed and returned.
+func simulationTransaction(msg *types.Message, chainID *big.Int) types.Transaction {
+	chainId, _ := uint256.FromBig(chainID)
+	return &types.DynamicFeeTransaction{
+		CommonTx: types.CommonTx{
+			Nonce: msg.Nonce(),
+			Gas:   msg.Gas(),
+			To:    msg.To(),
+			Value: msg.Value(),
+			Data:  msg.Data(),
+		},
+		ChainID:    chainId,
+		Tip:        msg.Tip(),
+		FeeCap:     msg.FeeCap(),
+		AccessList: msg.AccessList(),
+	}
+}
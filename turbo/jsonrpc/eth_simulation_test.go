@@ -0,0 +1,137 @@
+package jsonrpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/log/v3"
+
+	"github.com/ledgerwatch/erigon/cmd/rpcdaemon/rpcdaemontest"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/turbo/adapter/ethapi"
+	"github.com/ledgerwatch/erigon/turbo/stages/mock"
+)
+
+func TestSimulateV1(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	api := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, log.New())
+	ctx := context.Background()
+
+	latest, err := api.BlockNumber(ctx)
+	require.NoError(t, err)
+
+	var (
+		bank      = common.HexToAddress("0x71562b71999873db5b286df957af199ec94617f7")
+		receiver  = common.HexToAddress("0x00000000000000000000000000000000000000f1")
+		coinbase  = common.HexToAddress("0x00000000000000000000000000000000000000c0")
+		identity  = common.BytesToAddress([]byte{4})
+		movedTo   = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		timestamp = hexutil.Uint64(2_000_000_000)
+		input     = hexutility.Bytes{0x12, 0x34}
+	)
+	opts := SimulationOpts{
+		BlockStateCalls: []SimulationBlock{
+			{
+				BlockOverrides: &BlockOverrides{Timestamp: &timestamp, Coinbase: &coinbase},
+				Calls: []ethapi.CallArgs{
+					{From: &bank, To: &receiver, Value: (*hexutil.Big)(big.NewInt(1))},
+					{From: &bank, To: &receiver, Value: (*hexutil.Big)(big.NewInt(2))},
+				},
+			},
+			{
+				StateOverrides: &ethapi.StateOverrides{identity: ethapi.Account{MovePrecompileTo: &movedTo}},
+				Calls: []ethapi.CallArgs{
+					{From: &bank, To: &movedTo, Input: &input},
+				},
+			},
+		},
+	}
+	res, err := api.SimulateV1(ctx, opts, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	require.Equal(t, uint64(latest)+1, res[0]["number"].(*hexutil.Big).ToInt().Uint64())
+	require.Equal(t, uint64(latest)+2, res[1]["number"].(*hexutil.Big).ToInt().Uint64())
+	require.Equal(t, timestamp, res[0]["timestamp"])
+	require.Equal(t, timestamp+simulateTimestampIncrement, res[1]["timestamp"])
+	require.Equal(t, coinbase, res[0]["miner"])
+	require.Equal(t, res[0]["hash"], res[1]["parentHash"])
+	require.Equal(t, hexutil.Uint64(2*21000), res[0]["gasUsed"])
+
+	calls := res[0]["calls"].([]*SimulationCallResult)
+	require.Len(t, calls, 2)
+	for _, call := range calls {
+		require.Nil(t, call.Error)
+		require.Equal(t, hexutil.Uint64(types.ReceiptStatusSuccessful), call.Status)
+		require.Equal(t, hexutil.Uint64(21000), call.GasUsed)
+	}
+	require.Len(t, res[0]["transactions"], 2)
+
+	calls = res[1]["calls"].([]*SimulationCallResult)
+	require.Len(t, calls, 1)
+	require.Nil(t, calls[0].Error)
+	require.Equal(t, input, calls[0].ReturnValue)
+
+	// block numbers have to increase
+	past := hexutil.Uint64(latest)
+	_, err = api.SimulateV1(ctx, SimulationOpts{BlockStateCalls: []SimulationBlock{{BlockOverrides: &BlockOverrides{BlockNumber: &past}}}}, nil)
+	require.Error(t, err)
+
+	// skipped block numbers are filled with empty blocks
+	jump := hexutil.Uint64(latest) + 3
+	res, err = api.SimulateV1(ctx, SimulationOpts{BlockStateCalls: []SimulationBlock{{BlockOverrides: &BlockOverrides{BlockNumber: &jump}}}}, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	for i, block := range res {
+		require.Equal(t, uint64(latest)+uint64(i)+1, block["number"].(*hexutil.Big).ToInt().Uint64())
+		require.Empty(t, block["calls"])
+		if i > 0 {
+			require.Equal(t, res[i-1]["hash"], block["parentHash"])
+		}
+	}
+
+	// and count against the limit
+	jump = hexutil.Uint64(latest) + maxSimulateBlocks + 1
+	_, err = api.SimulateV1(ctx, SimulationOpts{BlockStateCalls: []SimulationBlock{{BlockOverrides: &BlockOverrides{BlockNumber: &jump}}}}, nil)
+	require.ErrorContains(t, err, "too many blocks")
+}
+
+func TestSimulateV1SystemCalls(t *testing.T) {
+	config := *params.AllProtocolChanges
+	config.PragueTime = big.NewInt(0)
+	// Stores the calldata of the system call in slot 0 and returns slot 0 to the other callers, which send no calldata
+	historyStorage := hexutility.MustDecodeHex("0x36600f5760005460005260206000f35b60003560005500")
+	key, _ := crypto.GenerateKey()
+	gspec := &types.Genesis{
+		Config: &config,
+		Alloc: types.GenesisAlloc{
+			crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(params.Ether)},
+			params.HistoryStorageAddress:          {Code: historyStorage, Balance: new(big.Int)},
+		},
+	}
+	m := mock.MockWithGenesis(t, gspec, key, false)
+	api := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, log.New())
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	number := hexutil.Uint64(2)
+	res, err := api.SimulateV1(context.Background(), SimulationOpts{BlockStateCalls: []SimulationBlock{{
+		BlockOverrides: &BlockOverrides{BlockNumber: &number},
+		Calls:          []ethapi.CallArgs{{From: &from, To: &params.HistoryStorageAddress}},
+	}}}, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	// the parent hash stored by the EIP-2935 system call of the last block is the hash of the filler block
+	calls := res[1]["calls"].([]*SimulationCallResult)
+	require.Len(t, calls, 1)
+	require.Nil(t, calls[0].Error)
+	require.Equal(t, res[0]["hash"].(common.Hash).Bytes(), []byte(calls[0].ReturnValue))
+}
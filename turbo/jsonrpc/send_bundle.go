@@ -0,0 +1,73 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon-lib/gointerfaces"
+	txPoolProto "github.com/ledgerwatch/erigon-lib/gointerfaces/txpool"
+	types2 "github.com/ledgerwatch/erigon-lib/gointerfaces/types"
+
+	"github.com/ledgerwatch/erigon/core/types"
+)
+
+// SendBundleArgs is a group of signed transactions to include together, in order, in the target block or not at all
+type SendBundleArgs struct {
+	Txs               []hexutility.Bytes `json:"txs"`
+	BlockNumber       hexutil.Uint64     `json:"blockNumber"`                 // the only block the bundle may be included in
+	RevertingTxHashes []common.Hash      `json:"revertingTxHashes,omitempty"` // transactions allowed to revert without dropping the bundle
+}
+
+type SendBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// SendBundle implements eth_sendBundle. Keeps a bundle of transactions for the locally built blocks, which are only
+// included in the target block if all of them succeed, or revert while allowed to. Bundles are never gossiped.
+func (api *APIImpl) SendBundle(ctx context.Context, args SendBundleArgs) (*SendBundleResult, error) {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	cc, err := api.chainConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &txPoolProto.SendBundleRequest{
+		RlpTxs:            make([][]byte, len(args.Txs)),
+		BlockNumber:       uint64(args.BlockNumber),
+		RevertingTxHashes: make([]*types2.H256, len(args.RevertingTxHashes)),
+	}
+	for i, encodedTx := range args.Txs {
+		txn, err := types.DecodeWrappedTransaction(encodedTx)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		if !txn.Protected() && !api.AllowUnprotectedTxs {
+			return nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
+		if txn.Protected() {
+			txnChainId := txn.GetChainID()
+			if cc.ChainID.Cmp(txnChainId.ToBig()) != 0 {
+				return nil, fmt.Errorf("transaction %d: invalid chain id, expected: %d got: %d", i, cc.ChainID, txnChainId)
+			}
+		}
+		req.RlpTxs[i] = encodedTx
+	}
+	for i, hash := range args.RevertingTxHashes {
+		req.RevertingTxHashes[i] = gointerfaces.ConvertHashToH256(hash)
+	}
+
+	reply, err := api.txPool.SendBundle(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &SendBundleResult{BundleHash: gointerfaces.ConvertH256ToHash(reply.Hash)}, nil
+}
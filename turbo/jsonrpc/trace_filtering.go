@@ -149,6 +149,9 @@ func rewardKindToString(kind consensus.RewardKind) string {
 }
 
 // Block implements trace_block
+// The transactions are replayed one after another, rpc.trace.workers only applies to debug_traceBlockByNumber and
+// debug_traceBlockByHash: doCallMany replays them on the parent state without the system calls run before them, which
+// the states handed over to the workers include, and the reward system calls need the state left by the last one.
 func (api *TraceAPIImpl) Block(ctx context.Context, blockNr rpc.BlockNumber, gasBailOut *bool) (ParityTraces, error) {
 	if gasBailOut == nil {
 		gasBailOut = new(bool) // false by default
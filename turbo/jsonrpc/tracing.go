@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"github.com/holiman/uint256"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
 	"github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"github.com/ledgerwatch/erigon/common/math"
@@ -101,13 +104,24 @@ func (api *PrivateDebugAPIImpl) traceBlock(ctx context.Context, blockNrOrHash rp
 
 	signer := types.MakeSigner(chainConfig, block.NumberU64(), block.Time())
 	rules := chainConfig.Rules(block.NumberU64(), block.Time())
-	stream.WriteArrayStart()
 
 	borTx := rawdb.ReadBorTransactionForBlock(tx, block.NumberU64())
 	txns := block.Transactions()
 	if borTx != nil && *config.BorTraceEnabled {
 		txns = append(txns, borTx)
 	}
+	// the struct logs are streamed as they are produced, only the results of the tracers are worth computing ahead
+	if api.traceWorkers > 1 && config.Tracer != nil && len(txns) > 1 && (borTx == nil || !*config.BorTraceEnabled) {
+		workers := api.acquireTraceWorkers(len(txns))
+		if workers > 1 {
+			defer api.traceWorkerSlots.Release(int64(workers))
+			return api.traceBlockParallel(ctx, block, blockCtx, ibs, config, chainConfig, workers, stream)
+		}
+		// the workers are busy with other requests, trace sequentially rather than with a single worker
+		api.traceWorkerSlots.Release(int64(workers))
+	}
+
+	stream.WriteArrayStart()
 
 	for idx, txn := range txns {
 		stream.WriteObjectStart()
@@ -122,14 +136,7 @@ func (api *PrivateDebugAPIImpl) traceBlock(ctx context.Context, blockNrOrHash rp
 			return ctx.Err()
 		}
 		ibs.SetTxContext(txn.Hash(), block.Hash(), idx)
-		msg, _ := txn.AsMessage(*signer, block.BaseFee(), rules)
-
-		if msg.FeeCap().IsZero() && engine != nil {
-			syscall := func(contract common.Address, data []byte) ([]byte, error) {
-				return core.SysCallContract(contract, data, chainConfig, ibs, block.Header(), engine, true /* constCall */)
-			}
-			msg.SetIsFree(engine.IsServiceTransaction(msg.From(), syscall))
-		}
+		msg := transactions.TxMessage(txn, signer, block.HeaderNoCopy(), rules, engine, chainConfig, ibs)
 
 		txCtx := evmtypes.TxContext{
 			TxHash:   txn.Hash(),
@@ -169,6 +176,170 @@ func (api *PrivateDebugAPIImpl) traceBlock(ctx context.Context, blockNrOrHash rp
 	return nil
 }
 
+// parallelTrace is the output of a transaction traced by traceBlockParallel.
+type parallelTrace struct {
+	result []byte
+	err    error
+	done   chan struct{}
+}
+
+// traceBlockParallel traces the transactions of the block with up to api.traceWorkers workers. The state before
+// each transaction is computed once by executing the block without tracing, the traced executions are
+// then spread over the workers, each of them reading the state through its own database transaction.
+// The traces are streamed in transaction order, in the same format as the sequential tracing.
+// The workers are taken from api.traceWorkerSlots, shared by all requests, so that no more than api.traceWorkers
+// database transactions are opened by the workers at any time.
+func (api *PrivateDebugAPIImpl) traceBlockParallel(ctx context.Context, block *types.Block, blockCtx evmtypes.BlockContext, ibs *state.IntraBlockState,
+	config *tracers.TraceConfig, chainConfig *chain.Config, workers int, stream *jsoniter.Stream) error {
+	engine := api.engine()
+	header := block.HeaderNoCopy()
+	signer := types.MakeSigner(chainConfig, block.NumberU64(), block.Time())
+	rules := chainConfig.Rules(block.NumberU64(), block.Time())
+	refunds := config.NoRefunds == nil || !*config.NoRefunds
+	txns := block.Transactions()
+
+	// changes[0] holds the state written before the first transaction, changes[i+1] the one written by the transaction i
+	changes := make([]*state.StateChanges, len(txns)+1)
+	traces := make([]parallelTrace, len(txns))
+	for i := range traces {
+		traces[i].done = make(chan struct{})
+	}
+	jobs := make(chan int, len(txns))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		stream.WriteArrayStart()
+		for idx, txn := range txns {
+			stream.WriteObjectStart()
+			stream.WriteObjectField("txHash")
+			stream.WriteString(txn.Hash().Hex())
+			stream.WriteMore()
+			stream.WriteObjectField("result")
+			select {
+			case <-traces[idx].done:
+			case <-gctx.Done():
+				stream.WriteNil()
+				return gctx.Err()
+			}
+			if len(traces[idx].result) == 0 {
+				stream.WriteNil()
+			} else {
+				stream.Write(traces[idx].result)
+			}
+			stream.WriteObjectEnd()
+
+			// if we have an error we want to output valid json for it before continuing after clearing down potential writes to the stream
+			if err := traces[idx].err; err != nil {
+				stream.WriteMore()
+				stream.WriteObjectStart()
+				err = rpc.HandleError(err, stream)
+				stream.WriteObjectEnd()
+				if err != nil {
+					return err
+				}
+			}
+			if idx != len(txns)-1 {
+				stream.WriteMore()
+			}
+			stream.Flush()
+		}
+		stream.WriteArrayEnd()
+		stream.Flush()
+		return nil
+	})
+	for i := 0; i < workers; i++ {
+		g.Go(func() error {
+			tx, err := api.db.BeginRo(gctx)
+			if err != nil {
+				return err
+			}
+			defer tx.Rollback()
+			reader, err := rpchelper.CreateHistoryStateReader(tx, block.NumberU64(), 0, api.historyV3(tx), chainConfig.ChainName)
+			if err != nil {
+				return err
+			}
+			overlay := state.NewOverlayReader(reader)
+			getHeader := func(hash common.Hash, number uint64) *types.Header {
+				h, _ := api._blockReader.Header(gctx, tx, hash, number)
+				return h
+			}
+			blockCtx := core.NewEVMBlockContext(header, core.GetHashFn(header, getHeader), engine, nil)
+			applied := 0
+			for idx := range jobs {
+				if gctx.Err() != nil {
+					return gctx.Err()
+				}
+				for ; applied <= idx; applied++ {
+					overlay.Apply(changes[applied])
+				}
+				txn := txns[idx]
+				ibs := state.New(overlay)
+				ibs.SetTxContext(txn.Hash(), block.Hash(), idx)
+				msg := transactions.TxMessage(txn, signer, header, rules, engine, chainConfig, ibs)
+				txCtx := evmtypes.TxContext{
+					TxHash:   txn.Hash(),
+					Origin:   msg.From(),
+					GasPrice: msg.GasPrice(),
+				}
+				result := jsoniter.NewStream(jsoniter.ConfigDefault, nil, 4096)
+				traces[idx].err = transactions.TraceTx(gctx, msg, blockCtx, txCtx, ibs, config, chainConfig, result, api.evmCallTimeout)
+				traces[idx].result = result.Buffer()
+				close(traces[idx].done)
+			}
+			return nil
+		})
+	}
+
+	// Execute the block without tracing, to hand over the state before each transaction to the workers
+	err := func() error {
+		defer close(jobs)
+		vmenv := vm.NewEVM(blockCtx, evmtypes.TxContext{}, ibs, chainConfig, vm.Config{})
+		changes[0] = state.NewStateChanges()
+		if err := ibs.FinalizeTx(rules, changes[0]); err != nil {
+			return err
+		}
+		for idx, txn := range txns {
+			jobs <- idx
+			if idx == len(txns)-1 {
+				break
+			}
+			select {
+			default:
+			case <-gctx.Done():
+				return nil
+			}
+			ibs.SetTxContext(txn.Hash(), block.Hash(), idx)
+			msg := transactions.TxMessage(txn, signer, header, rules, engine, chainConfig, ibs)
+			vmenv.Reset(core.NewEVMTxContext(msg), ibs)
+			// a failing transaction is reported by the worker tracing it
+			_, _ = core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas()).AddBlobGas(msg.BlobGas()), refunds, false /* gasBailout */)
+			changes[idx+1] = state.NewStateChanges()
+			if err := ibs.FinalizeTx(rules, changes[idx+1]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		cancel()
+		_ = g.Wait()
+		return err
+	}
+	return g.Wait()
+}
+
+// acquireTraceWorkers takes up to min(api.traceWorkers, txCount) free worker slots without waiting for the
+// slots held by other requests, and returns how many it took.
+func (api *PrivateDebugAPIImpl) acquireTraceWorkers(txCount int) int {
+	workers := 0
+	for workers < api.traceWorkers && workers < txCount && api.traceWorkerSlots.TryAcquire(1) {
+		workers++
+	}
+	return workers
+}
+
 // TraceTransaction implements debug_traceTransaction. Returns Geth style transaction traces.
 func (api *PrivateDebugAPIImpl) TraceTransaction(ctx context.Context, hash common.Hash, config *tracers.TraceConfig, stream *jsoniter.Stream) error {
 	tx, err := api.db.BeginRo(ctx)
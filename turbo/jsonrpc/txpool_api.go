@@ -3,6 +3,7 @@ package jsonrpc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 
@@ -188,8 +189,12 @@ func (api *TxPoolAPIImpl) ContentFrom(ctx context.Context, addr libcommon.Addres
 	return content, nil
 }
 
-// Status returns the number of pending and queued transaction in the pool.
-func (api *TxPoolAPIImpl) Status(ctx context.Context) (map[string]hexutil.Uint, error) {
+// Status returns the number of pending and queued transaction in the pool. Given the hash of a
+// transaction, it returns instead what happened to the transaction in the pool.
+func (api *TxPoolAPIImpl) Status(ctx context.Context, hash *libcommon.Hash) (interface{}, error) {
+	if hash != nil {
+		return api.lifecycle(ctx, *hash)
+	}
 	reply, err := api.pool.Status(ctx, &proto_txpool.StatusRequest{})
 	if err != nil {
 		return nil, err
@@ -201,6 +206,120 @@ func (api *TxPoolAPIImpl) Status(ctx context.Context) (map[string]hexutil.Uint,
 	}, nil
 }
 
+// TxLifecycle is what happened to a recently seen transaction in the pool, oldest event first
+type TxLifecycle struct {
+	Hash   libcommon.Hash     `json:"hash"`
+	Events []TxLifecycleEvent `json:"events"`
+}
+
+type TxLifecycleEvent struct {
+	Event       string          `json:"event"` // added, reinjected, moved, discarded or mined
+	Time        time.Time       `json:"time"`
+	SubPool     string          `json:"subPool,omitempty"`     // sub-pool the transaction was moved to
+	Reason      string          `json:"reason,omitempty"`      // why the transaction was discarded
+	ReplacedBy  *libcommon.Hash `json:"replacedBy,omitempty"`  // transaction which replaced this one
+	BlockNumber *hexutil.Uint64 `json:"blockNumber,omitempty"` // block which mined the transaction
+}
+
+func (api *TxPoolAPIImpl) lifecycle(ctx context.Context, hash libcommon.Hash) (*TxLifecycle, error) {
+	reply, err := api.pool.Lifecycle(ctx, &proto_txpool.LifecycleRequest{Hash: gointerfaces.ConvertHashToH256(hash)})
+	if err != nil {
+		return nil, err
+	}
+	lifecycle := &TxLifecycle{Hash: hash, Events: make([]TxLifecycleEvent, len(reply.Events))}
+	for i, e := range reply.Events {
+		event := TxLifecycleEvent{Time: time.UnixMilli(int64(e.Timestamp)).UTC(), Reason: e.Reason}
+		switch e.Type {
+		case proto_txpool.LifecycleReply_ADDED:
+			event.Event = "added"
+		case proto_txpool.LifecycleReply_REINJECTED:
+			event.Event = "reinjected"
+		case proto_txpool.LifecycleReply_MOVED:
+			event.Event = "moved"
+			event.SubPool = subPoolName(e.SubPool)
+		case proto_txpool.LifecycleReply_DISCARDED:
+			event.Event = "discarded"
+		case proto_txpool.LifecycleReply_MINED:
+			event.Event = "mined"
+			blockNumber := hexutil.Uint64(e.BlockNumber)
+			event.BlockNumber = &blockNumber
+		}
+		if e.ReplacedBy != nil {
+			replacedBy := libcommon.Hash(gointerfaces.ConvertH256ToHash(e.ReplacedBy))
+			event.ReplacedBy = &replacedBy
+		}
+		lifecycle.Events[i] = event
+	}
+	return lifecycle, nil
+}
+
+// TxPoolLocal is a local transaction in the pool, which is journaled and rebroadcast
+type TxPoolLocal struct {
+	*RPCTransaction
+	SubPool string `json:"subPool"`
+	Private bool   `json:"private"` // never announced or broadcast to peers
+}
+
+// Locals implements txpool_locals. Returns the local transactions in the pool, ordered by sender and nonce.
+func (api *TxPoolAPIImpl) Locals(ctx context.Context) ([]TxPoolLocal, error) {
+	reply, err := api.pool.Locals(ctx, &proto_txpool.LocalsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	cc, err := api.chainConfig(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	curHeader := rawdb.ReadCurrentHeader(tx)
+	if curHeader == nil {
+		return nil, nil
+	}
+	locals := make([]TxPoolLocal, len(reply.Txs))
+	for i, local := range reply.Txs {
+		txn, err := types.DecodeWrappedTransaction(local.RlpTx)
+		if err != nil {
+			return nil, fmt.Errorf("decoding transaction from: %x: %w", local.RlpTx, err)
+		}
+		txn.SetSender(gointerfaces.ConvertH160toAddress(local.Sender))
+		locals[i] = TxPoolLocal{
+			RPCTransaction: newRPCPendingTransaction(txn, curHeader, cc),
+			SubPool:        subPoolName(local.SubPool),
+			Private:        local.Private,
+		}
+	}
+	return locals, nil
+}
+
+// EvictLocal implements txpool_evictLocal. Drops a local transaction from the pool and from the journal, so it's
+// neither included nor rebroadcast anymore. Returns false if the transaction is not a local one in the pool.
+func (api *TxPoolAPIImpl) EvictLocal(ctx context.Context, hash libcommon.Hash) (bool, error) {
+	reply, err := api.pool.EvictLocal(ctx, &proto_txpool.EvictLocalRequest{Hash: gointerfaces.ConvertHashToH256(hash)})
+	if err != nil {
+		return false, err
+	}
+	return reply.Evicted, nil
+}
+
+func subPoolName(t proto_txpool.AllReply_TxnType) string {
+	switch t {
+	case proto_txpool.AllReply_PENDING:
+		return "pending"
+	case proto_txpool.AllReply_BASE_FEE:
+		return "baseFee"
+	case proto_txpool.AllReply_QUEUED:
+		return "queued"
+	default:
+		return ""
+	}
+}
+
 /*
 
 // Inspect retrieves the content of the transaction pool and flattens it into an
@@ -35,7 +35,7 @@ func TestTxPoolContent(t *testing.T) {
 	txPool := txpool.NewTxpoolClient(conn)
 	ff := rpchelper.New(ctx, nil, txPool, txpool.NewMiningClient(conn), func() {}, m.Log)
 	agg := m.HistoryV3Components()
-	api := NewTxPoolAPI(NewBaseApi(ff, kvcache.New(kvcache.DefaultCoherentConfig), m.BlockReader, agg, false, rpccfg.DefaultEvmCallTimeout, m.Engine, m.Dirs), m.DB, txPool)
+	api := NewTxPoolAPI(NewBaseApi(ff, kvcache.New(kvcache.DefaultCoherentConfig), m.BlockReader, agg, false, rpccfg.DefaultEvmCallTimeout, 0, 0, m.Engine, m.Dirs), m.DB, txPool)
 
 	expectValue := uint64(1234)
 	txn, err := types.SignTx(types.NewTransaction(0, libcommon.Address{1}, uint256.NewInt(expectValue), params.TxGas, uint256.NewInt(10*params.GWei), nil), *types.LatestSignerForChainID(m.ChainConfig.ChainID), m.Key)
@@ -58,9 +58,37 @@ func TestTxPoolContent(t *testing.T) {
 	require.Equal(1, len(content["pending"][sender]))
 	require.Equal(expectValue, content["pending"][sender]["0"].Value.ToInt().Uint64())
 
-	status, err := api.Status(ctx)
+	statusReply, err := api.Status(ctx, nil)
 	require.NoError(err)
+	status := statusReply.(map[string]hexutil.Uint)
 	require.Len(status, 3)
 	require.Equal(status["pending"], hexutil.Uint(1))
 	require.Equal(status["queued"], hexutil.Uint(0))
+
+	hash := txn.Hash()
+	statusReply, err = api.Status(ctx, &hash)
+	require.NoError(err)
+	lifecycle := statusReply.(*TxLifecycle)
+	require.Equal(hash, lifecycle.Hash)
+	require.Len(lifecycle.Events, 3)
+	require.Equal("added", lifecycle.Events[0].Event)
+	require.Equal(TxLifecycleEvent{Event: "moved", SubPool: "pending"}, TxLifecycleEvent{Event: lifecycle.Events[2].Event, SubPool: lifecycle.Events[2].SubPool})
+
+	locals, err := api.Locals(ctx)
+	require.NoError(err)
+	require.Len(locals, 1)
+	require.Equal(hash, locals[0].Hash)
+	require.Equal(m.Address, locals[0].From)
+	require.Equal("pending", locals[0].SubPool)
+	require.False(locals[0].Private)
+
+	evicted, err := api.EvictLocal(ctx, hash)
+	require.NoError(err)
+	require.True(evicted)
+	evicted, err = api.EvictLocal(ctx, hash)
+	require.NoError(err)
+	require.False(evicted)
+	locals, err = api.Locals(ctx)
+	require.NoError(err)
+	require.Empty(locals)
 }
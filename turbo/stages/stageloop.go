@@ -33,6 +33,7 @@ import (
 	"github.com/ledgerwatch/erigon/eth/ethconfig"
 	"github.com/ledgerwatch/erigon/eth/stagedsync"
 	"github.com/ledgerwatch/erigon/eth/stagedsync/stages"
+	"github.com/ledgerwatch/erigon/eth/tracers/live"
 	"github.com/ledgerwatch/erigon/p2p"
 	"github.com/ledgerwatch/erigon/p2p/sentry/sentry_multi_client"
 	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_helpers"
@@ -468,6 +469,7 @@ func NewDefaultStages(ctx context.Context,
 	blockRetire services.BlockRetire,
 	agg *state.AggregatorV3,
 	silkworm *silkworm.Silkworm,
+	liveTracer live.Tracer,
 	forkValidator *engine_helpers.ForkValidator,
 	heimdallClient heimdall.IHeimdallClient,
 	recents *lru.ARCCache[libcommon.Hash, *bor.Snapshot],
@@ -530,6 +532,7 @@ func NewDefaultStages(ctx context.Context,
 			cfg.Sync,
 			agg,
 			silkwormForExecutionStage(silkworm, cfg),
+			liveTracer,
 		),
 		stagedsync.StageHashStateCfg(db, dirs, cfg.HistoryV3),
 		stagedsync.StageTrieCfg(db, true, true, false, dirs.Tmp, blockReader, controlServer.Hd, cfg.HistoryV3, agg),
@@ -552,6 +555,7 @@ func NewPipelineStages(ctx context.Context,
 	blockRetire services.BlockRetire,
 	agg *state.AggregatorV3,
 	silkworm *silkworm.Silkworm,
+	liveTracer live.Tracer,
 	forkValidator *engine_helpers.ForkValidator,
 	logger log.Logger,
 	checkStateRoot bool,
@@ -604,6 +608,7 @@ func NewPipelineStages(ctx context.Context,
 				cfg.Sync,
 				agg,
 				silkwormForExecutionStage(silkworm, cfg),
+				liveTracer,
 			),
 			stagedsync.StageHashStateCfg(db, dirs, cfg.HistoryV3),
 			stagedsync.StageTrieCfg(db, checkStateRoot, true, false, dirs.Tmp, blockReader, controlServer.Hd, cfg.HistoryV3, agg),
@@ -640,6 +645,7 @@ func NewPipelineStages(ctx context.Context,
 			cfg.Sync,
 			agg,
 			silkwormForExecutionStage(silkworm, cfg),
+			liveTracer,
 		),
 		stagedsync.StageHashStateCfg(db, dirs, cfg.HistoryV3),
 		stagedsync.StageTrieCfg(db, checkStateRoot, true, false, dirs.Tmp, blockReader, controlServer.Hd, cfg.HistoryV3, agg),
@@ -681,6 +687,7 @@ func NewInMemoryExecution(ctx context.Context, db kv.RwDB, cfg *ethconfig.Config
 				cfg.Sync,
 				agg,
 				silkwormForExecutionStage(silkworm, cfg),
+				nil, // side forks are validated in memory, only the canonical chain is traced
 			),
 			stagedsync.StageHashStateCfg(db, dirs, cfg.HistoryV3),
 			stagedsync.StageTrieCfg(db, true, true, true, dirs.Tmp, blockReader, controlServer.Hd, cfg.HistoryV3, agg)),
@@ -0,0 +1,73 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/kv"
+
+	"github.com/ledgerwatch/erigon/consensus"
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/state"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/turbo/services"
+)
+
+// ComputeBlockAccessList re-executes the transactions of the block and returns the accounts and
+// storage slots read and written by each of them. Every transaction is executed by a new
+// IntraBlockState reading the state left by the previous ones, so that all its reads are recorded.
+func ComputeBlockAccessList(ctx context.Context, engine consensus.EngineReader, block *types.Block, cfg *chain.Config, headerReader services.HeaderReader, dbtx kv.Tx, historyV3 bool) (*types.BlockAccessList, error) {
+	accessList := &types.BlockAccessList{
+		BlockHash:    block.Hash(),
+		BlockNumber:  hexutil.Uint64(block.NumberU64()),
+		Transactions: []types.TxAccessList{},
+	}
+	if len(block.Transactions()) == 0 {
+		return accessList, nil
+	}
+	_, blockCtx, _, ibs, reader, err := ComputeTxEnv(ctx, engine, block, cfg, headerReader, dbtx, 0, historyV3)
+	if err != nil {
+		return nil, err
+	}
+	header := block.HeaderNoCopy()
+	signer := types.MakeSigner(cfg, block.NumberU64(), block.Time())
+	rules := cfg.Rules(block.NumberU64(), block.Time())
+
+	// The state written before the first transaction, by the system calls
+	overlay := state.NewOverlayReader(reader)
+	changes := state.NewStateChanges()
+	if err := ibs.FinalizeTx(rules, changes); err != nil {
+		return nil, err
+	}
+	overlay.Apply(changes)
+
+	for idx, txn := range block.Transactions() {
+		select {
+		default:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		reads := state.NewReadRecorder(overlay)
+		ibs := state.New(reads)
+		ibs.SetTxContext(txn.Hash(), block.Hash(), idx)
+		msg := TxMessage(txn, signer, header, rules, engine, cfg, ibs)
+		vmenv := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), ibs, cfg, vm.Config{})
+		if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(txn.GetGas()).AddBlobGas(txn.GetBlobGas()), true /* refunds */, false /* gasBailout */); err != nil {
+			return nil, fmt.Errorf("transaction %x failed: %w", txn.Hash(), err)
+		}
+		changes := state.NewStateChanges()
+		if err := ibs.FinalizeTx(rules, changes); err != nil {
+			return nil, err
+		}
+		overlay.Apply(changes)
+		accessList.Transactions = append(accessList.Transactions, types.TxAccessList{
+			TxIndex:  hexutil.Uint64(idx),
+			TxHash:   txn.Hash(),
+			Accounts: state.AccountAccesses(reads, changes),
+		})
+	}
+	return accessList, nil
+}
@@ -122,6 +122,19 @@ func ComputeTxEnv(ctx context.Context, engine consensus.EngineReader, block *typ
 	return nil, evmtypes.BlockContext{}, evmtypes.TxContext{}, nil, nil, fmt.Errorf("transaction index %d out of range for block %x", txIndex, block.Hash())
 }
 
+// TxMessage returns the message of txn, free of fees when the engine takes it for a service transaction, as gnosis
+// does for some accounts. The engine is asked through system calls on statedb.
+func TxMessage(txn types.Transaction, signer *types.Signer, header *types.Header, rules *chain.Rules, engine consensus.EngineReader, cfg *chain.Config, statedb *state.IntraBlockState) types.Message {
+	msg, _ := txn.AsMessage(*signer, header.BaseFee, rules)
+	if msg.FeeCap().IsZero() && engine != nil {
+		syscall := func(contract libcommon.Address, data []byte) ([]byte, error) {
+			return core.SysCallContract(contract, data, cfg, statedb, header, engine, true /* constCall */)
+		}
+		msg.SetIsFree(engine.IsServiceTransaction(msg.From(), syscall))
+	}
+	return msg
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -157,9 +170,11 @@ func TraceTx(
 		if config != nil && config.TracerConfig != nil {
 			cfg = *config.TracerConfig
 		}
-		if tracer, err = tracers.New(*config.Tracer, &tracers.Context{
-			TxHash: txCtx.TxHash,
-		}, cfg); err != nil {
+		tracerCtx := &tracers.Context{TxHash: txCtx.TxHash}
+		if statedb, ok := ibs.(*state.IntraBlockState); ok && txCtx.TxHash != (libcommon.Hash{}) {
+			tracerCtx.BlockHash, tracerCtx.TxIndex = statedb.BlockHash(), statedb.TxIndex()
+		}
+		if tracer, err = tracers.New(*config.Tracer, tracerCtx, cfg); err != nil {
 			stream.WriteNil()
 			return err
 		}